@@ -17,30 +17,1211 @@ limitations under the License.
 package v1alpha1
 
 import (
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
 )
 
 // GpuOperatorSpec defines the desired state of GpuOperator
+// +kubebuilder:validation:XValidation:rule="!has(self.dra) || !self.dra.enabled || !has(self.installEngine) || self.installEngine != 'Manifest'",message="dra requires a Helm install engine"
+// +kubebuilder:validation:XValidation:rule="!has(self.dra) || !self.dra.enabled || !has(self.devicePlugin) || !has(self.devicePlugin.reservedGpus) || size(self.devicePlugin.reservedGpus) == 0",message="reservedGpus requires the device plugin, which is disabled with dra"
+// +kubebuilder:validation:XValidation:rule="!has(self.dra) || !self.dra.enabled || !has(self.validation) || !has(self.validation.schedule) || self.validation.schedule == ''",message="the scheduled smoke tests request nvidia.com/gpu, which is not advertised with dra"
+// +kubebuilder:validation:XValidation:rule="!has(self.dra) || !self.dra.enabled || !has(self.validation) || !has(self.validation.enabled) || !self.validation.enabled",message="the CUDA validation requests nvidia.com/gpu, which is not advertised with dra"
+// +kubebuilder:validation:XValidation:rule="!has(self.dra) || !self.dra.enabled || !has(self.timeSlicing)",message="timeSlicing configures the device plugin, which is disabled with dra"
+// +kubebuilder:validation:XValidation:rule="!has(self.componentVersions) || !has(self.componentVersions.driver) || !has(self.driver) || !has(self.driver.image) || !(self.driver.image.contains(':') || self.driver.image.contains('@'))",message="componentVersions.driver cannot be combined with a pinned driver image"
+// +kubebuilder:validation:XValidation:rule="!has(self.componentVersions) || !has(self.componentVersions.driver) || !has(self.driverVersion) || self.driverVersion == '' || self.componentVersions.driver.startsWith(self.driverVersion + '.')",message="componentVersions.driver must belong to the driverVersion branch"
+// +kubebuilder:validation:XValidation:rule="!has(self.vgpu) || !has(self.ngcSecretRef)",message="vgpu and ngcSecretRef are mutually exclusive"
+// +kubebuilder:validation:XValidation:rule="!has(self.vgpu) || !has(self.driver) || !(has(self.driver.repository) || has(self.driver.image))",message="vgpu sets the driver image, it cannot be combined with driver.repository or driver.image"
+// +kubebuilder:validation:XValidation:rule="!has(self.driver) || !has(self.driver.mode) || self.driver.mode != 'Preinstalled' || ((!has(self.driverVersion) || self.driverVersion == '') && (!has(self.componentVersions) || !has(self.componentVersions.driver)))",message="driverVersion and componentVersions.driver cannot be set with a preinstalled driver"
+// +kubebuilder:validation:XValidation:rule="!has(self.driver) || !has(self.driver.mode) || self.driver.mode != 'Preinstalled' || !has(self.ngcSecretRef)",message="ngcSecretRef configures the driver, which is not deployed with a preinstalled driver"
+// +kubebuilder:validation:XValidation:rule="!has(self.vgpu) || !has(self.driver) || !has(self.driver.mode) || self.driver.mode == 'Managed'",message="vgpu requires the driver mode Managed"
+// +kubebuilder:validation:XValidation:rule="has(self.targetClusterKubeconfigSecretRef) == has(oldSelf.targetClusterKubeconfigSecretRef)",message="targetClusterKubeconfigSecretRef cannot be added or removed after creation"
+// +kubebuilder:validation:XValidation:rule="!has(self.valuesConfigMapName) || self.valuesConfigMapName == '' || !has(self.installEngine) || self.installEngine != 'Manifest'",message="valuesConfigMapName requires a Helm install engine, the Manifest install engine uses the values rendered into the image"
+// +kubebuilder:validation:XValidation:rule="!has(self.valuesSource) || self.valuesSource != 'ConfigMap' || (has(self.valuesConfigMapName) && self.valuesConfigMapName != '')",message="valuesSource ConfigMap requires valuesConfigMapName"
+// +kubebuilder:validation:XValidation:rule="!has(self.valuesSource) || self.valuesSource == 'Remote' || !has(self.installEngine) || self.installEngine != 'Manifest'",message="valuesSource requires a Helm install engine, the Manifest install engine uses the values rendered into the image"
+// +kubebuilder:validation:XValidation:rule="!has(self.helmRepo) || !has(self.installEngine) || self.installEngine != 'Manifest'",message="helmRepo requires a Helm install engine, the Manifest install engine uses the manifests rendered into the image"
+// +kubebuilder:validation:XValidation:rule="!has(self.helmRepo) || !has(self.registry) || !has(self.registry.helmRepoUrl) || self.registry.helmRepoUrl == ''",message="helmRepo and registry.helmRepoUrl are mutually exclusive"
+// +kubebuilder:validation:XValidation:rule="!(has(self.values) || has(self.rawValues)) || !has(self.installEngine) || self.installEngine != 'Manifest'",message="values and rawValues require a Helm install engine, the Manifest install engine uses the values rendered into the image"
+// +kubebuilder:validation:XValidation:rule="!has(self.chartVersion) || self.chartVersion == '' || !has(self.installEngine) || self.installEngine != 'Manifest'",message="chartVersion requires a Helm install engine, the Manifest install engine uses the chart rendered into the image"
+// +kubebuilder:validation:XValidation:rule="!has(self.upgradePolicy) || !has(self.installEngine) || self.installEngine != 'Manifest'",message="upgradePolicy requires a Helm install engine, the Manifest install engine uses the chart rendered into the image"
+// +kubebuilder:validation:XValidation:rule="!has(self.clusterPolicyManagement) || self.clusterPolicyManagement == 'Chart' || !has(self.installEngine) || self.installEngine != 'Manifest'",message="clusterPolicyManagement Controller requires a Helm install engine"
+// +kubebuilder:validation:XValidation:rule="!has(self.amd) || (has(self.vendor) && self.vendor == 'AMD')",message="amd requires the vendor AMD"
+// +kubebuilder:validation:XValidation:rule="!has(self.vendor) || self.vendor != 'AMD' || !has(self.installEngine) || self.installEngine != 'Manifest'",message="the AMD GPU operator is installed by the Helm install engine"
+// +kubebuilder:validation:XValidation:rule="!has(self.vendor) || self.vendor != 'AMD' || !(has(self.driverVersion) || has(self.upgradePolicy) || has(self.valuesConfigMapName) || has(self.values) || has(self.rawValues) || has(self.resources) || has(self.gfd) || has(self.resyncPeriod) || has(self.validation) || has(self.spot) || has(self.readinessChecks) || has(self.monitoring) || has(self.healthCheck) || has(self.mig) || has(self.timeSlicing) || has(self.workloads) || has(self.devicePlugin) || has(self.driver) || has(self.validator) || has(self.operands) || has(self.daemonsets) || has(self.toolkit) || has(self.runtimeClass) || has(self.kueue) || has(self.dra) || has(self.componentVersions) || has(self.ngcSecretRef) || has(self.vgpu) || has(self.registry) || (has(self.fipsMode) && self.fipsMode))",message="the NVIDIA settings cannot be combined with the vendor AMD"
+// +kubebuilder:validation:XValidation:rule="!has(self.vendor) || self.vendor != 'AMD' || !has(self.uninstall) || !(has(self.uninstall.drainGpuWorkloads) && self.uninstall.drainGpuWorkloads || has(self.uninstall.blockIfWorkloadsPresent) && self.uninstall.blockIfWorkloadsPresent || has(self.uninstall.deleteCRDs) && self.uninstall.deleteCRDs)",message="drainGpuWorkloads, blockIfWorkloadsPresent and deleteCRDs are not supported with the vendor AMD"
 type GpuOperatorSpec struct {
+	// Vendor selects the GPU operator to install: the NVIDIA GPU operator, or the AMD GPU operator with
+	// the ROCm device plugin and node labeller. An NVIDIA and an AMD GpuOperator can manage the same cluster
+	// +optional
+	// +kubebuilder:default=NVIDIA
+	// +kubebuilder:validation:Enum=NVIDIA;AMD
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="vendor cannot be changed after creation"
+	Vendor GPUVendor `json:"vendor,omitempty"`
+
+	// AMD configures the AMD GPU operator, if vendor is AMD
+	// +optional
+	AMD *AMDSpec `json:"amd,omitempty"`
+
 	// DriverVersion specifies the NVIDIA driver version to install
 	// Compatible with Garden Linux kernel versions in Kyma clusters
+	// If empty, the newest driver branch supported by every detected GPU model is selected
+	// +optional
+	DriverVersion string `json:"driverVersion,omitempty"`
+
+	// ChartVersion pins the version of the nvidia/gpu-operator chart, e.g. v25.3.0
+	// If empty, the latest chart version is installed
+	// +optional
+	// +kubebuilder:validation:Pattern=`^v?[0-9]+\.[0-9]+\.[0-9]+(-[0-9A-Za-z.-]+)?$`
+	ChartVersion string `json:"chartVersion,omitempty"`
+
+	// UpgradePolicy keeps the GPU operator chart current: the controller periodically looks up new chart
+	// versions in the Helm repository and upgrades within the scope of the upgrade channel
+	// +optional
+	UpgradePolicy *UpgradePolicySpec `json:"upgradePolicy,omitempty"`
+
+	// AllowDowngrade installs a chart or driver version lower than the installed one. Without it, such a
+	// spec is refused with the DowngradeBlocked condition, as the GPU operator does not support downgrades
+	// +optional
+	AllowDowngrade bool `json:"allowDowngrade,omitempty"`
+
+	// Paused stops all changes the controller makes to the cluster, e.g. to debug the GPU stack by hand,
+	// while the status keeps being reported. The operator.kyma-project.io/suspend annotation set to true
+	// has the same effect
+	// +optional
+	Paused bool `json:"paused,omitempty"`
+
+	// Namespace where the GPU operator will be installed
+	// Defaults to the defaultNamespace of the controller configuration, gpu-operator unless changed, or to
+	// its defaultAMDNamespace, kube-amd-gpu unless changed, for the vendor AMD
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+
+	// ValuesConfigMapName is the name of a ConfigMap in the namespace of the CR whose values.yaml key holds
+	// custom Helm values. They are deep-merged over the Garden Linux values; the settings of the spec take
+	// precedence over both
+	// +optional
+	ValuesConfigMapName string `json:"valuesConfigMapName,omitempty"`
+
+	// ValuesSource selects the Garden Linux values the custom values are merged over. Remote fetches the values
+	// published by the Gardener project and falls back to the copy embedded in the controller if they cannot be
+	// fetched, Embedded always uses that copy, and ConfigMap uses the values of valuesConfigMapName instead
+	// +optional
+	// +kubebuilder:default=Remote
+	// +kubebuilder:validation:Enum=Remote;Embedded;ConfigMap
+	ValuesSource ValuesSource `json:"valuesSource,omitempty"`
+
+	// Values are Helm values of the operand sections of the chart, merged over the values of
+	// valuesConfigMapName
+	// +optional
+	Values *HelmValues `json:"values,omitempty"`
+
+	// RawValues are unstructured Helm values merged over values, for chart settings values does not cover
+	// +optional
+	// +kubebuilder:pruning:PreserveUnknownFields
+	// +kubebuilder:validation:Type=object
+	RawValues *runtime.RawExtension `json:"rawValues,omitempty"`
+
+	// Resources defines resource limits for GPU operator components
+	// +optional
+	Resources *ResourceRequirements `json:"resources,omitempty"`
+
+	// GFD configures GPU Feature Discovery
+	// +optional
+	GFD *GFDSpec `json:"gfd,omitempty"`
+
+	// NamespaceDefaults configures a ResourceQuota and LimitRange in the installation namespace
+	// +optional
+	NamespaceDefaults *NamespaceDefaults `json:"namespaceDefaults,omitempty"`
+
+	// Install configures how the GPU operator is installed
+	// +optional
+	Install *InstallSpec `json:"install,omitempty"`
+
+	// Uninstall configures how the GPU operator is removed when the CR is deleted
+	// +optional
+	Uninstall *UninstallSpec `json:"uninstall,omitempty"`
+
+	// InstallEngine selects how the GPU operator is installed. Helm runs Helm in the controller with the
+	// Helm SDK, Manifest applies the manifests rendered at build time with server-side apply and prunes
+	// removed objects
+	// +optional
+	// +kubebuilder:default=Helm
+	// +kubebuilder:validation:Enum=Helm;Manifest
+	InstallEngine InstallEngine `json:"installEngine,omitempty"`
+
+	// ClusterPolicyManagement selects who configures the operands. Chart passes the operand settings of the
+	// spec to the chart, which renders them into the ClusterPolicy. Controller installs the chart with the
+	// operands disabled and keeps the ClusterPolicy in sync with the spec from the controller
+	// +optional
+	// +kubebuilder:default=Chart
+	// +kubebuilder:validation:Enum=Chart;Controller
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="clusterPolicyManagement cannot be changed after creation"
+	ClusterPolicyManagement ClusterPolicyManagement `json:"clusterPolicyManagement,omitempty"`
+
+	// ResyncPeriod is how often the deployed Helm release is compared with the desired installation.
+	// A release that was uninstalled, upgraded or rolled back by hand is reinstalled. Defaults to 1h
+	// +optional
+	ResyncPeriod *metav1.Duration `json:"resyncPeriod,omitempty"`
+
+	// Images overrides the helper images launched by the controller for this CR.
+	// Unset images fall back to the operator-level defaults
+	// +optional
+	Images *HelperImages `json:"images,omitempty"`
+
+	// Validation configures the CUDA validation after the installation and the CUDA smoke tests run on the
+	// GPU worker pools
+	// +optional
+	Validation *ValidationSpec `json:"validation,omitempty"`
+
+	// Spot configures the handling of frequently replaced spot/preemptible GPU nodes
+	// +optional
+	Spot *SpotSpec `json:"spot,omitempty"`
+
+	// ReadinessChecks defines what the GPU stack must provide for the CR to become Ready.
+	// If unset, the CR is Ready once the installation completed
+	// +optional
+	ReadinessChecks *ReadinessChecks `json:"readinessChecks,omitempty"`
+
+	// SkipCapacityCheck lets the CR become Ready without any node advertising allocatable nvidia.com/gpu,
+	// e.g. when the GPU worker pools scale up from zero only once GPU workloads are scheduled
+	// +optional
+	SkipCapacityCheck bool `json:"skipCapacityCheck,omitempty"`
+
+	// Monitoring configures the GPU monitoring operands
+	// +optional
+	Monitoring *MonitoringSpec `json:"monitoring,omitempty"`
+
+	// HealthCheck detects unhealthy GPUs, reports them in the status and optionally remediates them
+	// +optional
+	HealthCheck *HealthCheckSpec `json:"healthCheck,omitempty"`
+
+	// MIG configures the MIG manager
+	// +optional
+	MIG *MIGSpec `json:"mig,omitempty"`
+
+	// TimeSlicing shares every GPU between several containers by advertising it several times
+	// +optional
+	TimeSlicing *TimeSlicingSpec `json:"timeSlicing,omitempty"`
+
+	// Workloads configures the kinds of workloads the GPU nodes serve
+	// +optional
+	Workloads *WorkloadsSpec `json:"workloads,omitempty"`
+
+	// DevicePlugin configures the resources advertised by the NVIDIA device plugin
+	// +optional
+	DevicePlugin *DevicePluginSpec `json:"devicePlugin,omitempty"`
+
+	// Driver configures the driver DaemonSet
+	// +optional
+	Driver *DriverSpec `json:"driver,omitempty"`
+
+	// Validator configures the operator validator that checks the operands on every GPU node
+	// +optional
+	Validator *ValidatorSpec `json:"validator,omitempty"`
+
+	// Operands constrains the operand DaemonSets to a subset of the GPU nodes and lets them tolerate the taints
+	// of the GPU worker pools
+	// +optional
+	Operands *OperandsSpec `json:"operands,omitempty"`
+
+	// DaemonSets configures how the operand DaemonSets roll out changes
+	// +optional
+	DaemonSets *DaemonSetsSpec `json:"daemonsets,omitempty"`
+
+	// Toolkit configures where the container toolkit installs the NVIDIA runtime and which containerd it configures
+	// +optional
+	Toolkit *ToolkitSpec `json:"toolkit,omitempty"`
+
+	// RuntimeClass configures the RuntimeClass of the NVIDIA container runtime
+	// +optional
+	RuntimeClass *RuntimeClassSpec `json:"runtimeClass,omitempty"`
+
+	// Kueue configures the integration with the Kueue job queueing system
+	// +optional
+	Kueue *KueueSpec `json:"kueue,omitempty"`
+
+	// DRA configures GPU allocation with Dynamic Resource Allocation
+	// +optional
+	DRA *DRASpec `json:"dra,omitempty"`
+
+	// ComponentVersions pins the versions of individual operands, independent of the chart defaults
+	// +optional
+	ComponentVersions *ComponentVersions `json:"componentVersions,omitempty"`
+
+	// FIPSMode deploys the FIPS-validated images configured in the controller configuration for the GPU
+	// operator and every deployed operand. Installation fails if a deployed component has no FIPS image
+	// +optional
+	FIPSMode bool `json:"fipsMode,omitempty"`
+
+	// NGCSecretRef references a Secret in the namespace of the CR with the NGC API key and the NVIDIA
+	// License System client configuration, for NGC enterprise driver images. The controller derives the
+	// image pull secret and the licensing configuration of the driver from it
+	// +optional
+	NGCSecretRef *SecretReference `json:"ngcSecretRef,omitempty"`
+
+	// VGPU configures the vGPU guest driver for GPU nodes running on NVIDIA vGPU: the driver image from a
+	// private registry and its licensing
+	// +optional
+	VGPU *VGPUSpec `json:"vgpu,omitempty"`
+
+	// Registry points the installation at private mirrors of the NVIDIA image registries and Helm repository,
+	// for clusters that cannot reach nvcr.io or helm.ngc.nvidia.com
+	// +optional
+	Registry *RegistrySpec `json:"registry,omitempty"`
+
+	// Proxy routes the chart and values downloads of the installation and the downloads of the driver through
+	// an HTTP proxy, for clusters behind a corporate proxy
+	// +optional
+	Proxy *ProxySpec `json:"proxy,omitempty"`
+
+	// HelmRepo installs the charts from an internal mirror of the NVIDIA Helm repository, optionally with
+	// credentials. Mutually exclusive with registry.helmRepoUrl
+	// +optional
+	HelmRepo *HelmRepoSpec `json:"helmRepo,omitempty"`
+
+	// TargetClusterKubeconfigSecretRef references a Secret in the namespace of the CR with the kubeconfig
+	// of a remote cluster, e.g. a shoot managed from a Kyma management cluster. The GPU stack is installed
+	// into and monitored in that cluster instead of the cluster the controller runs in
+	// +optional
+	TargetClusterKubeconfigSecretRef *KubeconfigSecretReference `json:"targetClusterKubeconfigSecretRef,omitempty"`
+}
+
+// KubeconfigSecretReference references a kubeconfig stored in a Secret
+type KubeconfigSecretReference struct {
+	// Name of the Secret
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+
+	// Key of the kubeconfig in the Secret
+	// +kubebuilder:default="kubeconfig"
+	// +optional
+	Key string `json:"key,omitempty"`
+}
+
+// ComponentVersions defines the image tags of the operands. Empty fields keep the chart defaults
+type ComponentVersions struct {
+	// Driver is the full driver version, e.g. 570.124.06. The driver image tag is <version>-<os>
+	// +kubebuilder:validation:Pattern=`^[A-Za-z0-9_][A-Za-z0-9_.-]{0,127}$`
+	// +optional
+	Driver string `json:"driver,omitempty"`
+
+	// Toolkit is the image tag of the container toolkit, e.g. v1.17.5-ubuntu20.04
+	// +kubebuilder:validation:Pattern=`^[A-Za-z0-9_][A-Za-z0-9_.-]{0,127}$`
+	// +optional
+	Toolkit string `json:"toolkit,omitempty"`
+
+	// DevicePlugin is the image tag of the device plugin, e.g. v0.17.1
+	// +kubebuilder:validation:Pattern=`^[A-Za-z0-9_][A-Za-z0-9_.-]{0,127}$`
+	// +optional
+	DevicePlugin string `json:"devicePlugin,omitempty"`
+
+	// DCGMExporter is the image tag of DCGM Exporter, e.g. 4.1.1-4.0.4-ubuntu22.04
+	// +kubebuilder:validation:Pattern=`^[A-Za-z0-9_][A-Za-z0-9_.-]{0,127}$`
+	// +optional
+	DCGMExporter string `json:"dcgmExporter,omitempty"`
+}
+
+// DRASpec defines the installation of the NVIDIA DRA driver
+type DRASpec struct {
+	// Enabled installs the NVIDIA DRA driver, which publishes the GPUs as ResourceSlices and allocates them
+	// to ResourceClaims, instead of the device plugin advertising nvidia.com/gpu. Requires Kubernetes 1.32 or
+	// later with the resource.k8s.io API enabled
+	// +optional
+	Enabled bool `json:"enabled,omitempty"`
+}
+
+// KueueSpec defines the Kueue objects maintained by the controller
+type KueueSpec struct {
+	// ResourceFlavors keeps one Kueue ResourceFlavor per GPU model in sync with the GPU nodes of the
+	// cluster. Has no effect while Kueue is not installed
+	// +optional
+	ResourceFlavors bool `json:"resourceFlavors,omitempty"`
+}
+
+// RuntimeClassSpec defines the RuntimeClass GPU workloads run with
+type RuntimeClassSpec struct {
+	// Name of the RuntimeClass created for the NVIDIA container runtime, which GPU workloads reference
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]([-a-z0-9]*[a-z0-9])?(\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*$`
+	// +kubebuilder:validation:MaxLength=253
+	// +kubebuilder:default=nvidia
+	// +optional
+	Name string `json:"name,omitempty"`
+
+	// SetAsDefault makes the NVIDIA runtime the default runtime of containerd on the GPU nodes, so GPU
+	// workloads do not need to reference the RuntimeClass. Defaults to the chart default
+	// +optional
+	SetAsDefault *bool `json:"setAsDefault,omitempty"`
+}
+
+// ToolkitSpec defines the host paths used by the container toolkit. Empty fields keep the chart defaults
+type ToolkitSpec struct {
+	// ContainerdConfig is the path of the containerd configuration file on the host the NVIDIA runtime is
+	// added to, e.g. /etc/containerd/config.toml
+	// +kubebuilder:validation:Pattern=`^/`
+	// +optional
+	ContainerdConfig string `json:"containerdConfig,omitempty"`
+
+	// ContainerdSocket is the path of the containerd socket on the host, used to restart containerd
+	// after its configuration changed, e.g. /run/containerd/containerd.sock
+	// +kubebuilder:validation:Pattern=`^/`
+	// +optional
+	ContainerdSocket string `json:"containerdSocket,omitempty"`
+
+	// InstallDir is the host directory the NVIDIA container runtime is installed to, e.g. /opt/nvidia
+	// +kubebuilder:validation:Pattern=`^/`
+	// +optional
+	InstallDir string `json:"installDir,omitempty"`
+}
+
+// OperandsSpec defines on which GPU nodes the operands run
+type OperandsSpec struct {
+	// NodeSelector restricts the operands to the GPU nodes with these labels, e.g. the
+	// worker.gardener.cloud/pool label of the GPU worker pools. GPU nodes that do not match get no driver,
+	// container toolkit, device plugin or other operand
+	// +optional
+	NodeSelector map[string]string `json:"nodeSelector,omitempty"`
+
+	// Tolerations are additional taints the operands and the Node Feature Discovery worker tolerate, e.g. the
+	// taints of dedicated GPU worker pools
+	// +optional
+	Tolerations []corev1.Toleration `json:"tolerations,omitempty"`
+}
+
+// DaemonSetsSpec defines the rollout of the operand DaemonSets
+type DaemonSetsSpec struct {
+	// UpdateStrategy of the operand DaemonSets during chart upgrades. Defaults to the chart default
+	// +optional
+	UpdateStrategy *DaemonSetUpdateStrategy `json:"updateStrategy,omitempty"`
+}
+
+// DaemonSetUpdateStrategy defines how the pods of the operand DaemonSets are replaced
+// +kubebuilder:validation:XValidation:rule="!has(self.maxUnavailable) || self.type == 'RollingUpdate'",message="maxUnavailable requires the RollingUpdate type"
+type DaemonSetUpdateStrategy struct {
+	// Type is RollingUpdate to replace the pods automatically, or OnDelete to replace a pod only when it is deleted
+	// +kubebuilder:validation:Enum=RollingUpdate;OnDelete
+	// +kubebuilder:default=RollingUpdate
+	// +optional
+	Type string `json:"type,omitempty"`
+
+	// MaxUnavailable is the number or percentage of nodes whose operand pods are replaced at the same time
+	// during a rolling update, e.g. 1 or 10%
+	// +kubebuilder:validation:XIntOrString
+	// +optional
+	MaxUnavailable *intstr.IntOrString `json:"maxUnavailable,omitempty"`
+}
+
+// ValidatorSpec defines the validations of the operator validator
+// +kubebuilder:validation:XValidation:rule="!has(self.driver) || !has(self.driver.workload)",message="the driver validation has no workload"
+// +kubebuilder:validation:XValidation:rule="!has(self.toolkit) || !has(self.toolkit.workload)",message="the toolkit validation has no workload"
+// +kubebuilder:validation:XValidation:rule="!has(self.repository) || !has(self.image) || !(self.image.contains(':') || self.image.contains('@'))",message="a pinned image reference cannot be combined with repository"
+type ValidatorSpec struct {
+	// Repository is the registry path of the validator image
+	// +optional
+	Repository string `json:"repository,omitempty"`
+
+	// Image is the name of the validator image, or a complete image reference with a tag or digest
+	// +optional
+	Image string `json:"image,omitempty"`
+
+	// Driver configures the driver validation. It gates the other operands and always runs
+	// +optional
+	Driver *ValidatorComponent `json:"driver,omitempty"`
+
+	// Toolkit configures the container toolkit validation. It gates the other operands and always runs
+	// +optional
+	Toolkit *ValidatorComponent `json:"toolkit,omitempty"`
+
+	// CUDA configures the CUDA validation
+	// +optional
+	CUDA *ValidatorComponent `json:"cuda,omitempty"`
+
+	// Plugin configures the device plugin validation
+	// +optional
+	Plugin *ValidatorComponent `json:"plugin,omitempty"`
+}
+
+// ValidatorComponent defines a single validation of the operator validator
+type ValidatorComponent struct {
+	// Workload runs a test pod requesting a GPU on every node, supported by the cuda and plugin validations.
+	// Disable it on GPUs that do not run CUDA workloads or to save the GPU time. Defaults to the chart default
+	// +optional
+	Workload *bool `json:"workload,omitempty"`
+
+	// Env are additional environment variables of the validation, e.g. DISABLE_DEV_CHAR_SYMLINK_CREATION
+	// +optional
+	Env []corev1.EnvVar `json:"env,omitempty"`
+}
+
+// DriverMode is how the NVIDIA driver gets onto the GPU nodes
+// +kubebuilder:validation:Enum=Managed;Preinstalled;Precompiled
+type DriverMode string
+
+const (
+	// DriverModeManaged deploys the driver as configured by the chart values
+	DriverModeManaged DriverMode = "Managed"
+
+	// DriverModePreinstalled uses the driver shipped with the node image and deploys no driver
+	DriverModePreinstalled DriverMode = "Preinstalled"
+
+	// DriverModePrecompiled deploys the precompiled Garden Linux driver images, regardless of the chart values
+	DriverModePrecompiled DriverMode = "Precompiled"
+)
+
+// DriverSpec defines how the driver is installed and its container image
+// +kubebuilder:validation:XValidation:rule="!has(self.repository) || !has(self.image) || !(self.image.contains(':') || self.image.contains('@'))",message="a pinned image reference cannot be combined with repository"
+// +kubebuilder:validation:XValidation:rule="!has(self.mode) || self.mode != 'Preinstalled' || !(has(self.repository) || has(self.image))",message="a preinstalled driver has no image"
+// +kubebuilder:validation:XValidation:rule="!has(self.mode) || self.mode != 'Preinstalled' || !has(self.upgrade)",message="a preinstalled driver is not upgraded by the GPU operator"
+type DriverSpec struct {
+	// Mode is how the driver is installed: Managed deploys it as configured by the chart values, Preinstalled
+	// uses the driver of the node image and deploys only the toolkit and device plugin stack, Precompiled deploys
+	// the precompiled Garden Linux driver images. Defaults to Managed
+	// +optional
+	Mode DriverMode `json:"mode,omitempty"`
+
+	// Repository is the registry path of the driver images, e.g. registry.example.com/nvidia.
+	// The GPU operator pulls <repository>/<image>:<version>-<os>
+	// +optional
+	Repository string `json:"repository,omitempty"`
+
+	// Image is the name of the driver image, e.g. driver, or a complete image reference with a tag or
+	// digest, e.g. registry.example.com/nvidia/driver@sha256:..., which pins exactly that image
+	// +optional
+	Image string `json:"image,omitempty"`
+
+	// Upgrade configures how a change of the driver version rolls through the GPU nodes. The upgrade
+	// controller of the GPU operator upgrades the nodes in batches: it cordons a node, evicts its GPU
+	// workloads, waits for the new driver pod to be ready and uncordons the node
+	// +optional
+	Upgrade *DriverUpgradeSpec `json:"upgrade,omitempty"`
+}
+
+// DriverUpgradeSpec defines the rolling upgrade of the driver on the GPU nodes
+type DriverUpgradeSpec struct {
+	// MaxParallelUpgrades is the number of nodes upgraded at the same time, 0 for no limit. Defaults to 1
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	MaxParallelUpgrades *int32 `json:"maxParallelUpgrades,omitempty"`
+
+	// MaxUnavailable is the number or percentage of GPU nodes that may be unavailable during the upgrade,
+	// including the nodes that are unavailable for other reasons, e.g. 1 or 25%. Defaults to 25%
+	// +kubebuilder:validation:XIntOrString
+	// +optional
+	MaxUnavailable *intstr.IntOrString `json:"maxUnavailable,omitempty"`
+
+	// Timeout is how long the eviction of the GPU workloads of a node may take before the upgrade of the
+	// node fails. Zero waits indefinitely. Defaults to 5m
+	// +optional
+	Timeout *metav1.Duration `json:"timeout,omitempty"`
+
+	// Force deletes the GPU pods that are not managed by a controller, which are lost
+	// +optional
+	Force bool `json:"force,omitempty"`
+
+	// DeleteEmptyDir deletes the GPU pods with emptyDir volumes, whose data is lost
+	// +optional
+	DeleteEmptyDir bool `json:"deleteEmptyDir,omitempty"`
+
+	// Drain drains the node of all pods, not only the GPU workloads, before its driver is upgraded
+	// +optional
+	Drain bool `json:"drain,omitempty"`
+}
+
+// VGPUSpec defines the vGPU guest driver and its licensing
+// +kubebuilder:validation:XValidation:rule="has(self.licenseServer) != has(self.clientTokenSecretRef)",message="exactly one of licenseServer and clientTokenSecretRef must be set"
+type VGPUSpec struct {
+	// DriverRepository is the registry path of the vGPU guest driver images, which NVIDIA does not publish
+	// and have to be built into a private registry. The GPU operator pulls <driverRepository>/<driverImage>:<driverVersion>-<os>
+	// +kubebuilder:validation:MinLength=1
+	DriverRepository string `json:"driverRepository"`
+
+	// DriverImage is the name of the driver image. Defaults to the chart default driver
+	// +optional
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]+([._-][a-z0-9]+)*$`
+	DriverImage string `json:"driverImage,omitempty"`
+
+	// ImagePullSecretRef references a Secret of type kubernetes.io/dockerconfigjson in the namespace of the CR
+	// with the credentials of the driver registry
+	// +optional
+	ImagePullSecretRef *SecretReference `json:"imagePullSecretRef,omitempty"`
+
+	// LicenseServer licenses the vGPUs from a legacy NVIDIA vGPU license server
+	// +optional
+	LicenseServer *VGPULicenseServer `json:"licenseServer,omitempty"`
+
+	// ClientTokenSecretRef references a Secret in the namespace of the CR with the NVIDIA License System client
+	// configuration token in the key client_configuration_token.tok
+	// +optional
+	ClientTokenSecretRef *SecretReference `json:"clientTokenSecretRef,omitempty"`
+}
+
+// VGPULicenseServer defines a legacy NVIDIA vGPU license server
+type VGPULicenseServer struct {
+	// Address is the host name or IP address of the license server
+	// +kubebuilder:validation:MinLength=1
+	Address string `json:"address"`
+
+	// Port is the port of the license server
+	// +optional
+	// +kubebuilder:default=7070
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65535
+	Port int32 `json:"port,omitempty"`
+}
+
+// SecretReference references a Secret in the namespace of the CR
+type SecretReference struct {
+	// Name of the Secret
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+}
+
+// RegistrySpec defines the private mirrors the GPU stack is installed from
+type RegistrySpec struct {
+	// Repository is the prefix all images are pulled from instead of their source registry, e.g.
+	// registry.example.com/nvidia-mirror. The path below the source registry is kept, so the images of
+	// nvcr.io/nvidia/k8s are pulled from registry.example.com/nvidia-mirror/nvidia/k8s
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]([a-z0-9.:-]*[a-z0-9])?(/[a-z0-9._-]+)*$`
+	Repository string `json:"repository"`
+
+	// HelmRepoURL is the mirror of the NVIDIA Helm repository https://helm.ngc.nvidia.com/nvidia
+	// +optional
+	// +kubebuilder:validation:Pattern=`^https?://[^\s]+$`
+	HelmRepoURL string `json:"helmRepoUrl,omitempty"`
+
+	// ImagePullSecrets reference Secrets of type kubernetes.io/dockerconfigjson in the namespace of the CR
+	// that authenticate to the mirror. They are copied into the installation namespace and used by the GPU
+	// operator, its operands and the helper pods of the controller
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	ImagePullSecrets []SecretReference `json:"imagePullSecrets,omitempty"`
+
+	// CABundle holds the PEM-encoded CA certificates the TLS certificate of the Helm repository mirror is
+	// verified with. Image pulls use the CA certificates trusted by the nodes
+	// +optional
+	CABundle string `json:"caBundle,omitempty"`
+}
+
+// HelmRepoSpec defines the Helm repository the charts are installed from
+type HelmRepoSpec struct {
+	// URL of the Helm repository
+	// +kubebuilder:validation:Pattern=`^https?://[^\s]+$`
+	URL string `json:"url"`
+
+	// CredentialsSecretRef references a Secret in the namespace of the CR with the keys username and password
+	// for basic auth, or the key token for bearer token auth
+	// +optional
+	CredentialsSecretRef *SecretReference `json:"credentialsSecretRef,omitempty"`
+}
+
+// UpgradeChannel is the scope of the automatic upgrades of the GPU operator chart
+// +kubebuilder:validation:Enum=None;Patch;Minor;Latest
+type UpgradeChannel string
+
+const (
+	// UpgradeChannelNone reports new chart versions without upgrading
+	UpgradeChannelNone UpgradeChannel = "None"
+
+	// UpgradeChannelPatch upgrades to new patch versions of the installed minor version
+	UpgradeChannelPatch UpgradeChannel = "Patch"
+
+	// UpgradeChannelMinor upgrades to new minor and patch versions of the installed major version
+	UpgradeChannelMinor UpgradeChannel = "Minor"
+
+	// UpgradeChannelLatest upgrades to every new chart version
+	UpgradeChannelLatest UpgradeChannel = "Latest"
+)
+
+// UpgradePolicySpec defines the automatic upgrades of the GPU operator chart
+type UpgradePolicySpec struct {
+	// Channel is the scope of the automatic upgrades: None only reports new chart versions, Patch upgrades
+	// within the installed minor version, Minor within the installed major version and Latest to the newest
+	// version. Pre-releases are skipped. Defaults to None
+	// +kubebuilder:default=None
+	// +optional
+	Channel UpgradeChannel `json:"channel,omitempty"`
+
+	// CheckInterval is how often the Helm repository is queried for new chart versions. Defaults to 6h
+	// +optional
+	CheckInterval *metav1.Duration `json:"checkInterval,omitempty"`
+
+	// MaintenanceWindow restricts the automatic upgrades to a daily time window. Upgrades start at any
+	// time if unset
+	// +optional
+	MaintenanceWindow *MaintenanceWindow `json:"maintenanceWindow,omitempty"`
+
+	// RequireApproval holds back chart upgrades of the channel and changes of the driver version until
+	// the CR is annotated with operator.kyma-project.io/approve-upgrade set to the ID of the pending
+	// upgrade in status.pendingUpgrade
+	// +optional
+	RequireApproval bool `json:"requireApproval,omitempty"`
+}
+
+// MaintenanceWindow is a daily time window in UTC. A window whose end is before its begin spans midnight
+// +kubebuilder:validation:XValidation:rule="self.begin != self.end",message="the maintenance window must not be empty"
+type MaintenanceWindow struct {
+	// Begin of the window as HH:MM in UTC, e.g. 22:00
+	// +kubebuilder:validation:Pattern=`^([01][0-9]|2[0-3]):[0-5][0-9]$`
+	Begin string `json:"begin"`
+
+	// End of the window as HH:MM in UTC, e.g. 02:00
+	// +kubebuilder:validation:Pattern=`^([01][0-9]|2[0-3]):[0-5][0-9]$`
+	End string `json:"end"`
+}
+
+// ProxySpec defines the HTTP proxy of the installation
+// +kubebuilder:validation:XValidation:rule="has(self.httpProxy) || has(self.httpsProxy)",message="proxy requires httpProxy or httpsProxy"
+type ProxySpec struct {
+	// HTTPProxy is the proxy URL of HTTP requests, e.g. http://proxy.example.com:3128
+	// +optional
+	// +kubebuilder:validation:Pattern=`^https?://[^\s]+$`
+	HTTPProxy string `json:"httpProxy,omitempty"`
+
+	// HTTPSProxy is the proxy URL of HTTPS requests
+	// +optional
+	// +kubebuilder:validation:Pattern=`^https?://[^\s]+$`
+	HTTPSProxy string `json:"httpsProxy,omitempty"`
+
+	// NoProxy is a comma-separated list of hosts, domains and CIDRs that are reached directly. The cluster
+	// API server and cluster-internal service domains are always reached directly by the driver containers
+	// +optional
+	NoProxy string `json:"noProxy,omitempty"`
+}
+
+// DevicePluginSpec defines the resources advertised by the NVIDIA device plugin
+type DevicePluginSpec struct {
+	// ReservedGPUs keeps GPUs of the nodes of a worker pool out of the allocatable nvidia.com/gpu resources,
+	// e.g. for monitoring or transcoding daemons using them directly on the node
+	// +optional
+	// +listType=map
+	// +listMapKey=pool
+	ReservedGPUs []ReservedGPUs `json:"reservedGpus,omitempty"`
+}
+
+// ReservedGPUs defines the GPUs reserved on every node of a worker pool
+// +kubebuilder:validation:XValidation:rule="has(self.count) != has(self.indices)",message="exactly one of count and indices must be set"
+type ReservedGPUs struct {
+	// Pool is the name of the Gardener worker pool
+	// +kubebuilder:validation:MinLength=1
+	Pool string `json:"pool"`
+
+	// Count reserves the GPUs with the lowest indices, e.g. 1 reserves GPU 0
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	Count *int32 `json:"count,omitempty"`
+
+	// Indices reserves the GPUs with the given indices, as listed by nvidia-smi
+	// +optional
+	// +kubebuilder:validation:MinItems=1
+	Indices []int32 `json:"indices,omitempty"`
+}
+
+// WorkloadsSpec defines the kinds of workloads the GPU nodes serve
+type WorkloadsSpec struct {
+	// VMPassthrough configures GPU nodes to pass whole GPUs through to KubeVirt virtual machines
+	// +optional
+	VMPassthrough *VMPassthroughSpec `json:"vmPassthrough,omitempty"`
+}
+
+// VMPassthroughSpec defines the full-GPU passthrough of GPU nodes to virtual machines
+type VMPassthroughSpec struct {
+	// Enabled deploys the VFIO manager and the sandbox device plugin on the GPU nodes labeled
+	// nvidia.com/gpu.workload.config=vm-passthrough, while the other GPU nodes keep serving containers
+	// +optional
+	Enabled bool `json:"enabled,omitempty"`
+
+	// DefaultWorkload is the workload of GPU nodes without the nvidia.com/gpu.workload.config label.
+	// Defaults to container
+	// +optional
+	// +kubebuilder:validation:Enum=container;vm-passthrough
+	DefaultWorkload string `json:"defaultWorkload,omitempty"`
+}
+
+// MIGStrategy is how MIG devices are advertised to the scheduler
+// +kubebuilder:validation:Enum=single;mixed
+type MIGStrategy string
+
+const (
+	// MIGStrategySingle advertises the MIG devices as nvidia.com/gpu, all GPUs of a node must use the same profile
+	MIGStrategySingle MIGStrategy = "single"
+
+	// MIGStrategyMixed advertises every MIG profile as its own resource, e.g. nvidia.com/mig-1g.10gb
+	MIGStrategyMixed MIGStrategy = "mixed"
+)
+
+// MIGSpec defines how GPUs are partitioned with Multi-Instance GPU
+// +kubebuilder:validation:XValidation:rule="!has(self.configMapRef) || !has(self.profiles) || size(self.profiles) == 0",message="configMapRef and profiles are mutually exclusive"
+type MIGSpec struct {
+	// Strategy is how MIG devices are advertised. Defaults to the chart default single
+	// +optional
+	Strategy MIGStrategy `json:"strategy,omitempty"`
+
+	// ConfigMapRef references a ConfigMap in the namespace of the CR with a complete mig-parted configuration,
+	// used by the MIG manager instead of the built-in profiles. Nodes select a configuration by name with
+	// the nvidia.com/mig.config label
+	// +optional
+	ConfigMapRef *ConfigMapKeyReference `json:"configMapRef,omitempty"`
+
+	// Profiles are named partitionings applied to the GPU nodes matching their node selector. The controller
+	// generates the mig-parted configuration from them and selects it on the nodes
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	Profiles []MIGProfile `json:"profiles,omitempty"`
+}
+
+// MIGProfile partitions all GPUs of the matching nodes the same way
+type MIGProfile struct {
+	// Name of the configuration, selected on the nodes with the nvidia.com/mig.config label
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`
+	// +kubebuilder:validation:MaxLength=63
+	// +kubebuilder:validation:XValidation:rule="self != 'all-disabled'",message="all-disabled is the built-in default configuration"
+	Name string `json:"name"`
+
+	// NodeSelector selects the GPU nodes partitioned with the profile. A node matching several profiles
+	// gets the first one
+	// +kubebuilder:validation:MinProperties=1
+	NodeSelector map[string]string `json:"nodeSelector"`
+
+	// Devices maps MIG device profiles to the number of instances created on every GPU, e.g. 1g.10gb: 7.
+	// MIG is disabled on the GPUs if empty
+	// +optional
+	Devices map[string]int32 `json:"devices,omitempty"`
+}
+
+// TimeSlicingSpec defines how many containers share a GPU
+type TimeSlicingSpec struct {
+	// Replicas is the number of nvidia.com/gpu every GPU is advertised as, 1 disables the sharing
+	// +kubebuilder:validation:Minimum=1
+	Replicas int32 `json:"replicas"`
+
+	// Pools overrides the replicas for the GPU nodes of Gardener worker pools
+	// +optional
+	// +listType=map
+	// +listMapKey=pool
+	Pools []TimeSlicingPool `json:"pools,omitempty"`
+}
+
+// TimeSlicingPool defines the sharing of the GPUs of a worker pool
+type TimeSlicingPool struct {
+	// Pool is the name of the Gardener worker pool
+	// +kubebuilder:validation:MinLength=1
+	Pool string `json:"pool"`
+
+	// Replicas is the number of nvidia.com/gpu every GPU of the pool is advertised as, 1 disables the sharing
+	// +kubebuilder:validation:Minimum=1
+	Replicas int32 `json:"replicas"`
+}
+
+// ConfigMapKeyReference selects a key of a ConfigMap in the namespace of the CR
+type ConfigMapKeyReference struct {
+	// Name of the ConfigMap
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+
+	// Key holding the configuration. Defaults to config.yaml
+	// +optional
+	Key string `json:"key,omitempty"`
+}
+
+// MonitoringSpec defines the GPU monitoring operands
+type MonitoringSpec struct {
+	// DCGM configures the NVIDIA Data Center GPU Manager host engine
+	// +optional
+	DCGM *DCGMSpec `json:"dcgm,omitempty"`
+
+	// Enabled creates a ServiceMonitor for DCGM Exporter and a PrometheusRule with default GPU alerts in the
+	// installation namespace, so the GPU metrics flow into a Prometheus Operator based monitoring stack.
+	// Nothing is created while DCGM Exporter is disabled or the Prometheus Operator CRDs are not installed
+	// +optional
+	Enabled bool `json:"enabled,omitempty"`
+
+	// Labels are added to the ServiceMonitor and PrometheusRule, e.g. to match the selectors of the Prometheus
+	// instance
+	// +optional
+	Labels map[string]string `json:"labels,omitempty"`
+}
+
+// DCGMSpec defines how the DCGM host engine is run
+type DCGMSpec struct {
+	// Standalone runs the DCGM host engine (nv-hostengine) in its own DaemonSet instead of embedded in
+	// DCGM Exporter, so other agents on the node can connect to it as well. DCGM Exporter then uses it remotely
+	// +optional
+	Standalone bool `json:"standalone,omitempty"`
+
+	// HostPort is the node port the standalone host engine listens on. Defaults to 5555
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65535
+	HostPort *int32 `json:"hostPort,omitempty"`
+}
+
+// HealthCheckSpec defines how unhealthy GPUs are detected and remediated
+type HealthCheckSpec struct {
+	// Enabled reports the GPU nodes with unhealthy GPUs in status.gpuHealth and labels them with
+	// operator.kyma-project.io/gpu-unhealthy=true
+	// +optional
+	Enabled bool `json:"enabled,omitempty"`
+
+	// Source of the GPU health. DevicePlugin detects the GPUs the device plugin withdrew after a critical
+	// XID error from the difference between the capacity and the allocatable nvidia.com/gpu of a node.
+	// DCGMExporter additionally reads the XID errors reported by DCGM Exporter. Defaults to DevicePlugin
+	// +optional
+	Source HealthSource `json:"source,omitempty"`
+
+	// CordonUnhealthyNodes cordons the nodes with unhealthy GPUs, so no new workloads are scheduled there.
+	// The controller uncordons the nodes it cordoned once their GPUs are healthy again
+	// +optional
+	CordonUnhealthyNodes bool `json:"cordonUnhealthyNodes,omitempty"`
+
+	// RestartCrashedDriverPods deletes driver pods that are in CrashLoopBackOff, so the driver DaemonSet
+	// recreates them without the back-off delay. A pod is restarted at most once every ten minutes
+	// +optional
+	RestartCrashedDriverPods bool `json:"restartCrashedDriverPods,omitempty"`
+}
+
+// HealthSource is where the GPU health is read from
+// +kubebuilder:validation:Enum=DevicePlugin;DCGMExporter
+type HealthSource string
+
+const (
+	// HealthSourceDevicePlugin reads the GPU health from the allocatable GPUs the device plugin advertises
+	HealthSourceDevicePlugin HealthSource = "DevicePlugin"
+
+	// HealthSourceDCGMExporter additionally reads the XID errors from the metrics of DCGM Exporter
+	HealthSourceDCGMExporter HealthSource = "DCGMExporter"
+)
+
+// ReadinessChecks defines the criteria for an acceptable GPU stack, evaluated after the installation
+type ReadinessChecks struct {
+	// Operands overrides which operands must be rolled out and ready on all their nodes.
+	// By default the driver, container-toolkit, device-plugin and validator are required and the
+	// other operands are only reported
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	Operands []OperandReadiness `json:"operands,omitempty"`
+
+	// MinHealthyNodesPercent is the share of GPU nodes that must be Ready and advertise allocatable
+	// nvidia.com/gpu resources. Defaults to 100
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=100
+	MinHealthyNodesPercent *int32 `json:"minHealthyNodesPercent,omitempty"`
+}
+
+// OperandReadiness defines whether an operand of the GPU operator is required for readiness
+type OperandReadiness struct {
+	// Name of the operand
+	// +kubebuilder:validation:Enum=driver;container-toolkit;device-plugin;gpu-feature-discovery;dcgm;dcgm-exporter;mig-manager;validator
+	Name string `json:"name"`
+
+	// Required makes the CR wait until the operand DaemonSet exists and is ready on all its nodes.
+	// A not ready optional operand is only mentioned in the Ready condition
+	Required bool `json:"required"`
+}
+
+// SpotSpec defines how the GPU operator operands are rolled out on spot/preemptible GPU nodes
+type SpotSpec struct {
+	// Enabled runs the operands, including the driver and container toolkit, with the system-node-critical
+	// priority so they are scheduled first on new nodes, and tolerates the well-known spot interruption taints
+	// +optional
+	Enabled bool `json:"enabled,omitempty"`
+
+	// Tolerations are additional taints the operands tolerate, e.g. the interruption taints of a
+	// node termination handler
+	// +optional
+	Tolerations []corev1.Toleration `json:"tolerations,omitempty"`
+}
+
+// ValidationSpec defines how the GPU worker pools are validated
+type ValidationSpec struct {
+	// Schedule runs the CUDA smoke test on every GPU worker pool on a cron schedule, e.g. "0 */6 * * *".
+	// A pool whose latest run failed flips the CR to Warning. Scheduled tests are disabled if empty
+	// +optional
+	Schedule string `json:"schedule,omitempty"`
+
+	// Enabled runs a CUDA workload on every GPU node once a spec is installed, and on GPU nodes that join
+	// later, and reports the result per node in status.cudaValidation
+	// +optional
+	Enabled bool `json:"enabled,omitempty"`
+
+	// Command is the CUDA workload run in the validation image, nvidia-smi if empty. With a validation image
+	// containing the CUDA samples, e.g. ["/cuda-samples/vectorAdd"]
+	// +optional
+	Command []string `json:"command,omitempty"`
+}
+
+// HelperImages defines the auxiliary images the controller launches besides the GPU operator itself
+type HelperImages struct {
+	// Mirror is a registry prefix all helper images are pulled from, e.g. registry.local/mirror.
+	// The source registry of each image is replaced by the prefix
+	// +optional
+	Mirror string `json:"mirror,omitempty"`
+
+	// Validation is the image of the validator and test workload pods
+	// +optional
+	Validation string `json:"validation,omitempty"`
+
+	// DevicePlugin is the image of the device plugin serving worker pools with reserved GPUs
+	// +optional
+	DevicePlugin string `json:"devicePlugin,omitempty"`
+}
+
+// GPUVendor is the vendor of the GPUs a GpuOperator installs the GPU operator for
+type GPUVendor string
+
+const (
+	// GPUVendorNVIDIA installs the NVIDIA GPU operator
+	GPUVendorNVIDIA GPUVendor = "NVIDIA"
+
+	// GPUVendorAMD installs the AMD GPU operator
+	GPUVendorAMD GPUVendor = "AMD"
+)
+
+// AMDSpec configures the AMD GPU operator
+type AMDSpec struct {
+	// DriverVersion is the ROCm version of the amdgpu driver the AMD GPU operator builds and loads on the GPU
+	// nodes with the Kernel Module Management operator, e.g. 6.3.3. If empty, the driver of the node image is used
+	// +optional
+	// +kubebuilder:validation:Pattern=`^[0-9]+\.[0-9]+(\.[0-9]+)?$`
+	DriverVersion string `json:"driverVersion,omitempty"`
+
+	// MetricsExporter deploys the AMD device metrics exporter on the GPU nodes
+	// +optional
+	MetricsExporter bool `json:"metricsExporter,omitempty"`
+}
+
+// InstallEngine is the mechanism used to install the GPU operator
+type InstallEngine string
+
+const (
+	// InstallEngineHelm installs the chart with the Helm SDK from the controller
+	InstallEngineHelm InstallEngine = "Helm"
+
+	// InstallEngineManifest applies pre-rendered manifests from the controller without Helm
+	InstallEngineManifest InstallEngine = "Manifest"
+)
+
+// ValuesSource is where the Garden Linux values of the chart come from
+type ValuesSource string
+
+const (
+	// ValuesSourceRemote fetches the values published by the Gardener project, with the embedded copy as fallback
+	ValuesSourceRemote ValuesSource = "Remote"
+
+	// ValuesSourceEmbedded uses the copy of the values embedded in the controller
+	ValuesSourceEmbedded ValuesSource = "Embedded"
+
+	// ValuesSourceConfigMap uses the values of spec.valuesConfigMapName instead of the Garden Linux values
+	ValuesSourceConfigMap ValuesSource = "ConfigMap"
+)
+
+// HelmValues are the values of the operand sections of the GPU operator chart. The fields use the names of
+// the chart values.
+type HelmValues struct {
+	// +optional
+	Driver *DriverValues `json:"driver,omitempty"`
+	// +optional
+	Toolkit *OperandValues `json:"toolkit,omitempty"`
+	// +optional
+	DevicePlugin *OperandValues `json:"devicePlugin,omitempty"`
+	// +optional
+	DCGMExporter *OperandValues `json:"dcgmExporter,omitempty"`
+	// +optional
+	MIGManager *OperandValues `json:"migManager,omitempty"`
+	// +optional
+	GFD *OperandValues `json:"gfd,omitempty"`
+}
+
+// OperandValues are the chart values common to the operands
+type OperandValues struct {
+	// Enabled deploys the operand
+	// +optional
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// Env are additional environment variables of the operand container
+	// +optional
+	Env []corev1.EnvVar `json:"env,omitempty"`
+
+	// Args are additional arguments of the operand container
+	// +optional
+	Args []string `json:"args,omitempty"`
+
+	// Resources of the operand container
+	// +optional
+	Resources *corev1.ResourceRequirements `json:"resources,omitempty"`
+
+	// ImagePullPolicy of the operand image
+	// +optional
+	// +kubebuilder:validation:Enum=Always;IfNotPresent;Never
+	ImagePullPolicy corev1.PullPolicy `json:"imagePullPolicy,omitempty"`
+}
+
+// DriverValues are the chart values of the driver
+type DriverValues struct {
+	OperandValues `json:",inline"`
+
+	// UsePrecompiled installs the precompiled driver images instead of compiling the driver on the node
+	// +optional
+	UsePrecompiled *bool `json:"usePrecompiled,omitempty"`
+}
+
+// ClusterPolicyManagement is the owner of the operand settings of the ClusterPolicy
+type ClusterPolicyManagement string
+
+const (
+	// ClusterPolicyManagementChart renders the ClusterPolicy from the chart values at install time
+	ClusterPolicyManagementChart ClusterPolicyManagement = "Chart"
+
+	// ClusterPolicyManagementController reconciles the ClusterPolicy from the spec in the controller
+	ClusterPolicyManagementController ClusterPolicyManagement = "Controller"
+)
+
+// InstallSpec defines the install behavior
+type InstallSpec struct {
+	// CleanupOrphanedResources deletes the remnants of a previous installation found before the first
+	// Helm install, i.e. Helm release secrets, ClusterPolicies and operand workloads without a deployed
+	// release. If not set, the install fails with the OrphanedResourcesDetected condition listing them
+	// +optional
+	CleanupOrphanedResources bool `json:"cleanupOrphanedResources,omitempty"`
+
+	// SkipPreflight installs without checking the prerequisites first, e.g. when the GPU worker pools
+	// scale up from zero only once GPU workloads are scheduled
+	// +optional
+	SkipPreflight bool `json:"skipPreflight,omitempty"`
+
+	// RetryInterval is the wait before Helm runs again after a failed attempt. It doubles with
+	// every further failed attempt, up to 30m. Defaults to 30s
+	// +optional
+	RetryInterval *metav1.Duration `json:"retryInterval,omitempty"`
+}
+
+// InstallRetryStatus describes the backoff between failed installation attempts
+type InstallRetryStatus struct {
+	// ObservedGeneration is the generation of the CR the attempts failed for. A spec change is retried right away
+	ObservedGeneration int64 `json:"observedGeneration"`
+
+	// Attempts is the number of failed attempts
+	Attempts int32 `json:"attempts"`
+
+	// LastFailureTime is when the last attempt failed
+	LastFailureTime metav1.Time `json:"lastFailureTime"`
+
+	// NextRetryTime is when Helm runs again
+	NextRetryTime metav1.Time `json:"nextRetryTime"`
+}
+
+// UninstallSpec defines the uninstall behavior
+type UninstallSpec struct {
+	// Timeout after which finalization stops retrying the Helm uninstall, deletes the remaining
+	// resources on a best-effort basis and releases the finalizer. Defaults to 30m
+	// +optional
+	Timeout *metav1.Duration `json:"timeout,omitempty"`
+
+	// BlockIfWorkloadsPresent pauses finalization while pods requesting nvidia.com/gpu are running outside
+	// the installation namespace. The offending pods are listed in the UninstallBlocked condition and the
+	// timeout starts once they are gone
+	// +optional
+	BlockIfWorkloadsPresent bool `json:"blockIfWorkloadsPresent,omitempty"`
+
+	// DrainGPUWorkloads evicts the pods requesting nvidia.com/gpu before the GPU operator is uninstalled,
+	// respecting their PodDisruptionBudgets. Pods managed by DaemonSets and pods created after the CR
+	// was deleted are not evicted
+	// +optional
+	DrainGPUWorkloads bool `json:"drainGpuWorkloads,omitempty"`
+
+	// DrainTimeout after which the uninstall continues even if GPU workloads could not be evicted.
+	// The uninstall timeout starts after it. Defaults to 10m
 	// +optional
-	// +kubebuilder:default="570"
-	DriverVersion string `json:"driverVersion,omitempty"`
+	DrainTimeout *metav1.Duration `json:"drainTimeout,omitempty"`
 
-	// Namespace where the GPU operator will be installed
+	// DeleteNamespace deletes the installation namespace after the GPU operator is uninstalled.
+	// Only a namespace created by the module is deleted, a pre-existing namespace is kept
 	// +optional
-	// +kubebuilder:default="gpu-operator"
-	Namespace string `json:"namespace,omitempty"`
+	DeleteNamespace bool `json:"deleteNamespace,omitempty"`
 
-	// ValuesConfigMapName is the name of the ConfigMap containing custom Helm values
-	// If specified, these values will be used instead of the default values
+	// DeleteCRDs deletes the NVIDIA CRDs after the GPU operator is uninstalled. Deleting a CRD deletes all
+	// custom resources of its kind in the cluster, including NVIDIADriver objects not created by the module.
+	// By default the CRDs are kept
 	// +optional
-	ValuesConfigMapName string `json:"valuesConfigMapName,omitempty"`
+	DeleteCRDs bool `json:"deleteCRDs,omitempty"`
+}
 
-	// Resources defines resource limits for GPU operator components
+// NamespaceDefaults defines the ResourceQuota and LimitRange provisioned in the installation namespace
+// +kubebuilder:validation:XValidation:rule="!has(self.quota) || !has(self.quota.limits) || has(self.containerDefaults) && has(self.containerDefaults.limits)",message="quota.limits requires containerDefaults.limits, the pods in the quota are rejected without limits"
+type NamespaceDefaults struct {
+	// Enabled creates the ResourceQuota and LimitRange; values not set below use sane defaults
 	// +optional
-	Resources *ResourceRequirements `json:"resources,omitempty"`
+	Enabled bool `json:"enabled,omitempty"`
+
+	// Quota defines the hard limits of the namespace ResourceQuota. The quota only counts the pods with a
+	// deadline, the CUDA validation and smoke test pods, not the operand DaemonSets
+	// +optional
+	Quota *NamespaceQuota `json:"quota,omitempty"`
+
+	// ContainerDefaults defines the default requests and limits applied by the LimitRange
+	// to containers that do not specify their own. The default requests are 10m CPU and 64Mi memory;
+	// limits are only applied if set, as they also apply to the operand containers
+	// +optional
+	ContainerDefaults *ResourceRequirements `json:"containerDefaults,omitempty"`
+}
+
+// NamespaceQuota defines the hard limits of the namespace ResourceQuota
+type NamespaceQuota struct {
+	// Pods is the maximum number of pods in the quota, unlimited if not set
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	Pods *int32 `json:"pods,omitempty"`
+
+	// Requests defines the total CPU and memory requests allowed in the quota, 10 CPU and 20Gi memory unless set
+	// +optional
+	Requests *Resources `json:"requests,omitempty"`
+
+	// Limits defines the total CPU and memory limits allowed in the quota, unlimited if not set
+	// +optional
+	Limits *Resources `json:"limits,omitempty"`
+}
+
+// GFDSpec defines GPU Feature Discovery settings
+type GFDSpec struct {
+	// ExtraLabelRules define custom node labels generated alongside the standard GFD labels
+	// The rules are rendered into a Node Feature Discovery NodeFeatureRule
+	// +optional
+	ExtraLabelRules []GFDLabelRule `json:"extraLabelRules,omitempty"`
+}
+
+// GFDLabelRule defines a set of labels applied to nodes whose GFD labels match all expressions
+type GFDLabelRule struct {
+	// Name of the rule
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+
+	// Labels to add to matching nodes, e.g. "gpu.kyma-project.io/memory-class": "large"
+	// +kubebuilder:validation:MinProperties=1
+	Labels map[string]string `json:"labels"`
+
+	// MatchExpressions on GFD labels (e.g. nvidia.com/gpu.memory); all of them must match
+	// If empty, the labels are applied to every node with NVIDIA GPUs
+	// +optional
+	MatchExpressions []GFDLabelExpression `json:"matchExpressions,omitempty"`
+}
+
+// GFDLabelExpression matches a single GFD label
+type GFDLabelExpression struct {
+	// Key is the GFD label name, e.g. nvidia.com/gpu.memory
+	// +kubebuilder:validation:MinLength=1
+	Key string `json:"key"`
+
+	// Operator compares the label value against Values
+	// +kubebuilder:validation:Enum=In;NotIn;Exists;DoesNotExist;Gt;Lt
+	Operator string `json:"operator"`
+
+	// Values used by the operator; Gt and Lt expect exactly one integer value
+	// +optional
+	Values []string `json:"values,omitempty"`
 }
 
 // ResourceRequirements defines CPU and memory requirements
@@ -74,20 +1255,587 @@ type GpuOperatorStatus struct {
 	// +optional
 	Conditions []metav1.Condition `json:"conditions,omitempty"`
 
-	// InstalledVersion is the version of the GPU operator currently installed
+	// InstalledVersion is the driver version of the GPU operator currently installed, as read from the
+	// deployed Helm release
 	// +optional
 	InstalledVersion string `json:"installedVersion,omitempty"`
 
+	// InstalledChartVersion is the version of the GPU operator chart currently installed
+	// +optional
+	InstalledChartVersion string `json:"installedChartVersion,omitempty"`
+
+	// AvailableChartVersion is the newest version of the GPU operator chart in the Helm repository within
+	// the scope of spec.upgradePolicy.channel, or the newest version for the None channel
+	// +optional
+	AvailableChartVersion string `json:"availableChartVersion,omitempty"`
+
+	// UpgradedChartVersion is the chart version the upgrade channel selected, which is installed instead of
+	// an older spec.chartVersion
+	// +optional
+	UpgradedChartVersion string `json:"upgradedChartVersion,omitempty"`
+
+	// LastChartVersionCheckTime is when the Helm repository was last queried for new chart versions
+	// +optional
+	LastChartVersionCheckTime *metav1.Time `json:"lastChartVersionCheckTime,omitempty"`
+
+	// PendingUpgrade is the upgrade waiting for approval, if spec.upgradePolicy.requireApproval is set
+	// +optional
+	PendingUpgrade *UpgradePlan `json:"pendingUpgrade,omitempty"`
+
+	// AppliedDriverVersion is the driver version of the spec, spec.componentVersions.driver or
+	// spec.driverVersion, the installation last proceeded with. A different version is a driver
+	// change that requires approval
+	// +optional
+	AppliedDriverVersion string `json:"appliedDriverVersion,omitempty"`
+
+	// DriverRecommendation records the driver branch selected because spec.driverVersion is empty
+	// +optional
+	DriverRecommendation *DriverRecommendation `json:"driverRecommendation,omitempty"`
+
+	// RuntimeClassName is the RuntimeClass of the NVIDIA container runtime GPU workloads reference
+	// +optional
+	RuntimeClassName string `json:"runtimeClassName,omitempty"`
+
+	// ObservedReinstall is the value of the operator.kyma-project.io/reinstall annotation the last
+	// reinstall was run for
+	// +optional
+	ObservedReinstall string `json:"observedReinstall,omitempty"`
+
+	// ObservedForceReinstall is the value of the operator.kyma-project.io/force-reinstall annotation the
+	// last forced Helm upgrade was run for
+	// +optional
+	ObservedForceReinstall string `json:"observedForceReinstall,omitempty"`
+
+	// ClusterPolicy reports the state of the ClusterPolicy of the GPU operator release
+	// +optional
+	ClusterPolicy *ClusterPolicyStatus `json:"clusterPolicy,omitempty"`
+
+	// Components reports the rollout of the GPU operator and its operands
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	Components []ComponentStatus `json:"components,omitempty"`
+
+	// InstallHash is the hash of the Helm install inputs, the charts and their values derived from the spec
+	// and the custom values, of the last successful installation
+	// +optional
+	InstallHash string `json:"installHash,omitempty"`
+
+	// InstallRetry reports the failed Helm installation attempts since the last successful installation
+	// +optional
+	InstallRetry *InstallRetryStatus `json:"installRetry,omitempty"`
+
+	// ValuesSource is where the Garden Linux values of the last installation came from, Embedded if the
+	// Remote values could not be fetched
+	// +optional
+	ValuesSource ValuesSource `json:"valuesSource,omitempty"`
+
+	// LastResyncTime is when the deployed Helm release was last compared with the desired installation
+	// +optional
+	LastResyncTime *metav1.Time `json:"lastResyncTime,omitempty"`
+
+	// Nodes reports the results of the operator validator on every GPU node
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	Nodes []NodeStatus `json:"nodes,omitempty"`
+
+	// Pools reports the rollout of the GPU stack per Gardener worker pool
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	Pools []PoolStatus `json:"pools,omitempty"`
+
+	// GPUNodes is the inventory of the GPU nodes of the cluster
+	// +optional
+	GPUNodes *GPUNodeInventory `json:"gpuNodes,omitempty"`
+
+	// GPUInventory summarizes the GPU nodes per GPU model from the GFD and NFD labels, sorted by product
+	// +optional
+	// +listType=map
+	// +listMapKey=product
+	GPUInventory []GPUModelInventory `json:"gpuInventory,omitempty"`
+
+	// MIG reports the MIG configuration of the GPU nodes, if spec.mig is set
+	// +optional
+	MIG *MIGStatus `json:"mig,omitempty"`
+
+	// CUDAValidation reports the CUDA validation of the GPU nodes, if spec.validation.enabled is set
+	// +optional
+	CUDAValidation *CUDAValidationStatus `json:"cudaValidation,omitempty"`
+
+	// PendingGPUPods reports the pods that cannot be scheduled because not enough nvidia.com/gpu is free
+	// +optional
+	PendingGPUPods *PendingGPUPods `json:"pendingGpuPods,omitempty"`
+
+	// GPUAllocation reports the GPUs allocated to the pods scheduled in the cluster, per namespace
+	// +optional
+	GPUAllocation *GPUAllocation `json:"gpuAllocation,omitempty"`
+
+	// Compatibility reports whether the versions of the cluster and the installation are supported
+	// +optional
+	Compatibility *CompatibilityReport `json:"compatibility,omitempty"`
+
+	// GPUHealth reports the GPU nodes with unhealthy GPUs, if spec.healthCheck is enabled
+	// +optional
+	GPUHealth *GPUHealthStatus `json:"gpuHealth,omitempty"`
+
+	// DriverUpgrade reports the progress of the driver upgrade on the GPU nodes while one is rolling
+	// +optional
+	DriverUpgrade *DriverUpgradeStatus `json:"driverUpgrade,omitempty"`
+
 	// ObservedGeneration is the generation of the GpuOperator CR that was last processed
 	// +optional
 	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
 }
 
+// DriverRecommendation is a driver branch selected from the GPU models detected in the cluster
+type DriverRecommendation struct {
+	// Version is the selected driver branch
+	Version string `json:"version"`
+
+	// GPUModels are the detected GPU models and their architecture, e.g. "NVIDIA-A100-SXM4-40GB (ampere)"
+	// +optional
+	GPUModels []string `json:"gpuModels,omitempty"`
+
+	// Reason explains the selection
+	// +optional
+	Reason string `json:"reason,omitempty"`
+}
+
+// PendingGPUPods summarizes the pods pending for lack of GPUs
+type PendingGPUPods struct {
+	// Count is the number of pods pending for lack of GPUs
+	Count int32 `json:"count"`
+
+	// TopNamespaces are the namespaces with the most pending pods, at most five
+	// +optional
+	TopNamespaces []NamespacePodCount `json:"topNamespaces,omitempty"`
+}
+
+// GPUHealthStatus reports the health of the GPUs
+type GPUHealthStatus struct {
+	// UnhealthyGPUs is the number of unhealthy GPUs in the cluster
+	UnhealthyGPUs int64 `json:"unhealthyGpus"`
+
+	// Nodes are the GPU nodes with unhealthy GPUs, sorted by name
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	Nodes []UnhealthyGPUNode `json:"nodes,omitempty"`
+
+	// RestartedDriverPods are the driver pods restarted after a crash loop by the last reconcile
+	// +optional
+	RestartedDriverPods []string `json:"restartedDriverPods,omitempty"`
+}
+
+// UnhealthyGPUNode is a GPU node with unhealthy GPUs
+type UnhealthyGPUNode struct {
+	// Name of the node
+	Name string `json:"name"`
+
+	// UnhealthyGPUs is the number of unhealthy GPUs of the node
+	UnhealthyGPUs int64 `json:"unhealthyGpus"`
+
+	// Reason describes why the GPUs are unhealthy, e.g. the withdrawn GPUs or the XID errors
+	Reason string `json:"reason"`
+
+	// Cordoned reports whether the controller cordoned the node
+	// +optional
+	Cordoned bool `json:"cordoned,omitempty"`
+
+	// Since is when the GPUs of the node were first seen unhealthy
+	Since metav1.Time `json:"since"`
+}
+
+// GPUAllocation is a snapshot of the GPUs allocated to pods
+type GPUAllocation struct {
+	// GPUs is the number of GPUs allocated in the cluster
+	GPUs int64 `json:"gpus"`
+
+	// Namespaces are the namespaces with allocated GPUs, most GPUs first, at most twenty
+	// +optional
+	Namespaces []NamespaceGPUAllocation `json:"namespaces,omitempty"`
+
+	// Time the snapshot was taken
+	Time metav1.Time `json:"time"`
+}
+
+// CompatibilityResult is the outcome of a compatibility check
+// +kubebuilder:validation:Enum=Supported;Unsupported;Unknown
+type CompatibilityResult string
+
+const (
+	// CompatibilitySupported versions are validated together
+	CompatibilitySupported CompatibilityResult = "Supported"
+	// CompatibilityUnsupported versions are known not to work together or are end-of-life
+	CompatibilityUnsupported CompatibilityResult = "Unsupported"
+	// CompatibilityUnknown versions could not be determined or were not validated
+	CompatibilityUnknown CompatibilityResult = "Unknown"
+)
+
+// CompatibilityReport summarizes the compatibility checks of the installation
+type CompatibilityReport struct {
+	// Result is Unsupported if any check is unsupported, Unknown if any check is unknown, and Supported otherwise
+	Result CompatibilityResult `json:"result"`
+
+	// Checks are the evaluated versions
+	// +optional
+	// +listType=map
+	// +listMapKey=component
+	Checks []CompatibilityCheck `json:"checks,omitempty"`
+
+	// Time the checks were evaluated
+	Time metav1.Time `json:"time"`
+}
+
+// CompatibilityCheck is the result of checking one version
+type CompatibilityCheck struct {
+	// Component is the checked version: kubernetes, kernel, gpuModels, driverBranch or chartVersion
+	Component string `json:"component"`
+
+	// Version is the checked version, empty if it could not be determined
+	// +optional
+	Version string `json:"version,omitempty"`
+
+	// Result of the check
+	Result CompatibilityResult `json:"result"`
+
+	// Message explains the result
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
+// NamespaceGPUAllocation is the number of GPUs allocated to the pods of a namespace
+type NamespaceGPUAllocation struct {
+	// Namespace of the pods
+	Namespace string `json:"namespace"`
+
+	// GPUs allocated to the pods of the namespace
+	GPUs int64 `json:"gpus"`
+
+	// Pods of the namespace with allocated GPUs
+	Pods int32 `json:"pods"`
+}
+
+// NamespacePodCount is the number of pods of a namespace
+type NamespacePodCount struct {
+	// Namespace of the pods
+	Namespace string `json:"namespace"`
+
+	// Count is the number of pods
+	Count int32 `json:"count"`
+}
+
+// NodeStatus is the observed state of a GPU node
+type NodeStatus struct {
+	// Name of the node
+	Name string `json:"name"`
+
+	// Validations are the results of the operator validator on the node
+	Validations NodeValidations `json:"validations"`
+}
+
+// ClusterPolicyStatus is the state the NVIDIA GPU operator reports on its ClusterPolicy
+type ClusterPolicyStatus struct {
+	// Name of the ClusterPolicy
+	Name string `json:"name"`
+
+	// State of the ClusterPolicy, ready once all enabled operands are ready
+	// +optional
+	State string `json:"state,omitempty"`
+
+	// Conditions are the conditions of the ClusterPolicy
+	// +optional
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+// ComponentStatus is the rollout of a component of the GPU operator installation
+type ComponentStatus struct {
+	// Name of the component, e.g. gpu-operator, cluster-policy or the operand name used by spec.readinessChecks
+	Name string `json:"name"`
+
+	// Kind of the object the component is deployed as
+	// +kubebuilder:validation:Enum=Deployment;DaemonSet;ClusterPolicy
+	Kind string `json:"kind"`
+
+	// Ready reports whether the component is rolled out and ready on all its pods
+	Ready bool `json:"ready"`
+
+	// DesiredPods is the number of pods the component should run
+	// +optional
+	DesiredPods int32 `json:"desiredPods,omitempty"`
+
+	// ReadyPods is the number of ready pods
+	// +optional
+	ReadyPods int32 `json:"readyPods,omitempty"`
+
+	// UpdatedPods is the number of pods running the current revision
+	// +optional
+	UpdatedPods int32 `json:"updatedPods,omitempty"`
+
+	// Message describes why the component is not ready
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
+// PoolStatus is the rollout of the GPU stack on the GPU nodes of a worker pool
+type PoolStatus struct {
+	// Name of the worker pool
+	Name string `json:"name"`
+
+	// Nodes is the number of GPU nodes of the pool
+	Nodes int32 `json:"nodes"`
+
+	// ReadyNodes is the number of GPU nodes that are Ready and passed the operator validator
+	ReadyNodes int32 `json:"readyNodes"`
+
+	// ReadyPercent is the percentage of ready GPU nodes
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=100
+	ReadyPercent int32 `json:"readyPercent"`
+
+	// DriverVersions are the driver versions reported by GFD on the nodes of the pool, sorted. There is more
+	// than one while a driver upgrade is rolling through the pool.
+	// +optional
+	DriverVersions []string `json:"driverVersions,omitempty"`
+
+	// UpgradeStep is the least advanced step of the driver upgrades in progress on the nodes of the pool,
+	// upgrade-failed if an upgrade failed
+	// +optional
+	UpgradeStep string `json:"upgradeStep,omitempty"`
+
+	// UpgradingNodes is the number of nodes with a driver upgrade in progress or failed
+	// +optional
+	UpgradingNodes int32 `json:"upgradingNodes,omitempty"`
+}
+
+// UpgradePlan is an upgrade waiting for approval
+type UpgradePlan struct {
+	// ID identifies the plan. Annotate the CR with operator.kyma-project.io/approve-upgrade=<id> to approve it
+	ID string `json:"id"`
+
+	// Chart is the chart upgrade selected by the upgrade channel
+	// +optional
+	Chart *VersionChange `json:"chart,omitempty"`
+
+	// Driver is the change of the driver version in the spec, which rolls the driver on every GPU node
+	// +optional
+	Driver *VersionChange `json:"driver,omitempty"`
+
+	// Since is when the plan started waiting for approval
+	Since metav1.Time `json:"since"`
+}
+
+// VersionChange is a change from one version to another
+type VersionChange struct {
+	// From is the current version
+	// +optional
+	From string `json:"from,omitempty"`
+
+	// To is the version after the upgrade
+	To string `json:"to"`
+}
+
+// DriverUpgradeStatus is the progress of the driver upgrade on the GPU nodes
+type DriverUpgradeStatus struct {
+	// UpgradedNodes is the number of GPU nodes whose driver upgrade is done
+	UpgradedNodes int32 `json:"upgradedNodes"`
+
+	// UpgradingNodes is the number of GPU nodes with a driver upgrade in progress
+	UpgradingNodes int32 `json:"upgradingNodes"`
+
+	// FailedNodes is the number of GPU nodes whose driver upgrade failed
+	FailedNodes int32 `json:"failedNodes"`
+
+	// Nodes are the GPU nodes tracked by the upgrade controller, sorted by name
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	Nodes []DriverUpgradeNode `json:"nodes,omitempty"`
+}
+
+// DriverUpgradeNode is the driver upgrade progress of a GPU node
+type DriverUpgradeNode struct {
+	// Name of the node
+	Name string `json:"name"`
+
+	// State is the upgrade state the upgrade controller reports for the node, e.g. cordon-required,
+	// pod-restart-required, upgrade-done or upgrade-failed
+	State string `json:"state"`
+
+	// DriverVersion is the driver version GFD reports for the node
+	// +optional
+	DriverVersion string `json:"driverVersion,omitempty"`
+}
+
+// MIGStatus is the MIG configuration rollout on the GPU nodes
+type MIGStatus struct {
+	// Nodes are the GPU nodes with a selected MIG configuration, sorted by name
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	Nodes []MIGNodeStatus `json:"nodes,omitempty"`
+}
+
+// MIGNodeStatus is the MIG configuration of a GPU node
+type MIGNodeStatus struct {
+	// Name of the node
+	Name string `json:"name"`
+
+	// Config is the MIG configuration selected on the node
+	Config string `json:"config"`
+
+	// State is the state the MIG manager reports for applying the configuration: pending, rebooting, success
+	// or failed
+	// +optional
+	State string `json:"state,omitempty"`
+}
+
+// CUDAValidationStatus is the result of the CUDA validation of the GPU nodes
+type CUDAValidationStatus struct {
+	// ObservedGeneration is the generation of the spec the GPU nodes are validated for
+	ObservedGeneration int64 `json:"observedGeneration"`
+
+	// Nodes are the results per GPU node, sorted by name
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	Nodes []CUDAValidationNode `json:"nodes,omitempty"`
+}
+
+// CUDAValidationNode is the result of the CUDA validation of a GPU node
+type CUDAValidationNode struct {
+	// Name of the node
+	Name string `json:"name"`
+
+	// Result of the CUDA workload on the node
+	Result ValidationResult `json:"result"`
+
+	// Message describes a failed or pending validation
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
+// GPUNodeInventory summarizes the GPU nodes of the cluster
+type GPUNodeInventory struct {
+	// Count is the number of GPU nodes
+	Count int32 `json:"count"`
+
+	// AllocatableGPUs is the sum of the allocatable nvidia.com/gpu, or amd.com/gpu for the vendor AMD, of the GPU nodes
+	AllocatableGPUs int64 `json:"allocatableGpus"`
+
+	// DriverReadyNodes is the number of GPU nodes with a working driver
+	DriverReadyNodes int32 `json:"driverReadyNodes"`
+
+	// Nodes are the GPU nodes, sorted by name
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	Nodes []GPUNode `json:"nodes,omitempty"`
+}
+
+// GPUNode is a GPU node of the inventory
+type GPUNode struct {
+	// Name of the node
+	Name string `json:"name"`
+
+	// AllocatableGPUs is the allocatable nvidia.com/gpu, or amd.com/gpu for the vendor AMD, of the node
+	AllocatableGPUs int64 `json:"allocatableGpus"`
+
+	// DriverReady reports whether the driver works on the node
+	DriverReady bool `json:"driverReady"`
+
+	// DriverVersion is the driver version GFD reports for the node
+	// +optional
+	DriverVersion string `json:"driverVersion,omitempty"`
+
+	// KernelVersion is the kernel version of the node
+	// +optional
+	KernelVersion string `json:"kernelVersion,omitempty"`
+}
+
+// GPUModelInventory summarizes the GPU nodes with one GPU model
+type GPUModelInventory struct {
+	// Product is the GPU model GFD reports, without the MIG and sharing suffixes, e.g. "NVIDIA-A100-SXM4-40GB",
+	// or the AMD node labeller reports for the vendor AMD
+	Product string `json:"product"`
+
+	// Family is the GPU architecture, e.g. "ampere"
+	// +optional
+	Family string `json:"family,omitempty"`
+
+	// MemoryMiB is the memory of one GPU in MiB
+	// +optional
+	MemoryMiB int64 `json:"memoryMiB,omitempty"`
+
+	// ComputeCapability is the CUDA compute capability of the GPUs, e.g. "8.0"
+	// +optional
+	ComputeCapability string `json:"computeCapability,omitempty"`
+
+	// MIGCapable reports whether the GPUs support MIG
+	MIGCapable bool `json:"migCapable"`
+
+	// Nodes is the number of GPU nodes with the model
+	Nodes int32 `json:"nodes"`
+
+	// GPUs is the number of physical GPUs of the nodes
+	GPUs int64 `json:"gpus"`
+
+	// AllocatableGPUs is the sum of the allocatable nvidia.com/gpu, or amd.com/gpu for the vendor AMD, of the nodes
+	AllocatableGPUs int64 `json:"allocatableGpus"`
+
+	// Pools are the Gardener worker pools of the nodes, sorted
+	// +optional
+	Pools []string `json:"pools,omitempty"`
+}
+
+// NodeValidations are the results of the validations of the operator validator on a node
+type NodeValidations struct {
+	// Driver is the result of the driver validation
+	// +optional
+	Driver ValidationResult `json:"driver,omitempty"`
+
+	// Toolkit is the result of the container toolkit validation
+	// +optional
+	Toolkit ValidationResult `json:"toolkit,omitempty"`
+
+	// CUDA is the result of the CUDA validation
+	// +optional
+	CUDA ValidationResult `json:"cuda,omitempty"`
+
+	// Plugin is the result of the device plugin validation
+	// +optional
+	Plugin ValidationResult `json:"plugin,omitempty"`
+
+	// Message describes the first failed validation
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
+// ValidationResult is the outcome of a single validation
+// +kubebuilder:validation:Enum=Passed;Failed;Pending
+type ValidationResult string
+
+const (
+	// ValidationPassed means the validation completed successfully
+	ValidationPassed ValidationResult = "Passed"
+
+	// ValidationFailed means the validation exited with an error
+	ValidationFailed ValidationResult = "Failed"
+
+	// ValidationPending means the validation has not completed yet
+	ValidationPending ValidationResult = "Pending"
+)
+
 // +kubebuilder:object:root=true
 // +kubebuilder:subresource:status
-// +kubebuilder:resource:scope=Namespaced
+// +kubebuilder:storageversion
+// +kubebuilder:resource:scope=Namespaced,categories=kyma-modules,shortName=gpuop
 // +kubebuilder:printcolumn:name="State",type=string,JSONPath=`.status.state`
 // +kubebuilder:printcolumn:name="Driver Version",type=string,JSONPath=`.spec.driverVersion`
+// +kubebuilder:printcolumn:name="Compatibility",type=string,JSONPath=`.status.compatibility.result`,priority=1
+// +kubebuilder:printcolumn:name="Conditions",type=string,JSONPath=`.status.conditions[?(@.status=="True")].type`
 // +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
 
 // GpuOperator is the Schema for the gpuoperators API
@@ -16,6 +16,8 @@ limitations under the License.
 
 package v1alpha1
 
+import metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
 // State is a string type that represents the state of the module.
 type State string
 
@@ -23,7 +25,7 @@ const (
 	// StateReady signifies that the module is installed and ready.
 	StateReady State = "Ready"
 
-	// StateProcessing signifies that the module is being processed.
+	// StateProcessing signifies that the module is being installed or updated to a new generation of the spec.
 	StateProcessing State = "Processing"
 
 	// StateError signifies that the module is in an error state.
@@ -31,13 +33,72 @@ const (
 
 	// StateDeleting signifies that the module is being deleted.
 	StateDeleting State = "Deleting"
+
+	// StateWarning signifies that the module is installed but not fully healthy, e.g. after operands
+	// of an installation that was Ready became unavailable, or while operands fail on some of the nodes.
+	StateWarning State = "Warning"
 )
 
 // Status defines the observed state of Module CR.
 type Status struct {
 	// State signifies current state of Module CR.
-	// Value can be one of ("Ready", "Processing", "Error", "Deleting").
+	// Value can be one of ("Ready", "Processing", "Error", "Deleting", "Warning").
 	// +kubebuilder:validation:Required
-	// +kubebuilder:validation:Enum=Processing;Deleting;Ready;Error
+	// +kubebuilder:validation:Enum=Processing;Deleting;Ready;Error;Warning
 	State State `json:"state"`
+
+	// ModuleVersion is the version of the module controller that last reconciled the CR, in the format
+	// of the versions the ModuleReleaseMeta assigns to the release channels
+	// +optional
+	ModuleVersion string `json:"moduleVersion,omitempty"`
+
+	// LastOperation reports the stage the running or last install, upgrade or uninstall reached
+	// +optional
+	LastOperation *LastOperation `json:"lastOperation,omitempty"`
+}
+
+// Operation is an operation of the module on the cluster
+// +kubebuilder:validation:Enum=Install;Upgrade;Uninstall
+type Operation string
+
+const (
+	// OperationInstall is the first installation of the module
+	OperationInstall Operation = "Install"
+
+	// OperationUpgrade installs a changed spec over a successful installation
+	OperationUpgrade Operation = "Upgrade"
+
+	// OperationUninstall removes the module from the cluster
+	OperationUninstall Operation = "Uninstall"
+)
+
+// OperationState is the state of an operation
+// +kubebuilder:validation:Enum=Processing;Succeeded;Failed
+type OperationState string
+
+const (
+	// OperationProcessing operations are in progress
+	OperationProcessing OperationState = "Processing"
+
+	// OperationSucceeded operations completed
+	OperationSucceeded OperationState = "Succeeded"
+
+	// OperationFailed operations failed at the stage of the description and are retried
+	OperationFailed OperationState = "Failed"
+)
+
+// LastOperation describes the stage an operation reached
+type LastOperation struct {
+	// Operation is the running or last operation
+	Operation Operation `json:"operation"`
+
+	// State of the operation
+	State OperationState `json:"state"`
+
+	// Description of the stage the operation reached
+	// +optional
+	Description string `json:"description,omitempty"`
+
+	// LastUpdateTime is when the operation reached the stage
+	LastUpdateTime metav1.Time `json:"lastUpdateTime"`
 }
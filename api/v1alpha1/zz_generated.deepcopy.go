@@ -21,163 +21,1993 @@ limitations under the License.
 package v1alpha1
 
 import (
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1"
-	runtime "k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
 )
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *GpuOperator) DeepCopyInto(out *GpuOperator) {
+func (in *AMDSpec) DeepCopyInto(out *AMDSpec) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	in.Spec.DeepCopyInto(&out.Spec)
-	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GpuOperator.
-func (in *GpuOperator) DeepCopy() *GpuOperator {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AMDSpec.
+func (in *AMDSpec) DeepCopy() *AMDSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(GpuOperator)
+	out := new(AMDSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *GpuOperator) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CUDAValidationNode) DeepCopyInto(out *CUDAValidationNode) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CUDAValidationNode.
+func (in *CUDAValidationNode) DeepCopy() *CUDAValidationNode {
+	if in == nil {
+		return nil
 	}
-	return nil
+	out := new(CUDAValidationNode)
+	in.DeepCopyInto(out)
+	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *GpuOperatorList) DeepCopyInto(out *GpuOperatorList) {
+func (in *CUDAValidationStatus) DeepCopyInto(out *CUDAValidationStatus) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ListMeta.DeepCopyInto(&out.ListMeta)
-	if in.Items != nil {
-		in, out := &in.Items, &out.Items
-		*out = make([]GpuOperator, len(*in))
+	if in.Nodes != nil {
+		in, out := &in.Nodes, &out.Nodes
+		*out = make([]CUDAValidationNode, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CUDAValidationStatus.
+func (in *CUDAValidationStatus) DeepCopy() *CUDAValidationStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(CUDAValidationStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterPolicyStatus) DeepCopyInto(out *ClusterPolicyStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GpuOperatorList.
-func (in *GpuOperatorList) DeepCopy() *GpuOperatorList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterPolicyStatus.
+func (in *ClusterPolicyStatus) DeepCopy() *ClusterPolicyStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(GpuOperatorList)
+	out := new(ClusterPolicyStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *GpuOperatorList) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CompatibilityCheck) DeepCopyInto(out *CompatibilityCheck) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CompatibilityCheck.
+func (in *CompatibilityCheck) DeepCopy() *CompatibilityCheck {
+	if in == nil {
+		return nil
 	}
-	return nil
+	out := new(CompatibilityCheck)
+	in.DeepCopyInto(out)
+	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *GpuOperatorSpec) DeepCopyInto(out *GpuOperatorSpec) {
+func (in *CompatibilityReport) DeepCopyInto(out *CompatibilityReport) {
 	*out = *in
-	if in.Resources != nil {
-		in, out := &in.Resources, &out.Resources
-		*out = new(ResourceRequirements)
+	if in.Checks != nil {
+		in, out := &in.Checks, &out.Checks
+		*out = make([]CompatibilityCheck, len(*in))
+		copy(*out, *in)
+	}
+	in.Time.DeepCopyInto(&out.Time)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CompatibilityReport.
+func (in *CompatibilityReport) DeepCopy() *CompatibilityReport {
+	if in == nil {
+		return nil
+	}
+	out := new(CompatibilityReport)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ComponentStatus) DeepCopyInto(out *ComponentStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ComponentStatus.
+func (in *ComponentStatus) DeepCopy() *ComponentStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ComponentStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ComponentVersions) DeepCopyInto(out *ComponentVersions) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ComponentVersions.
+func (in *ComponentVersions) DeepCopy() *ComponentVersions {
+	if in == nil {
+		return nil
+	}
+	out := new(ComponentVersions)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConfigMapKeyReference) DeepCopyInto(out *ConfigMapKeyReference) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigMapKeyReference.
+func (in *ConfigMapKeyReference) DeepCopy() *ConfigMapKeyReference {
+	if in == nil {
+		return nil
+	}
+	out := new(ConfigMapKeyReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DCGMSpec) DeepCopyInto(out *DCGMSpec) {
+	*out = *in
+	if in.HostPort != nil {
+		in, out := &in.HostPort, &out.HostPort
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DCGMSpec.
+func (in *DCGMSpec) DeepCopy() *DCGMSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(DCGMSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DRASpec) DeepCopyInto(out *DRASpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DRASpec.
+func (in *DRASpec) DeepCopy() *DRASpec {
+	if in == nil {
+		return nil
+	}
+	out := new(DRASpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DaemonSetUpdateStrategy) DeepCopyInto(out *DaemonSetUpdateStrategy) {
+	*out = *in
+	if in.MaxUnavailable != nil {
+		in, out := &in.MaxUnavailable, &out.MaxUnavailable
+		*out = new(intstr.IntOrString)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DaemonSetUpdateStrategy.
+func (in *DaemonSetUpdateStrategy) DeepCopy() *DaemonSetUpdateStrategy {
+	if in == nil {
+		return nil
+	}
+	out := new(DaemonSetUpdateStrategy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DaemonSetsSpec) DeepCopyInto(out *DaemonSetsSpec) {
+	*out = *in
+	if in.UpdateStrategy != nil {
+		in, out := &in.UpdateStrategy, &out.UpdateStrategy
+		*out = new(DaemonSetUpdateStrategy)
 		(*in).DeepCopyInto(*out)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GpuOperatorSpec.
-func (in *GpuOperatorSpec) DeepCopy() *GpuOperatorSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DaemonSetsSpec.
+func (in *DaemonSetsSpec) DeepCopy() *DaemonSetsSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(GpuOperatorSpec)
+	out := new(DaemonSetsSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *GpuOperatorStatus) DeepCopyInto(out *GpuOperatorStatus) {
+func (in *DevicePluginSpec) DeepCopyInto(out *DevicePluginSpec) {
 	*out = *in
-	out.Status = in.Status
-	if in.Conditions != nil {
-		in, out := &in.Conditions, &out.Conditions
-		*out = make([]v1.Condition, len(*in))
+	if in.ReservedGPUs != nil {
+		in, out := &in.ReservedGPUs, &out.ReservedGPUs
+		*out = make([]ReservedGPUs, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GpuOperatorStatus.
-func (in *GpuOperatorStatus) DeepCopy() *GpuOperatorStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DevicePluginSpec.
+func (in *DevicePluginSpec) DeepCopy() *DevicePluginSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(GpuOperatorStatus)
+	out := new(DevicePluginSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ResourceRequirements) DeepCopyInto(out *ResourceRequirements) {
+func (in *DriverRecommendation) DeepCopyInto(out *DriverRecommendation) {
 	*out = *in
-	if in.Limits != nil {
-		in, out := &in.Limits, &out.Limits
-		*out = new(Resources)
+	if in.GPUModels != nil {
+		in, out := &in.GPUModels, &out.GPUModels
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DriverRecommendation.
+func (in *DriverRecommendation) DeepCopy() *DriverRecommendation {
+	if in == nil {
+		return nil
+	}
+	out := new(DriverRecommendation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DriverSpec) DeepCopyInto(out *DriverSpec) {
+	*out = *in
+	if in.Upgrade != nil {
+		in, out := &in.Upgrade, &out.Upgrade
+		*out = new(DriverUpgradeSpec)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DriverSpec.
+func (in *DriverSpec) DeepCopy() *DriverSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(DriverSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DriverUpgradeNode) DeepCopyInto(out *DriverUpgradeNode) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DriverUpgradeNode.
+func (in *DriverUpgradeNode) DeepCopy() *DriverUpgradeNode {
+	if in == nil {
+		return nil
+	}
+	out := new(DriverUpgradeNode)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DriverUpgradeSpec) DeepCopyInto(out *DriverUpgradeSpec) {
+	*out = *in
+	if in.MaxParallelUpgrades != nil {
+		in, out := &in.MaxParallelUpgrades, &out.MaxParallelUpgrades
+		*out = new(int32)
 		**out = **in
 	}
-	if in.Requests != nil {
-		in, out := &in.Requests, &out.Requests
-		*out = new(Resources)
+	if in.MaxUnavailable != nil {
+		in, out := &in.MaxUnavailable, &out.MaxUnavailable
+		*out = new(intstr.IntOrString)
+		**out = **in
+	}
+	if in.Timeout != nil {
+		in, out := &in.Timeout, &out.Timeout
+		*out = new(v1.Duration)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceRequirements.
-func (in *ResourceRequirements) DeepCopy() *ResourceRequirements {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DriverUpgradeSpec.
+func (in *DriverUpgradeSpec) DeepCopy() *DriverUpgradeSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(ResourceRequirements)
+	out := new(DriverUpgradeSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Resources) DeepCopyInto(out *Resources) {
+func (in *DriverUpgradeStatus) DeepCopyInto(out *DriverUpgradeStatus) {
 	*out = *in
+	if in.Nodes != nil {
+		in, out := &in.Nodes, &out.Nodes
+		*out = make([]DriverUpgradeNode, len(*in))
+		copy(*out, *in)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Resources.
-func (in *Resources) DeepCopy() *Resources {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DriverUpgradeStatus.
+func (in *DriverUpgradeStatus) DeepCopy() *DriverUpgradeStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(Resources)
+	out := new(DriverUpgradeStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Status) DeepCopyInto(out *Status) {
+func (in *DriverValues) DeepCopyInto(out *DriverValues) {
 	*out = *in
+	in.OperandValues.DeepCopyInto(&out.OperandValues)
+	if in.UsePrecompiled != nil {
+		in, out := &in.UsePrecompiled, &out.UsePrecompiled
+		*out = new(bool)
+		**out = **in
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Status.
-func (in *Status) DeepCopy() *Status {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DriverValues.
+func (in *DriverValues) DeepCopy() *DriverValues {
 	if in == nil {
 		return nil
 	}
-	out := new(Status)
+	out := new(DriverValues)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GFDLabelExpression) DeepCopyInto(out *GFDLabelExpression) {
+	*out = *in
+	if in.Values != nil {
+		in, out := &in.Values, &out.Values
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GFDLabelExpression.
+func (in *GFDLabelExpression) DeepCopy() *GFDLabelExpression {
+	if in == nil {
+		return nil
+	}
+	out := new(GFDLabelExpression)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GFDLabelRule) DeepCopyInto(out *GFDLabelRule) {
+	*out = *in
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.MatchExpressions != nil {
+		in, out := &in.MatchExpressions, &out.MatchExpressions
+		*out = make([]GFDLabelExpression, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GFDLabelRule.
+func (in *GFDLabelRule) DeepCopy() *GFDLabelRule {
+	if in == nil {
+		return nil
+	}
+	out := new(GFDLabelRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GFDSpec) DeepCopyInto(out *GFDSpec) {
+	*out = *in
+	if in.ExtraLabelRules != nil {
+		in, out := &in.ExtraLabelRules, &out.ExtraLabelRules
+		*out = make([]GFDLabelRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GFDSpec.
+func (in *GFDSpec) DeepCopy() *GFDSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(GFDSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GPUAllocation) DeepCopyInto(out *GPUAllocation) {
+	*out = *in
+	if in.Namespaces != nil {
+		in, out := &in.Namespaces, &out.Namespaces
+		*out = make([]NamespaceGPUAllocation, len(*in))
+		copy(*out, *in)
+	}
+	in.Time.DeepCopyInto(&out.Time)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GPUAllocation.
+func (in *GPUAllocation) DeepCopy() *GPUAllocation {
+	if in == nil {
+		return nil
+	}
+	out := new(GPUAllocation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GPUHealthStatus) DeepCopyInto(out *GPUHealthStatus) {
+	*out = *in
+	if in.Nodes != nil {
+		in, out := &in.Nodes, &out.Nodes
+		*out = make([]UnhealthyGPUNode, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.RestartedDriverPods != nil {
+		in, out := &in.RestartedDriverPods, &out.RestartedDriverPods
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GPUHealthStatus.
+func (in *GPUHealthStatus) DeepCopy() *GPUHealthStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(GPUHealthStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GPUModelInventory) DeepCopyInto(out *GPUModelInventory) {
+	*out = *in
+	if in.Pools != nil {
+		in, out := &in.Pools, &out.Pools
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GPUModelInventory.
+func (in *GPUModelInventory) DeepCopy() *GPUModelInventory {
+	if in == nil {
+		return nil
+	}
+	out := new(GPUModelInventory)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GPUNode) DeepCopyInto(out *GPUNode) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GPUNode.
+func (in *GPUNode) DeepCopy() *GPUNode {
+	if in == nil {
+		return nil
+	}
+	out := new(GPUNode)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GPUNodeInventory) DeepCopyInto(out *GPUNodeInventory) {
+	*out = *in
+	if in.Nodes != nil {
+		in, out := &in.Nodes, &out.Nodes
+		*out = make([]GPUNode, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GPUNodeInventory.
+func (in *GPUNodeInventory) DeepCopy() *GPUNodeInventory {
+	if in == nil {
+		return nil
+	}
+	out := new(GPUNodeInventory)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GpuOperator) DeepCopyInto(out *GpuOperator) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GpuOperator.
+func (in *GpuOperator) DeepCopy() *GpuOperator {
+	if in == nil {
+		return nil
+	}
+	out := new(GpuOperator)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *GpuOperator) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GpuOperatorList) DeepCopyInto(out *GpuOperatorList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]GpuOperator, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GpuOperatorList.
+func (in *GpuOperatorList) DeepCopy() *GpuOperatorList {
+	if in == nil {
+		return nil
+	}
+	out := new(GpuOperatorList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *GpuOperatorList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GpuOperatorSpec) DeepCopyInto(out *GpuOperatorSpec) {
+	*out = *in
+	if in.AMD != nil {
+		in, out := &in.AMD, &out.AMD
+		*out = new(AMDSpec)
+		**out = **in
+	}
+	if in.UpgradePolicy != nil {
+		in, out := &in.UpgradePolicy, &out.UpgradePolicy
+		*out = new(UpgradePolicySpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Values != nil {
+		in, out := &in.Values, &out.Values
+		*out = new(HelmValues)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RawValues != nil {
+		in, out := &in.RawValues, &out.RawValues
+		*out = new(runtime.RawExtension)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = new(ResourceRequirements)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.GFD != nil {
+		in, out := &in.GFD, &out.GFD
+		*out = new(GFDSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.NamespaceDefaults != nil {
+		in, out := &in.NamespaceDefaults, &out.NamespaceDefaults
+		*out = new(NamespaceDefaults)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Install != nil {
+		in, out := &in.Install, &out.Install
+		*out = new(InstallSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Uninstall != nil {
+		in, out := &in.Uninstall, &out.Uninstall
+		*out = new(UninstallSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ResyncPeriod != nil {
+		in, out := &in.ResyncPeriod, &out.ResyncPeriod
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.Images != nil {
+		in, out := &in.Images, &out.Images
+		*out = new(HelperImages)
+		**out = **in
+	}
+	if in.Validation != nil {
+		in, out := &in.Validation, &out.Validation
+		*out = new(ValidationSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Spot != nil {
+		in, out := &in.Spot, &out.Spot
+		*out = new(SpotSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ReadinessChecks != nil {
+		in, out := &in.ReadinessChecks, &out.ReadinessChecks
+		*out = new(ReadinessChecks)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Monitoring != nil {
+		in, out := &in.Monitoring, &out.Monitoring
+		*out = new(MonitoringSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.HealthCheck != nil {
+		in, out := &in.HealthCheck, &out.HealthCheck
+		*out = new(HealthCheckSpec)
+		**out = **in
+	}
+	if in.MIG != nil {
+		in, out := &in.MIG, &out.MIG
+		*out = new(MIGSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TimeSlicing != nil {
+		in, out := &in.TimeSlicing, &out.TimeSlicing
+		*out = new(TimeSlicingSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Workloads != nil {
+		in, out := &in.Workloads, &out.Workloads
+		*out = new(WorkloadsSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DevicePlugin != nil {
+		in, out := &in.DevicePlugin, &out.DevicePlugin
+		*out = new(DevicePluginSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Driver != nil {
+		in, out := &in.Driver, &out.Driver
+		*out = new(DriverSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Validator != nil {
+		in, out := &in.Validator, &out.Validator
+		*out = new(ValidatorSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Operands != nil {
+		in, out := &in.Operands, &out.Operands
+		*out = new(OperandsSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DaemonSets != nil {
+		in, out := &in.DaemonSets, &out.DaemonSets
+		*out = new(DaemonSetsSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Toolkit != nil {
+		in, out := &in.Toolkit, &out.Toolkit
+		*out = new(ToolkitSpec)
+		**out = **in
+	}
+	if in.RuntimeClass != nil {
+		in, out := &in.RuntimeClass, &out.RuntimeClass
+		*out = new(RuntimeClassSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Kueue != nil {
+		in, out := &in.Kueue, &out.Kueue
+		*out = new(KueueSpec)
+		**out = **in
+	}
+	if in.DRA != nil {
+		in, out := &in.DRA, &out.DRA
+		*out = new(DRASpec)
+		**out = **in
+	}
+	if in.ComponentVersions != nil {
+		in, out := &in.ComponentVersions, &out.ComponentVersions
+		*out = new(ComponentVersions)
+		**out = **in
+	}
+	if in.NGCSecretRef != nil {
+		in, out := &in.NGCSecretRef, &out.NGCSecretRef
+		*out = new(SecretReference)
+		**out = **in
+	}
+	if in.VGPU != nil {
+		in, out := &in.VGPU, &out.VGPU
+		*out = new(VGPUSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Registry != nil {
+		in, out := &in.Registry, &out.Registry
+		*out = new(RegistrySpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Proxy != nil {
+		in, out := &in.Proxy, &out.Proxy
+		*out = new(ProxySpec)
+		**out = **in
+	}
+	if in.HelmRepo != nil {
+		in, out := &in.HelmRepo, &out.HelmRepo
+		*out = new(HelmRepoSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TargetClusterKubeconfigSecretRef != nil {
+		in, out := &in.TargetClusterKubeconfigSecretRef, &out.TargetClusterKubeconfigSecretRef
+		*out = new(KubeconfigSecretReference)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GpuOperatorSpec.
+func (in *GpuOperatorSpec) DeepCopy() *GpuOperatorSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(GpuOperatorSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GpuOperatorStatus) DeepCopyInto(out *GpuOperatorStatus) {
+	*out = *in
+	in.Status.DeepCopyInto(&out.Status)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.LastChartVersionCheckTime != nil {
+		in, out := &in.LastChartVersionCheckTime, &out.LastChartVersionCheckTime
+		*out = new(v1.Time)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PendingUpgrade != nil {
+		in, out := &in.PendingUpgrade, &out.PendingUpgrade
+		*out = new(UpgradePlan)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DriverRecommendation != nil {
+		in, out := &in.DriverRecommendation, &out.DriverRecommendation
+		*out = new(DriverRecommendation)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ClusterPolicy != nil {
+		in, out := &in.ClusterPolicy, &out.ClusterPolicy
+		*out = new(ClusterPolicyStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Components != nil {
+		in, out := &in.Components, &out.Components
+		*out = make([]ComponentStatus, len(*in))
+		copy(*out, *in)
+	}
+	if in.InstallRetry != nil {
+		in, out := &in.InstallRetry, &out.InstallRetry
+		*out = new(InstallRetryStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.LastResyncTime != nil {
+		in, out := &in.LastResyncTime, &out.LastResyncTime
+		*out = new(v1.Time)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Nodes != nil {
+		in, out := &in.Nodes, &out.Nodes
+		*out = make([]NodeStatus, len(*in))
+		copy(*out, *in)
+	}
+	if in.Pools != nil {
+		in, out := &in.Pools, &out.Pools
+		*out = make([]PoolStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.GPUNodes != nil {
+		in, out := &in.GPUNodes, &out.GPUNodes
+		*out = new(GPUNodeInventory)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.GPUInventory != nil {
+		in, out := &in.GPUInventory, &out.GPUInventory
+		*out = make([]GPUModelInventory, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.MIG != nil {
+		in, out := &in.MIG, &out.MIG
+		*out = new(MIGStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CUDAValidation != nil {
+		in, out := &in.CUDAValidation, &out.CUDAValidation
+		*out = new(CUDAValidationStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PendingGPUPods != nil {
+		in, out := &in.PendingGPUPods, &out.PendingGPUPods
+		*out = new(PendingGPUPods)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.GPUAllocation != nil {
+		in, out := &in.GPUAllocation, &out.GPUAllocation
+		*out = new(GPUAllocation)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Compatibility != nil {
+		in, out := &in.Compatibility, &out.Compatibility
+		*out = new(CompatibilityReport)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.GPUHealth != nil {
+		in, out := &in.GPUHealth, &out.GPUHealth
+		*out = new(GPUHealthStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DriverUpgrade != nil {
+		in, out := &in.DriverUpgrade, &out.DriverUpgrade
+		*out = new(DriverUpgradeStatus)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GpuOperatorStatus.
+func (in *GpuOperatorStatus) DeepCopy() *GpuOperatorStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(GpuOperatorStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HealthCheckSpec) DeepCopyInto(out *HealthCheckSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HealthCheckSpec.
+func (in *HealthCheckSpec) DeepCopy() *HealthCheckSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(HealthCheckSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HelmRepoSpec) DeepCopyInto(out *HelmRepoSpec) {
+	*out = *in
+	if in.CredentialsSecretRef != nil {
+		in, out := &in.CredentialsSecretRef, &out.CredentialsSecretRef
+		*out = new(SecretReference)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HelmRepoSpec.
+func (in *HelmRepoSpec) DeepCopy() *HelmRepoSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(HelmRepoSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HelmValues) DeepCopyInto(out *HelmValues) {
+	*out = *in
+	if in.Driver != nil {
+		in, out := &in.Driver, &out.Driver
+		*out = new(DriverValues)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Toolkit != nil {
+		in, out := &in.Toolkit, &out.Toolkit
+		*out = new(OperandValues)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DevicePlugin != nil {
+		in, out := &in.DevicePlugin, &out.DevicePlugin
+		*out = new(OperandValues)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DCGMExporter != nil {
+		in, out := &in.DCGMExporter, &out.DCGMExporter
+		*out = new(OperandValues)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MIGManager != nil {
+		in, out := &in.MIGManager, &out.MIGManager
+		*out = new(OperandValues)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.GFD != nil {
+		in, out := &in.GFD, &out.GFD
+		*out = new(OperandValues)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HelmValues.
+func (in *HelmValues) DeepCopy() *HelmValues {
+	if in == nil {
+		return nil
+	}
+	out := new(HelmValues)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HelperImages) DeepCopyInto(out *HelperImages) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HelperImages.
+func (in *HelperImages) DeepCopy() *HelperImages {
+	if in == nil {
+		return nil
+	}
+	out := new(HelperImages)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InstallRetryStatus) DeepCopyInto(out *InstallRetryStatus) {
+	*out = *in
+	in.LastFailureTime.DeepCopyInto(&out.LastFailureTime)
+	in.NextRetryTime.DeepCopyInto(&out.NextRetryTime)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstallRetryStatus.
+func (in *InstallRetryStatus) DeepCopy() *InstallRetryStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(InstallRetryStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InstallSpec) DeepCopyInto(out *InstallSpec) {
+	*out = *in
+	if in.RetryInterval != nil {
+		in, out := &in.RetryInterval, &out.RetryInterval
+		*out = new(v1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstallSpec.
+func (in *InstallSpec) DeepCopy() *InstallSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(InstallSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KubeconfigSecretReference) DeepCopyInto(out *KubeconfigSecretReference) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubeconfigSecretReference.
+func (in *KubeconfigSecretReference) DeepCopy() *KubeconfigSecretReference {
+	if in == nil {
+		return nil
+	}
+	out := new(KubeconfigSecretReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KueueSpec) DeepCopyInto(out *KueueSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KueueSpec.
+func (in *KueueSpec) DeepCopy() *KueueSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(KueueSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LastOperation) DeepCopyInto(out *LastOperation) {
+	*out = *in
+	in.LastUpdateTime.DeepCopyInto(&out.LastUpdateTime)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LastOperation.
+func (in *LastOperation) DeepCopy() *LastOperation {
+	if in == nil {
+		return nil
+	}
+	out := new(LastOperation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MIGNodeStatus) DeepCopyInto(out *MIGNodeStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MIGNodeStatus.
+func (in *MIGNodeStatus) DeepCopy() *MIGNodeStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(MIGNodeStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MIGProfile) DeepCopyInto(out *MIGProfile) {
+	*out = *in
+	if in.NodeSelector != nil {
+		in, out := &in.NodeSelector, &out.NodeSelector
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Devices != nil {
+		in, out := &in.Devices, &out.Devices
+		*out = make(map[string]int32, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MIGProfile.
+func (in *MIGProfile) DeepCopy() *MIGProfile {
+	if in == nil {
+		return nil
+	}
+	out := new(MIGProfile)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MIGSpec) DeepCopyInto(out *MIGSpec) {
+	*out = *in
+	if in.ConfigMapRef != nil {
+		in, out := &in.ConfigMapRef, &out.ConfigMapRef
+		*out = new(ConfigMapKeyReference)
+		**out = **in
+	}
+	if in.Profiles != nil {
+		in, out := &in.Profiles, &out.Profiles
+		*out = make([]MIGProfile, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MIGSpec.
+func (in *MIGSpec) DeepCopy() *MIGSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(MIGSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MIGStatus) DeepCopyInto(out *MIGStatus) {
+	*out = *in
+	if in.Nodes != nil {
+		in, out := &in.Nodes, &out.Nodes
+		*out = make([]MIGNodeStatus, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MIGStatus.
+func (in *MIGStatus) DeepCopy() *MIGStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(MIGStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MaintenanceWindow) DeepCopyInto(out *MaintenanceWindow) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MaintenanceWindow.
+func (in *MaintenanceWindow) DeepCopy() *MaintenanceWindow {
+	if in == nil {
+		return nil
+	}
+	out := new(MaintenanceWindow)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MonitoringSpec) DeepCopyInto(out *MonitoringSpec) {
+	*out = *in
+	if in.DCGM != nil {
+		in, out := &in.DCGM, &out.DCGM
+		*out = new(DCGMSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MonitoringSpec.
+func (in *MonitoringSpec) DeepCopy() *MonitoringSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(MonitoringSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NamespaceDefaults) DeepCopyInto(out *NamespaceDefaults) {
+	*out = *in
+	if in.Quota != nil {
+		in, out := &in.Quota, &out.Quota
+		*out = new(NamespaceQuota)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ContainerDefaults != nil {
+		in, out := &in.ContainerDefaults, &out.ContainerDefaults
+		*out = new(ResourceRequirements)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NamespaceDefaults.
+func (in *NamespaceDefaults) DeepCopy() *NamespaceDefaults {
+	if in == nil {
+		return nil
+	}
+	out := new(NamespaceDefaults)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NamespaceGPUAllocation) DeepCopyInto(out *NamespaceGPUAllocation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NamespaceGPUAllocation.
+func (in *NamespaceGPUAllocation) DeepCopy() *NamespaceGPUAllocation {
+	if in == nil {
+		return nil
+	}
+	out := new(NamespaceGPUAllocation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NamespacePodCount) DeepCopyInto(out *NamespacePodCount) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NamespacePodCount.
+func (in *NamespacePodCount) DeepCopy() *NamespacePodCount {
+	if in == nil {
+		return nil
+	}
+	out := new(NamespacePodCount)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NamespaceQuota) DeepCopyInto(out *NamespaceQuota) {
+	*out = *in
+	if in.Pods != nil {
+		in, out := &in.Pods, &out.Pods
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Requests != nil {
+		in, out := &in.Requests, &out.Requests
+		*out = new(Resources)
+		**out = **in
+	}
+	if in.Limits != nil {
+		in, out := &in.Limits, &out.Limits
+		*out = new(Resources)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NamespaceQuota.
+func (in *NamespaceQuota) DeepCopy() *NamespaceQuota {
+	if in == nil {
+		return nil
+	}
+	out := new(NamespaceQuota)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodeStatus) DeepCopyInto(out *NodeStatus) {
+	*out = *in
+	out.Validations = in.Validations
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeStatus.
+func (in *NodeStatus) DeepCopy() *NodeStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodeValidations) DeepCopyInto(out *NodeValidations) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeValidations.
+func (in *NodeValidations) DeepCopy() *NodeValidations {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeValidations)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OperandReadiness) DeepCopyInto(out *OperandReadiness) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OperandReadiness.
+func (in *OperandReadiness) DeepCopy() *OperandReadiness {
+	if in == nil {
+		return nil
+	}
+	out := new(OperandReadiness)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OperandValues) DeepCopyInto(out *OperandValues) {
+	*out = *in
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Env != nil {
+		in, out := &in.Env, &out.Env
+		*out = make([]corev1.EnvVar, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Args != nil {
+		in, out := &in.Args, &out.Args
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = new(corev1.ResourceRequirements)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OperandValues.
+func (in *OperandValues) DeepCopy() *OperandValues {
+	if in == nil {
+		return nil
+	}
+	out := new(OperandValues)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OperandsSpec) DeepCopyInto(out *OperandsSpec) {
+	*out = *in
+	if in.NodeSelector != nil {
+		in, out := &in.NodeSelector, &out.NodeSelector
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Tolerations != nil {
+		in, out := &in.Tolerations, &out.Tolerations
+		*out = make([]corev1.Toleration, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OperandsSpec.
+func (in *OperandsSpec) DeepCopy() *OperandsSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(OperandsSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PendingGPUPods) DeepCopyInto(out *PendingGPUPods) {
+	*out = *in
+	if in.TopNamespaces != nil {
+		in, out := &in.TopNamespaces, &out.TopNamespaces
+		*out = make([]NamespacePodCount, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PendingGPUPods.
+func (in *PendingGPUPods) DeepCopy() *PendingGPUPods {
+	if in == nil {
+		return nil
+	}
+	out := new(PendingGPUPods)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PoolStatus) DeepCopyInto(out *PoolStatus) {
+	*out = *in
+	if in.DriverVersions != nil {
+		in, out := &in.DriverVersions, &out.DriverVersions
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PoolStatus.
+func (in *PoolStatus) DeepCopy() *PoolStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(PoolStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProxySpec) DeepCopyInto(out *ProxySpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProxySpec.
+func (in *ProxySpec) DeepCopy() *ProxySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ProxySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReadinessChecks) DeepCopyInto(out *ReadinessChecks) {
+	*out = *in
+	if in.Operands != nil {
+		in, out := &in.Operands, &out.Operands
+		*out = make([]OperandReadiness, len(*in))
+		copy(*out, *in)
+	}
+	if in.MinHealthyNodesPercent != nil {
+		in, out := &in.MinHealthyNodesPercent, &out.MinHealthyNodesPercent
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReadinessChecks.
+func (in *ReadinessChecks) DeepCopy() *ReadinessChecks {
+	if in == nil {
+		return nil
+	}
+	out := new(ReadinessChecks)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RegistrySpec) DeepCopyInto(out *RegistrySpec) {
+	*out = *in
+	if in.ImagePullSecrets != nil {
+		in, out := &in.ImagePullSecrets, &out.ImagePullSecrets
+		*out = make([]SecretReference, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RegistrySpec.
+func (in *RegistrySpec) DeepCopy() *RegistrySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(RegistrySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReservedGPUs) DeepCopyInto(out *ReservedGPUs) {
+	*out = *in
+	if in.Count != nil {
+		in, out := &in.Count, &out.Count
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Indices != nil {
+		in, out := &in.Indices, &out.Indices
+		*out = make([]int32, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReservedGPUs.
+func (in *ReservedGPUs) DeepCopy() *ReservedGPUs {
+	if in == nil {
+		return nil
+	}
+	out := new(ReservedGPUs)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourceRequirements) DeepCopyInto(out *ResourceRequirements) {
+	*out = *in
+	if in.Limits != nil {
+		in, out := &in.Limits, &out.Limits
+		*out = new(Resources)
+		**out = **in
+	}
+	if in.Requests != nil {
+		in, out := &in.Requests, &out.Requests
+		*out = new(Resources)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceRequirements.
+func (in *ResourceRequirements) DeepCopy() *ResourceRequirements {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourceRequirements)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Resources) DeepCopyInto(out *Resources) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Resources.
+func (in *Resources) DeepCopy() *Resources {
+	if in == nil {
+		return nil
+	}
+	out := new(Resources)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RuntimeClassSpec) DeepCopyInto(out *RuntimeClassSpec) {
+	*out = *in
+	if in.SetAsDefault != nil {
+		in, out := &in.SetAsDefault, &out.SetAsDefault
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RuntimeClassSpec.
+func (in *RuntimeClassSpec) DeepCopy() *RuntimeClassSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(RuntimeClassSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecretReference) DeepCopyInto(out *SecretReference) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecretReference.
+func (in *SecretReference) DeepCopy() *SecretReference {
+	if in == nil {
+		return nil
+	}
+	out := new(SecretReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SpotSpec) DeepCopyInto(out *SpotSpec) {
+	*out = *in
+	if in.Tolerations != nil {
+		in, out := &in.Tolerations, &out.Tolerations
+		*out = make([]corev1.Toleration, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SpotSpec.
+func (in *SpotSpec) DeepCopy() *SpotSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(SpotSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Status) DeepCopyInto(out *Status) {
+	*out = *in
+	if in.LastOperation != nil {
+		in, out := &in.LastOperation, &out.LastOperation
+		*out = new(LastOperation)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Status.
+func (in *Status) DeepCopy() *Status {
+	if in == nil {
+		return nil
+	}
+	out := new(Status)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TimeSlicingPool) DeepCopyInto(out *TimeSlicingPool) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TimeSlicingPool.
+func (in *TimeSlicingPool) DeepCopy() *TimeSlicingPool {
+	if in == nil {
+		return nil
+	}
+	out := new(TimeSlicingPool)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TimeSlicingSpec) DeepCopyInto(out *TimeSlicingSpec) {
+	*out = *in
+	if in.Pools != nil {
+		in, out := &in.Pools, &out.Pools
+		*out = make([]TimeSlicingPool, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TimeSlicingSpec.
+func (in *TimeSlicingSpec) DeepCopy() *TimeSlicingSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(TimeSlicingSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ToolkitSpec) DeepCopyInto(out *ToolkitSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ToolkitSpec.
+func (in *ToolkitSpec) DeepCopy() *ToolkitSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ToolkitSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UnhealthyGPUNode) DeepCopyInto(out *UnhealthyGPUNode) {
+	*out = *in
+	in.Since.DeepCopyInto(&out.Since)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UnhealthyGPUNode.
+func (in *UnhealthyGPUNode) DeepCopy() *UnhealthyGPUNode {
+	if in == nil {
+		return nil
+	}
+	out := new(UnhealthyGPUNode)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UninstallSpec) DeepCopyInto(out *UninstallSpec) {
+	*out = *in
+	if in.Timeout != nil {
+		in, out := &in.Timeout, &out.Timeout
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.DrainTimeout != nil {
+		in, out := &in.DrainTimeout, &out.DrainTimeout
+		*out = new(v1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UninstallSpec.
+func (in *UninstallSpec) DeepCopy() *UninstallSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(UninstallSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UpgradePlan) DeepCopyInto(out *UpgradePlan) {
+	*out = *in
+	if in.Chart != nil {
+		in, out := &in.Chart, &out.Chart
+		*out = new(VersionChange)
+		**out = **in
+	}
+	if in.Driver != nil {
+		in, out := &in.Driver, &out.Driver
+		*out = new(VersionChange)
+		**out = **in
+	}
+	in.Since.DeepCopyInto(&out.Since)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UpgradePlan.
+func (in *UpgradePlan) DeepCopy() *UpgradePlan {
+	if in == nil {
+		return nil
+	}
+	out := new(UpgradePlan)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UpgradePolicySpec) DeepCopyInto(out *UpgradePolicySpec) {
+	*out = *in
+	if in.CheckInterval != nil {
+		in, out := &in.CheckInterval, &out.CheckInterval
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.MaintenanceWindow != nil {
+		in, out := &in.MaintenanceWindow, &out.MaintenanceWindow
+		*out = new(MaintenanceWindow)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UpgradePolicySpec.
+func (in *UpgradePolicySpec) DeepCopy() *UpgradePolicySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(UpgradePolicySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VGPULicenseServer) DeepCopyInto(out *VGPULicenseServer) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VGPULicenseServer.
+func (in *VGPULicenseServer) DeepCopy() *VGPULicenseServer {
+	if in == nil {
+		return nil
+	}
+	out := new(VGPULicenseServer)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VGPUSpec) DeepCopyInto(out *VGPUSpec) {
+	*out = *in
+	if in.ImagePullSecretRef != nil {
+		in, out := &in.ImagePullSecretRef, &out.ImagePullSecretRef
+		*out = new(SecretReference)
+		**out = **in
+	}
+	if in.LicenseServer != nil {
+		in, out := &in.LicenseServer, &out.LicenseServer
+		*out = new(VGPULicenseServer)
+		**out = **in
+	}
+	if in.ClientTokenSecretRef != nil {
+		in, out := &in.ClientTokenSecretRef, &out.ClientTokenSecretRef
+		*out = new(SecretReference)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VGPUSpec.
+func (in *VGPUSpec) DeepCopy() *VGPUSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(VGPUSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VMPassthroughSpec) DeepCopyInto(out *VMPassthroughSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VMPassthroughSpec.
+func (in *VMPassthroughSpec) DeepCopy() *VMPassthroughSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(VMPassthroughSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ValidationSpec) DeepCopyInto(out *ValidationSpec) {
+	*out = *in
+	if in.Command != nil {
+		in, out := &in.Command, &out.Command
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ValidationSpec.
+func (in *ValidationSpec) DeepCopy() *ValidationSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ValidationSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ValidatorComponent) DeepCopyInto(out *ValidatorComponent) {
+	*out = *in
+	if in.Workload != nil {
+		in, out := &in.Workload, &out.Workload
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Env != nil {
+		in, out := &in.Env, &out.Env
+		*out = make([]corev1.EnvVar, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ValidatorComponent.
+func (in *ValidatorComponent) DeepCopy() *ValidatorComponent {
+	if in == nil {
+		return nil
+	}
+	out := new(ValidatorComponent)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ValidatorSpec) DeepCopyInto(out *ValidatorSpec) {
+	*out = *in
+	if in.Driver != nil {
+		in, out := &in.Driver, &out.Driver
+		*out = new(ValidatorComponent)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Toolkit != nil {
+		in, out := &in.Toolkit, &out.Toolkit
+		*out = new(ValidatorComponent)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CUDA != nil {
+		in, out := &in.CUDA, &out.CUDA
+		*out = new(ValidatorComponent)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Plugin != nil {
+		in, out := &in.Plugin, &out.Plugin
+		*out = new(ValidatorComponent)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ValidatorSpec.
+func (in *ValidatorSpec) DeepCopy() *ValidatorSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ValidatorSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VersionChange) DeepCopyInto(out *VersionChange) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VersionChange.
+func (in *VersionChange) DeepCopy() *VersionChange {
+	if in == nil {
+		return nil
+	}
+	out := new(VersionChange)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkloadsSpec) DeepCopyInto(out *WorkloadsSpec) {
+	*out = *in
+	if in.VMPassthrough != nil {
+		in, out := &in.VMPassthrough, &out.VMPassthrough
+		*out = new(VMPassthroughSpec)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkloadsSpec.
+func (in *WorkloadsSpec) DeepCopy() *WorkloadsSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkloadsSpec)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -0,0 +1,192 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"sigs.k8s.io/controller-runtime/pkg/conversion"
+
+	"github.com/kyma-project/gpu-operator/api/v1alpha1"
+)
+
+var _ conversion.Convertible = &GpuOperator{}
+
+// ConvertTo converts this GpuOperator to the hub version v1alpha1, which keeps the settings flat in the spec
+func (src *GpuOperator) ConvertTo(dstRaw conversion.Hub) error {
+	dst := dstRaw.(*v1alpha1.GpuOperator)
+	dst.ObjectMeta = src.ObjectMeta
+	dst.Status = src.Status
+
+	in, out := &src.Spec, &dst.Spec
+	out.Namespace = in.Namespace
+	if chart := in.Chart; chart != nil {
+		out.ChartVersion = chart.Version
+		out.HelmRepo = chart.Repo
+		out.InstallEngine = chart.InstallEngine
+		out.ValuesSource = chart.ValuesSource
+		out.ValuesConfigMapName = chart.ValuesConfigMapName
+		out.Values = chart.Values
+		out.RawValues = chart.RawValues
+		out.ClusterPolicyManagement = chart.ClusterPolicyManagement
+		out.ResyncPeriod = chart.ResyncPeriod
+	}
+	if driver := in.Driver; driver != nil {
+		out.DriverVersion = driver.Version
+		if driver.Mode != "" || driver.Repository != "" || driver.Image != "" || driver.Upgrade != nil {
+			out.Driver = &v1alpha1.DriverSpec{
+				Mode:       driver.Mode,
+				Repository: driver.Repository,
+				Image:      driver.Image,
+				Upgrade:    driver.Upgrade,
+			}
+		}
+		out.NGCSecretRef = driver.NGCSecretRef
+		out.VGPU = driver.VGPU
+	}
+	if components := in.Components; components != nil {
+		out.ComponentVersions = components.Versions
+		out.DevicePlugin = components.DevicePlugin
+		out.Toolkit = components.Toolkit
+		out.RuntimeClass = components.RuntimeClass
+		out.Validator = components.Validator
+		out.GFD = components.GFD
+		out.Monitoring = components.Monitoring
+		out.MIG = components.MIG
+		out.TimeSlicing = components.TimeSlicing
+		out.DRA = components.DRA
+	}
+	if registry := in.Registry; registry != nil {
+		out.Registry = &v1alpha1.RegistrySpec{
+			Repository:       registry.Repository,
+			HelmRepoURL:      registry.HelmRepoURL,
+			ImagePullSecrets: registry.ImagePullSecrets,
+			CABundle:         registry.CABundle,
+		}
+	}
+	if operands := in.Operands; operands != nil {
+		if len(operands.NodeSelector) > 0 || len(operands.Tolerations) > 0 {
+			out.Operands = &v1alpha1.OperandsSpec{NodeSelector: operands.NodeSelector, Tolerations: operands.Tolerations}
+		}
+		out.Resources = operands.Resources
+		out.DaemonSets = operands.DaemonSets
+		out.Spot = operands.Spot
+	}
+	out.NamespaceDefaults = in.NamespaceDefaults
+	out.Install = in.Install
+	out.Uninstall = in.Uninstall
+	out.Images = in.Images
+	out.Validation = in.Validation
+	out.ReadinessChecks = in.ReadinessChecks
+	out.SkipCapacityCheck = in.SkipCapacityCheck
+	out.HealthCheck = in.HealthCheck
+	out.UpgradePolicy = in.UpgradePolicy
+	out.AllowDowngrade = in.AllowDowngrade
+	out.Paused = in.Paused
+	out.Vendor = in.Vendor
+	out.AMD = in.AMD
+	out.Workloads = in.Workloads
+	out.Kueue = in.Kueue
+	out.FIPSMode = in.FIPSMode
+	out.Proxy = in.Proxy
+	out.TargetClusterKubeconfigSecretRef = in.TargetClusterKubeconfigSecretRef
+	return nil
+}
+
+// ConvertFrom converts from the hub version v1alpha1 to this version. A group is only set if one of its
+// settings is, so a round trip through v1alpha1 keeps the object unchanged.
+func (dst *GpuOperator) ConvertFrom(srcRaw conversion.Hub) error {
+	src := srcRaw.(*v1alpha1.GpuOperator)
+	dst.ObjectMeta = src.ObjectMeta
+	dst.Status = src.Status
+
+	in, out := &src.Spec, &dst.Spec
+	out.Namespace = in.Namespace
+	chart := ChartSpec{
+		Version:                 in.ChartVersion,
+		Repo:                    in.HelmRepo,
+		InstallEngine:           in.InstallEngine,
+		ValuesSource:            in.ValuesSource,
+		ValuesConfigMapName:     in.ValuesConfigMapName,
+		Values:                  in.Values,
+		RawValues:               in.RawValues,
+		ClusterPolicyManagement: in.ClusterPolicyManagement,
+		ResyncPeriod:            in.ResyncPeriod,
+	}
+	if chart != (ChartSpec{}) {
+		out.Chart = &chart
+	}
+	driver := DriverSpec{Version: in.DriverVersion, NGCSecretRef: in.NGCSecretRef, VGPU: in.VGPU}
+	if in.Driver != nil {
+		driver.Mode = in.Driver.Mode
+		driver.Repository = in.Driver.Repository
+		driver.Image = in.Driver.Image
+		driver.Upgrade = in.Driver.Upgrade
+	}
+	if driver != (DriverSpec{}) {
+		out.Driver = &driver
+	}
+	components := ComponentsSpec{
+		Versions:     in.ComponentVersions,
+		DevicePlugin: in.DevicePlugin,
+		Toolkit:      in.Toolkit,
+		RuntimeClass: in.RuntimeClass,
+		Validator:    in.Validator,
+		GFD:          in.GFD,
+		Monitoring:   in.Monitoring,
+		MIG:          in.MIG,
+		TimeSlicing:  in.TimeSlicing,
+		DRA:          in.DRA,
+	}
+	if components != (ComponentsSpec{}) {
+		out.Components = &components
+	}
+	if registry := in.Registry; registry != nil {
+		out.Registry = &RegistrySpec{
+			Repository:       registry.Repository,
+			HelmRepoURL:      registry.HelmRepoURL,
+			ImagePullSecrets: registry.ImagePullSecrets,
+			CABundle:         registry.CABundle,
+		}
+	}
+	operands := OperandsSpec{Resources: in.Resources, DaemonSets: in.DaemonSets, Spot: in.Spot}
+	if in.Operands != nil {
+		operands.NodeSelector = in.Operands.NodeSelector
+		operands.Tolerations = in.Operands.Tolerations
+	}
+	if len(operands.NodeSelector) > 0 || len(operands.Tolerations) > 0 || operands.Resources != nil ||
+		operands.DaemonSets != nil || operands.Spot != nil {
+		out.Operands = &operands
+	}
+	out.NamespaceDefaults = in.NamespaceDefaults
+	out.Install = in.Install
+	out.Uninstall = in.Uninstall
+	out.Images = in.Images
+	out.Validation = in.Validation
+	out.ReadinessChecks = in.ReadinessChecks
+	out.SkipCapacityCheck = in.SkipCapacityCheck
+	out.HealthCheck = in.HealthCheck
+	out.UpgradePolicy = in.UpgradePolicy
+	out.AllowDowngrade = in.AllowDowngrade
+	out.Paused = in.Paused
+	out.Vendor = in.Vendor
+	out.AMD = in.AMD
+	out.Workloads = in.Workloads
+	out.Kueue = in.Kueue
+	out.FIPSMode = in.FIPSMode
+	out.Proxy = in.Proxy
+	out.TargetClusterKubeconfigSecretRef = in.TargetClusterKubeconfigSecretRef
+	return nil
+}
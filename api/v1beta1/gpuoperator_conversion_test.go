@@ -0,0 +1,141 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
+
+	"github.com/kyma-project/gpu-operator/api/v1alpha1"
+)
+
+func TestConversionRoundTripFromHub(t *testing.T) {
+	full := &v1alpha1.GpuOperator{}
+	fill(t, reflect.ValueOf(&full.Spec).Elem(), "spec")
+
+	tests := []struct {
+		name string
+		hub  *v1alpha1.GpuOperator
+	}{
+		{name: "every spec field set", hub: full},
+		{name: "empty spec", hub: &v1alpha1.GpuOperator{}},
+		{name: "driver version only", hub: &v1alpha1.GpuOperator{Spec: v1alpha1.GpuOperatorSpec{DriverVersion: "550"}}},
+		{name: "resources without operands", hub: &v1alpha1.GpuOperator{Spec: v1alpha1.GpuOperatorSpec{
+			Resources: full.Spec.Resources,
+		}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			spoke := &GpuOperator{}
+			if err := spoke.ConvertFrom(tt.hub); err != nil {
+				t.Fatalf("ConvertFrom: %v", err)
+			}
+			got := &v1alpha1.GpuOperator{}
+			if err := spoke.ConvertTo(got); err != nil {
+				t.Fatalf("ConvertTo: %v", err)
+			}
+			if diff := cmp.Diff(tt.hub.Spec, got.Spec, quantityComparer); diff != "" {
+				t.Errorf("v1alpha1 -> v1beta1 -> v1alpha1 changed the spec (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestConversionRoundTripFromSpoke(t *testing.T) {
+	spoke := &GpuOperator{}
+	fill(t, reflect.ValueOf(&spoke.Spec).Elem(), "spec")
+
+	hub := &v1alpha1.GpuOperator{}
+	if err := spoke.ConvertTo(hub); err != nil {
+		t.Fatalf("ConvertTo: %v", err)
+	}
+	got := &GpuOperator{}
+	if err := got.ConvertFrom(hub); err != nil {
+		t.Fatalf("ConvertFrom: %v", err)
+	}
+	if diff := cmp.Diff(spoke.Spec, got.Spec, quantityComparer); diff != "" {
+		t.Errorf("v1beta1 -> v1alpha1 -> v1beta1 changed the spec (-want +got):\n%s", diff)
+	}
+}
+
+var quantityComparer = cmp.Comparer(func(a, b resource.Quantity) bool { return a.Cmp(b) == 0 })
+
+// fill sets every field reachable from v to a non-zero value, so a field the conversion forgets to copy
+// shows up in the round trip. It fails on field kinds it cannot fill, so new kinds of fields are not skipped
+func fill(t *testing.T, v reflect.Value, path string) {
+	t.Helper()
+	switch v.Addr().Interface().(type) {
+	case *resource.Quantity:
+		v.Set(reflect.ValueOf(resource.MustParse("1Gi")))
+		return
+	case *metav1.Time:
+		v.Set(reflect.ValueOf(metav1.NewTime(time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC))))
+		return
+	case *metav1.Duration:
+		v.Set(reflect.ValueOf(metav1.Duration{Duration: time.Minute}))
+		return
+	case *intstr.IntOrString:
+		v.Set(reflect.ValueOf(intstr.FromString("25%")))
+		return
+	case *runtime.RawExtension:
+		v.Set(reflect.ValueOf(runtime.RawExtension{Raw: []byte(`{"key":"value"}`)}))
+		return
+	}
+
+	switch v.Kind() {
+	case reflect.String:
+		v.SetString(path)
+	case reflect.Bool:
+		v.SetBool(true)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		v.SetInt(3)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		v.SetUint(3)
+	case reflect.Float32, reflect.Float64:
+		v.SetFloat(0.5)
+	case reflect.Pointer:
+		v.Set(reflect.New(v.Type().Elem()))
+		fill(t, v.Elem(), path)
+	case reflect.Slice:
+		v.Set(reflect.MakeSlice(v.Type(), 1, 1))
+		fill(t, v.Index(0), path+"[0]")
+	case reflect.Map:
+		key := reflect.New(v.Type().Key()).Elem()
+		fill(t, key, path+".key")
+		elem := reflect.New(v.Type().Elem()).Elem()
+		fill(t, elem, path+"[key]")
+		v.Set(reflect.MakeMap(v.Type()))
+		v.SetMapIndex(key, elem)
+	case reflect.Struct:
+		for i := 0; i < v.NumField(); i++ {
+			field := v.Type().Field(i)
+			if !field.IsExported() {
+				t.Fatalf("cannot fill %s: unexported field %s of %s", path, field.Name, v.Type())
+			}
+			fill(t, v.Field(i), path+"."+field.Name)
+		}
+	default:
+		t.Fatalf("cannot fill %s of kind %s", path, v.Kind())
+	}
+}
@@ -0,0 +1,406 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/kyma-project/gpu-operator/api/v1alpha1"
+)
+
+// GpuOperatorSpec defines the desired state of GpuOperator. The settings are grouped by what they configure;
+// the settings that only moved keep their v1alpha1 types.
+// +kubebuilder:validation:XValidation:rule="!has(self.components) || !has(self.components.dra) || !self.components.dra.enabled || !has(self.chart) || !has(self.chart.installEngine) || self.chart.installEngine != 'Manifest'",message="dra requires a Helm install engine"
+// +kubebuilder:validation:XValidation:rule="!has(self.components) || !has(self.components.dra) || !self.components.dra.enabled || !has(self.validation) || !has(self.validation.schedule) || self.validation.schedule == ''",message="the scheduled smoke tests request nvidia.com/gpu, which is not advertised with dra"
+// +kubebuilder:validation:XValidation:rule="!has(self.components) || !has(self.components.dra) || !self.components.dra.enabled || !has(self.validation) || !has(self.validation.enabled) || !self.validation.enabled",message="the CUDA validation requests nvidia.com/gpu, which is not advertised with dra"
+// +kubebuilder:validation:XValidation:rule="!has(self.components) || !has(self.components.versions) || !has(self.components.versions.driver) || !has(self.driver) || !has(self.driver.image) || !(self.driver.image.contains(':') || self.driver.image.contains('@'))",message="components.versions.driver cannot be combined with a pinned driver image"
+// +kubebuilder:validation:XValidation:rule="!has(self.components) || !has(self.components.versions) || !has(self.components.versions.driver) || !has(self.driver) || !has(self.driver.version) || self.driver.version == '' || self.components.versions.driver.startsWith(self.driver.version + '.')",message="components.versions.driver must belong to the driver.version branch"
+// +kubebuilder:validation:XValidation:rule="!has(self.driver) || !has(self.driver.mode) || self.driver.mode != 'Preinstalled' || !has(self.components) || !has(self.components.versions) || !has(self.components.versions.driver)",message="components.versions.driver cannot be set with a preinstalled driver"
+// +kubebuilder:validation:XValidation:rule="has(self.targetClusterKubeconfigSecretRef) == has(oldSelf.targetClusterKubeconfigSecretRef)",message="targetClusterKubeconfigSecretRef cannot be added or removed after creation"
+// +kubebuilder:validation:XValidation:rule="!has(self.chart) || !has(self.chart.repo) || !has(self.registry) || !has(self.registry.helmRepoUrl) || self.registry.helmRepoUrl == ''",message="chart.repo and registry.helmRepoUrl are mutually exclusive"
+// +kubebuilder:validation:XValidation:rule="!has(self.upgradePolicy) || !has(self.chart) || !has(self.chart.installEngine) || self.chart.installEngine != 'Manifest'",message="upgradePolicy requires a Helm install engine, the Manifest install engine uses the chart rendered into the image"
+// +kubebuilder:validation:XValidation:rule="!has(self.amd) || (has(self.vendor) && self.vendor == 'AMD')",message="amd requires the vendor AMD"
+// +kubebuilder:validation:XValidation:rule="!has(self.vendor) || self.vendor != 'AMD' || !has(self.chart) || !has(self.chart.installEngine) || self.chart.installEngine != 'Manifest'",message="the AMD GPU operator is installed by the Helm install engine"
+// +kubebuilder:validation:XValidation:rule="!has(self.vendor) || self.vendor != 'AMD' || !(has(self.driver) || has(self.components) || has(self.registry) || has(self.operands) || has(self.validation) || has(self.readinessChecks) || has(self.healthCheck) || has(self.upgradePolicy) || has(self.workloads) || has(self.kueue) || (has(self.fipsMode) && self.fipsMode) || has(self.chart) && (has(self.chart.valuesConfigMapName) || has(self.chart.values) || has(self.chart.rawValues) || has(self.chart.resyncPeriod)))",message="the NVIDIA settings cannot be combined with the vendor AMD"
+// +kubebuilder:validation:XValidation:rule="!has(self.vendor) || self.vendor != 'AMD' || !has(self.uninstall) || !(has(self.uninstall.drainGpuWorkloads) && self.uninstall.drainGpuWorkloads || has(self.uninstall.blockIfWorkloadsPresent) && self.uninstall.blockIfWorkloadsPresent || has(self.uninstall.deleteCRDs) && self.uninstall.deleteCRDs)",message="drainGpuWorkloads, blockIfWorkloadsPresent and deleteCRDs are not supported with the vendor AMD"
+type GpuOperatorSpec struct {
+	// Vendor selects the GPU operator to install: the NVIDIA GPU operator, or the AMD GPU operator with
+	// the ROCm device plugin and node labeller. An NVIDIA and an AMD GpuOperator can manage the same cluster
+	// +optional
+	// +kubebuilder:default=NVIDIA
+	// +kubebuilder:validation:Enum=NVIDIA;AMD
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="vendor cannot be changed after creation"
+	Vendor v1alpha1.GPUVendor `json:"vendor,omitempty"`
+
+	// AMD configures the AMD GPU operator, if vendor is AMD
+	// +optional
+	AMD *v1alpha1.AMDSpec `json:"amd,omitempty"`
+
+	// Namespace where the GPU operator will be installed
+	// Defaults to the defaultNamespace of the controller configuration, gpu-operator unless changed, or to
+	// its defaultAMDNamespace, kube-amd-gpu unless changed, for the vendor AMD
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+
+	// Chart configures the GPU operator chart, its values and how it is installed
+	// +optional
+	// +kubebuilder:default={}
+	Chart *ChartSpec `json:"chart,omitempty"`
+
+	// Driver configures the NVIDIA driver version, how the driver is installed and its container image
+	// +optional
+	Driver *DriverSpec `json:"driver,omitempty"`
+
+	// Components configures the operands of the GPU operator and pins their versions
+	// +optional
+	Components *ComponentsSpec `json:"components,omitempty"`
+
+	// Registry points the installation at private mirrors of the NVIDIA image registries and Helm repository,
+	// for clusters that cannot reach nvcr.io or helm.ngc.nvidia.com
+	// +optional
+	Registry *RegistrySpec `json:"registry,omitempty"`
+
+	// Operands configures where the operand DaemonSets run and how they roll out changes
+	// +optional
+	Operands *OperandsSpec `json:"operands,omitempty"`
+
+	// NamespaceDefaults configures a ResourceQuota and LimitRange in the installation namespace
+	// +optional
+	NamespaceDefaults *v1alpha1.NamespaceDefaults `json:"namespaceDefaults,omitempty"`
+
+	// Install configures how the GPU operator is installed
+	// +optional
+	Install *v1alpha1.InstallSpec `json:"install,omitempty"`
+
+	// Uninstall configures how the GPU operator is removed when the CR is deleted
+	// +optional
+	Uninstall *v1alpha1.UninstallSpec `json:"uninstall,omitempty"`
+
+	// Images overrides the helper images launched by the controller for this CR.
+	// Unset images fall back to the operator-level defaults
+	// +optional
+	Images *v1alpha1.HelperImages `json:"images,omitempty"`
+
+	// Validation configures the CUDA validation after the installation and the CUDA smoke tests run on the
+	// GPU worker pools
+	// +optional
+	Validation *v1alpha1.ValidationSpec `json:"validation,omitempty"`
+
+	// ReadinessChecks defines what the GPU stack must provide for the CR to become Ready.
+	// If unset, the CR is Ready once the installation completed
+	// +optional
+	ReadinessChecks *v1alpha1.ReadinessChecks `json:"readinessChecks,omitempty"`
+
+	// SkipCapacityCheck lets the CR become Ready without any node advertising allocatable nvidia.com/gpu,
+	// e.g. when the GPU worker pools scale up from zero only once GPU workloads are scheduled
+	// +optional
+	SkipCapacityCheck bool `json:"skipCapacityCheck,omitempty"`
+
+	// HealthCheck detects unhealthy GPUs, reports them in the status and optionally remediates them
+	// +optional
+	HealthCheck *v1alpha1.HealthCheckSpec `json:"healthCheck,omitempty"`
+
+	// UpgradePolicy keeps the GPU operator chart current: the controller periodically looks up new chart
+	// versions in the Helm repository and upgrades within the scope of the upgrade channel
+	// +optional
+	UpgradePolicy *v1alpha1.UpgradePolicySpec `json:"upgradePolicy,omitempty"`
+
+	// AllowDowngrade installs a chart or driver version lower than the installed one. Without it, such a
+	// spec is refused with the DowngradeBlocked condition, as the GPU operator does not support downgrades
+	// +optional
+	AllowDowngrade bool `json:"allowDowngrade,omitempty"`
+
+	// Paused stops all changes the controller makes to the cluster, e.g. to debug the GPU stack by hand,
+	// while the status keeps being reported. The operator.kyma-project.io/suspend annotation set to true
+	// has the same effect
+	// +optional
+	Paused bool `json:"paused,omitempty"`
+
+	// Workloads configures the kinds of workloads the GPU nodes serve
+	// +optional
+	Workloads *v1alpha1.WorkloadsSpec `json:"workloads,omitempty"`
+
+	// Kueue configures the integration with the Kueue job queueing system
+	// +optional
+	Kueue *v1alpha1.KueueSpec `json:"kueue,omitempty"`
+
+	// FIPSMode deploys the FIPS-validated images configured in the controller configuration for the GPU
+	// operator and every deployed operand. Installation fails if a deployed component has no FIPS image
+	// +optional
+	FIPSMode bool `json:"fipsMode,omitempty"`
+
+	// Proxy routes the chart and values downloads of the installation and the downloads of the driver through
+	// an HTTP proxy, for clusters behind a corporate proxy
+	// +optional
+	Proxy *v1alpha1.ProxySpec `json:"proxy,omitempty"`
+
+	// TargetClusterKubeconfigSecretRef references a Secret in the namespace of the CR with the kubeconfig
+	// of a remote cluster, e.g. a shoot managed from a Kyma management cluster. The GPU stack is installed
+	// into and monitored in that cluster instead of the cluster the controller runs in
+	// +optional
+	TargetClusterKubeconfigSecretRef *v1alpha1.KubeconfigSecretReference `json:"targetClusterKubeconfigSecretRef,omitempty"`
+}
+
+// ChartSpec defines the GPU operator chart, its values and how it is installed
+// +kubebuilder:validation:XValidation:rule="!has(self.version) || self.version == '' || !has(self.installEngine) || self.installEngine != 'Manifest'",message="version requires a Helm install engine, the Manifest install engine uses the chart rendered into the image"
+// +kubebuilder:validation:XValidation:rule="!has(self.repo) || !has(self.installEngine) || self.installEngine != 'Manifest'",message="repo requires a Helm install engine, the Manifest install engine uses the manifests rendered into the image"
+// +kubebuilder:validation:XValidation:rule="!has(self.valuesConfigMapName) || self.valuesConfigMapName == '' || !has(self.installEngine) || self.installEngine != 'Manifest'",message="valuesConfigMapName requires a Helm install engine, the Manifest install engine uses the values rendered into the image"
+// +kubebuilder:validation:XValidation:rule="!has(self.valuesSource) || self.valuesSource != 'ConfigMap' || (has(self.valuesConfigMapName) && self.valuesConfigMapName != '')",message="valuesSource ConfigMap requires valuesConfigMapName"
+// +kubebuilder:validation:XValidation:rule="!has(self.valuesSource) || self.valuesSource == 'Remote' || !has(self.installEngine) || self.installEngine != 'Manifest'",message="valuesSource requires a Helm install engine, the Manifest install engine uses the values rendered into the image"
+// +kubebuilder:validation:XValidation:rule="!(has(self.values) || has(self.rawValues)) || !has(self.installEngine) || self.installEngine != 'Manifest'",message="values and rawValues require a Helm install engine, the Manifest install engine uses the values rendered into the image"
+// +kubebuilder:validation:XValidation:rule="!has(self.clusterPolicyManagement) || self.clusterPolicyManagement == 'Chart' || !has(self.installEngine) || self.installEngine != 'Manifest'",message="clusterPolicyManagement Controller requires a Helm install engine"
+type ChartSpec struct {
+	// Version pins the version of the nvidia/gpu-operator chart, e.g. v25.3.0
+	// If empty, the latest chart version is installed
+	// +optional
+	// +kubebuilder:validation:Pattern=`^v?[0-9]+\.[0-9]+\.[0-9]+(-[0-9A-Za-z.-]+)?$`
+	Version string `json:"version,omitempty"`
+
+	// Repo installs the charts from an internal mirror of the NVIDIA Helm repository, optionally with
+	// credentials. Mutually exclusive with registry.helmRepoUrl
+	// +optional
+	Repo *v1alpha1.HelmRepoSpec `json:"repo,omitempty"`
+
+	// InstallEngine selects how the GPU operator is installed. Helm runs Helm in the controller with the
+	// Helm SDK, Manifest applies the manifests rendered at build time with server-side apply and prunes
+	// removed objects
+	// +optional
+	// +kubebuilder:default=Helm
+	// +kubebuilder:validation:Enum=Helm;Manifest
+	InstallEngine v1alpha1.InstallEngine `json:"installEngine,omitempty"`
+
+	// ValuesSource selects the Garden Linux values the custom values are merged over. Remote fetches the values
+	// published by the Gardener project and falls back to the copy embedded in the controller if they cannot be
+	// fetched, Embedded always uses that copy, and ConfigMap uses the values of valuesConfigMapName instead
+	// +optional
+	// +kubebuilder:default=Remote
+	// +kubebuilder:validation:Enum=Remote;Embedded;ConfigMap
+	ValuesSource v1alpha1.ValuesSource `json:"valuesSource,omitempty"`
+
+	// ValuesConfigMapName is the name of a ConfigMap in the namespace of the CR whose values.yaml key holds
+	// custom Helm values. They are deep-merged over the Garden Linux values; the settings of the spec take
+	// precedence over both
+	// +optional
+	ValuesConfigMapName string `json:"valuesConfigMapName,omitempty"`
+
+	// Values are Helm values of the operand sections of the chart, merged over the values of
+	// valuesConfigMapName
+	// +optional
+	Values *v1alpha1.HelmValues `json:"values,omitempty"`
+
+	// RawValues are unstructured Helm values merged over values, for chart settings values does not cover
+	// +optional
+	// +kubebuilder:pruning:PreserveUnknownFields
+	// +kubebuilder:validation:Type=object
+	RawValues *runtime.RawExtension `json:"rawValues,omitempty"`
+
+	// ClusterPolicyManagement selects who configures the operands. Chart passes the operand settings of the
+	// spec to the chart, which renders them into the ClusterPolicy. Controller installs the chart with the
+	// operands disabled and keeps the ClusterPolicy in sync with the spec from the controller
+	// +optional
+	// +kubebuilder:default=Chart
+	// +kubebuilder:validation:Enum=Chart;Controller
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="clusterPolicyManagement cannot be changed after creation"
+	ClusterPolicyManagement v1alpha1.ClusterPolicyManagement `json:"clusterPolicyManagement,omitempty"`
+
+	// ResyncPeriod is how often the deployed Helm release is compared with the desired installation.
+	// A release that was uninstalled, upgraded or rolled back by hand is reinstalled. Defaults to 1h
+	// +optional
+	ResyncPeriod *metav1.Duration `json:"resyncPeriod,omitempty"`
+}
+
+// DriverSpec defines the NVIDIA driver version, how the driver is installed and its container image
+// +kubebuilder:validation:XValidation:rule="!has(self.repository) || !has(self.image) || !(self.image.contains(':') || self.image.contains('@'))",message="a pinned image reference cannot be combined with repository"
+// +kubebuilder:validation:XValidation:rule="!has(self.mode) || self.mode != 'Preinstalled' || !(has(self.repository) || has(self.image))",message="a preinstalled driver has no image"
+// +kubebuilder:validation:XValidation:rule="!has(self.mode) || self.mode != 'Preinstalled' || !has(self.upgrade)",message="a preinstalled driver is not upgraded by the GPU operator"
+// +kubebuilder:validation:XValidation:rule="!has(self.mode) || self.mode != 'Preinstalled' || !has(self.version) || self.version == ''",message="version cannot be set with a preinstalled driver"
+// +kubebuilder:validation:XValidation:rule="!has(self.mode) || self.mode != 'Preinstalled' || !has(self.ngcSecretRef)",message="ngcSecretRef configures the driver, which is not deployed with a preinstalled driver"
+// +kubebuilder:validation:XValidation:rule="!has(self.vgpu) || !has(self.ngcSecretRef)",message="vgpu and ngcSecretRef are mutually exclusive"
+// +kubebuilder:validation:XValidation:rule="!has(self.vgpu) || !(has(self.repository) || has(self.image))",message="vgpu sets the driver image, it cannot be combined with repository or image"
+// +kubebuilder:validation:XValidation:rule="!has(self.vgpu) || !has(self.mode) || self.mode == 'Managed'",message="vgpu requires the driver mode Managed"
+type DriverSpec struct {
+	// Version specifies the NVIDIA driver version to install
+	// Compatible with Garden Linux kernel versions in Kyma clusters
+	// If empty, the newest driver branch supported by every detected GPU model is selected
+	// +optional
+	Version string `json:"version,omitempty"`
+
+	// Mode is how the driver is installed: Managed deploys it as configured by the chart values, Preinstalled
+	// uses the driver of the node image and deploys only the toolkit and device plugin stack, Precompiled deploys
+	// the precompiled Garden Linux driver images. Defaults to Managed
+	// +optional
+	Mode v1alpha1.DriverMode `json:"mode,omitempty"`
+
+	// Repository is the registry path of the driver images, e.g. registry.example.com/nvidia.
+	// The GPU operator pulls <repository>/<image>:<version>-<os>
+	// +optional
+	Repository string `json:"repository,omitempty"`
+
+	// Image is the name of the driver image, e.g. driver, or a complete image reference with a tag or
+	// digest, e.g. registry.example.com/nvidia/driver@sha256:..., which pins exactly that image
+	// +optional
+	Image string `json:"image,omitempty"`
+
+	// NGCSecretRef references a Secret in the namespace of the CR with the NGC API key and the NVIDIA
+	// License System client configuration, for NGC enterprise driver images. The controller derives the
+	// image pull secret and the licensing configuration of the driver from it
+	// +optional
+	NGCSecretRef *v1alpha1.SecretReference `json:"ngcSecretRef,omitempty"`
+
+	// VGPU configures the vGPU guest driver for GPU nodes running on NVIDIA vGPU: the driver image from a
+	// private registry and its licensing
+	// +optional
+	VGPU *v1alpha1.VGPUSpec `json:"vgpu,omitempty"`
+
+	// Upgrade configures how a change of the driver version rolls through the GPU nodes
+	// +optional
+	Upgrade *v1alpha1.DriverUpgradeSpec `json:"upgrade,omitempty"`
+}
+
+// ComponentsSpec defines the operands of the GPU operator
+// +kubebuilder:validation:XValidation:rule="!has(self.dra) || !self.dra.enabled || !has(self.devicePlugin) || !has(self.devicePlugin.reservedGpus) || size(self.devicePlugin.reservedGpus) == 0",message="reservedGpus requires the device plugin, which is disabled with dra"
+// +kubebuilder:validation:XValidation:rule="!has(self.dra) || !self.dra.enabled || !has(self.timeSlicing)",message="timeSlicing configures the device plugin, which is disabled with dra"
+type ComponentsSpec struct {
+	// Versions pins the versions of individual operands, independent of the chart defaults
+	// +optional
+	Versions *v1alpha1.ComponentVersions `json:"versions,omitempty"`
+
+	// DevicePlugin configures the resources advertised by the NVIDIA device plugin
+	// +optional
+	DevicePlugin *v1alpha1.DevicePluginSpec `json:"devicePlugin,omitempty"`
+
+	// Toolkit configures where the container toolkit installs the NVIDIA runtime and which containerd it configures
+	// +optional
+	Toolkit *v1alpha1.ToolkitSpec `json:"toolkit,omitempty"`
+
+	// RuntimeClass configures the RuntimeClass of the NVIDIA container runtime
+	// +optional
+	RuntimeClass *v1alpha1.RuntimeClassSpec `json:"runtimeClass,omitempty"`
+
+	// Validator configures the operator validator that checks the operands on every GPU node
+	// +optional
+	Validator *v1alpha1.ValidatorSpec `json:"validator,omitempty"`
+
+	// GFD configures GPU Feature Discovery
+	// +optional
+	GFD *v1alpha1.GFDSpec `json:"gfd,omitempty"`
+
+	// Monitoring configures the GPU monitoring operands
+	// +optional
+	Monitoring *v1alpha1.MonitoringSpec `json:"monitoring,omitempty"`
+
+	// MIG configures the MIG manager
+	// +optional
+	MIG *v1alpha1.MIGSpec `json:"mig,omitempty"`
+
+	// TimeSlicing shares every GPU between several containers by advertising it several times
+	// +optional
+	TimeSlicing *v1alpha1.TimeSlicingSpec `json:"timeSlicing,omitempty"`
+
+	// DRA configures GPU allocation with Dynamic Resource Allocation
+	// +optional
+	DRA *v1alpha1.DRASpec `json:"dra,omitempty"`
+}
+
+// RegistrySpec defines the private mirrors the GPU stack is installed from
+type RegistrySpec struct {
+	// Repository is the prefix all images are pulled from instead of their source registry, e.g.
+	// registry.example.com/nvidia-mirror. The path below the source registry is kept, so the images of
+	// nvcr.io/nvidia/k8s are pulled from registry.example.com/nvidia-mirror/nvidia/k8s
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]([a-z0-9.:-]*[a-z0-9])?(/[a-z0-9._-]+)*$`
+	Repository string `json:"repository"`
+
+	// HelmRepoURL is the mirror of the NVIDIA Helm repository https://helm.ngc.nvidia.com/nvidia
+	// +optional
+	// +kubebuilder:validation:Pattern=`^https?://[^\s]+$`
+	HelmRepoURL string `json:"helmRepoUrl,omitempty"`
+
+	// ImagePullSecrets reference Secrets of type kubernetes.io/dockerconfigjson in the namespace of the CR
+	// that authenticate to the mirror. They are copied into the installation namespace and used by the GPU
+	// operator, its operands and the helper pods of the controller
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	ImagePullSecrets []v1alpha1.SecretReference `json:"imagePullSecrets,omitempty"`
+
+	// CABundle holds the PEM-encoded CA certificates the TLS certificate of the Helm repository mirror is
+	// verified with. Image pulls use the CA certificates trusted by the nodes
+	// +optional
+	CABundle string `json:"caBundle,omitempty"`
+}
+
+// OperandsSpec defines where the operand DaemonSets run and how they roll out changes
+type OperandsSpec struct {
+	// NodeSelector restricts the operands to the GPU nodes with these labels, e.g. the
+	// worker.gardener.cloud/pool label of the GPU worker pools. GPU nodes that do not match get no driver,
+	// container toolkit, device plugin or other operand
+	// +optional
+	NodeSelector map[string]string `json:"nodeSelector,omitempty"`
+
+	// Tolerations are additional taints the operands and the Node Feature Discovery worker tolerate, e.g. the
+	// taints of dedicated GPU worker pools
+	// +optional
+	Tolerations []corev1.Toleration `json:"tolerations,omitempty"`
+
+	// Resources defines resource limits for GPU operator components
+	// +optional
+	Resources *v1alpha1.ResourceRequirements `json:"resources,omitempty"`
+
+	// DaemonSets configures how the operand DaemonSets roll out changes
+	// +optional
+	DaemonSets *v1alpha1.DaemonSetsSpec `json:"daemonsets,omitempty"`
+
+	// Spot configures the handling of frequently replaced spot/preemptible GPU nodes
+	// +optional
+	Spot *v1alpha1.SpotSpec `json:"spot,omitempty"`
+}
+
+// v1beta1 is only served together with the conversion webhook, see config/crd/patches/serve_v1beta1.yaml
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:unservedversion
+// +kubebuilder:resource:scope=Namespaced,categories=kyma-modules,shortName=gpuop
+// +kubebuilder:printcolumn:name="State",type=string,JSONPath=`.status.state`
+// +kubebuilder:printcolumn:name="Driver Version",type=string,JSONPath=`.spec.driver.version`
+// +kubebuilder:printcolumn:name="Compatibility",type=string,JSONPath=`.status.compatibility.result`,priority=1
+// +kubebuilder:printcolumn:name="Conditions",type=string,JSONPath=`.status.conditions[?(@.status=="True")].type`
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+
+// GpuOperator is the Schema for the gpuoperators API
+type GpuOperator struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   GpuOperatorSpec            `json:"spec,omitempty"`
+	Status v1alpha1.GpuOperatorStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// GpuOperatorList contains a list of GpuOperator
+type GpuOperatorList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []GpuOperator `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&GpuOperator{}, &GpuOperatorList{})
+}
@@ -20,34 +20,54 @@ import (
 	"crypto/tls"
 	"flag"
 	"os"
+	"strings"
 
 	// Import all Kubernetes client auth plugins (e.g. Azure, GCP, OIDC, etc.)
 	// to ensure that exec-entrypoint and run can make use of them.
 	_ "k8s.io/client-go/plugin/pkg/client/auth"
 
+	"go.uber.org/zap/zapcore"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
 	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/healthz"
 	"sigs.k8s.io/controller-runtime/pkg/log/zap"
+	"sigs.k8s.io/controller-runtime/pkg/metrics/filters"
 	metricsserver "sigs.k8s.io/controller-runtime/pkg/metrics/server"
 	"sigs.k8s.io/controller-runtime/pkg/webhook"
 
 	operatorv1alpha1 "github.com/kyma-project/gpu-operator/api/v1alpha1"
+	operatorv1beta1 "github.com/kyma-project/gpu-operator/api/v1beta1"
+	"github.com/kyma-project/gpu-operator/internal/certs"
+	"github.com/kyma-project/gpu-operator/internal/config"
 	"github.com/kyma-project/gpu-operator/internal/controller"
+	"github.com/kyma-project/gpu-operator/internal/logging"
+	"github.com/kyma-project/gpu-operator/internal/migration"
+	"github.com/kyma-project/gpu-operator/internal/statusz"
+	webhookoperatorv1alpha1 "github.com/kyma-project/gpu-operator/internal/webhook/v1alpha1"
 	// +kubebuilder:scaffold:imports
 )
 
 var (
 	scheme   = runtime.NewScheme()
 	setupLog = ctrl.Log.WithName("setup")
+
+	// version is the module version, set at build time with -ldflags "-X main.version=<version>"
+	version = "dev"
 )
 
+// gpuOperatorCRD is the CRD whose conversion webhook and stored versions the manager maintains
+const gpuOperatorCRD = "gpuoperators.operator.kyma-project.io"
+
 func init() {
 	utilruntime.Must(clientgoscheme.AddToScheme(scheme))
 
 	utilruntime.Must(operatorv1alpha1.AddToScheme(scheme))
+	utilruntime.Must(operatorv1beta1.AddToScheme(scheme))
 	// +kubebuilder:scaffold:scheme
 }
 
@@ -56,7 +76,22 @@ func main() {
 	var enableLeaderElection bool
 	var probeAddr string
 	var secureMetrics bool
+	var metricsCertDir string
 	var enableHTTP2 bool
+	var configFile string
+	var logLevels string
+	var leaderElectionID string
+	var watchNamespaces string
+	var shardSelector string
+	var enableWebhooks bool
+	var webhookCertMode string
+	var webhookServiceName string
+	var webhookCertSecret string
+	var webhookConfigurations string
+	var imageMirror string
+	var validationImage string
+	var devicePluginImage string
+	var statuszAddr string
 	var tlsOpts []func(*tls.Config)
 	flag.StringVar(&metricsAddr, "metrics-bind-address", "0", "The address the metrics endpoint binds to. "+
 		"Use :8443 for HTTPS or :8080 for HTTP, or leave as 0 to disable the metrics service.")
@@ -65,16 +100,139 @@ func main() {
 		"Enable leader election for controller manager. "+
 			"Enabling this will ensure there is only one active controller manager.")
 	flag.BoolVar(&secureMetrics, "metrics-secure", true,
-		"If set, the metrics endpoint is served securely via HTTPS. Use --metrics-secure=false to use HTTP instead.")
+		"If set, the metrics endpoint is served securely via HTTPS and only to authenticated and authorized callers. "+
+			"Use --metrics-secure=false to use HTTP instead.")
+	flag.StringVar(&metricsCertDir, "metrics-cert-dir", "",
+		"Directory containing tls.crt and tls.key of the metrics serving certificate. "+
+			"A self-signed certificate is generated if empty.")
 	flag.BoolVar(&enableHTTP2, "enable-http2", false,
 		"If set, HTTP/2 will be enabled for the metrics and webhook servers")
+	flag.StringVar(&leaderElectionID, "leader-election-id", "gpu-operator.kyma-project.io",
+		"Name of the leader election lease. Each shard must use its own ID.")
+	flag.StringVar(&watchNamespaces, "watch-namespaces", "",
+		"Comma-separated namespaces watched for GpuOperator CRs. All namespaces are watched if empty.")
+	flag.StringVar(&shardSelector, "shard-selector", "",
+		"Label selector restricting the GpuOperator CRs handled by this instance, e.g. shard=a. "+
+			"Allows running multiple controller instances without overlapping work.")
+	flag.BoolVar(&enableWebhooks, "enable-webhooks", false,
+		"If set, the admission webhooks are served and their serving certificate is managed by the controller.")
+	flag.StringVar(&webhookCertMode, "webhook-cert-mode", string(certs.ModeAuto),
+		"How the webhook serving certificate is provisioned: auto, cert-manager or self-signed. "+
+			"auto uses cert-manager when it is installed.")
+	flag.StringVar(&webhookServiceName, "webhook-service-name", "gpu-operator-webhook-service",
+		"Name of the Service fronting the webhook server; the certificate is issued for its DNS names.")
+	flag.StringVar(&webhookCertSecret, "webhook-cert-secret", "gpu-operator-webhook-server-cert",
+		"Name of the Secret holding the webhook serving certificate.")
+	flag.StringVar(&webhookConfigurations, "webhook-configurations",
+		"gpu-operator-validating-webhook-configuration,gpu-operator-mutating-webhook-configuration",
+		"Comma-separated Validating/MutatingWebhookConfiguration names whose caBundle is managed.")
+	flag.StringVar(&imageMirror, "image-mirror", os.Getenv("IMAGE_MIRROR"),
+		"Registry prefix all helper images are pulled from, replacing their source registry, "+
+			"e.g. registry.local/mirror. Defaults to the IMAGE_MIRROR environment variable.")
+	flag.StringVar(&validationImage, "validation-image", os.Getenv("RELATED_IMAGE_VALIDATION"),
+		"Image of the validator and test workload pods. "+
+			"Defaults to the RELATED_IMAGE_VALIDATION environment variable.")
+	flag.StringVar(&devicePluginImage, "device-plugin-image", os.Getenv("RELATED_IMAGE_DEVICE_PLUGIN"),
+		"Image of the device plugin serving worker pools with reserved GPUs. "+
+			"Defaults to the RELATED_IMAGE_DEVICE_PLUGIN environment variable.")
+	flag.StringVar(&statuszAddr, "statusz-bind-address", "0",
+		"The address the /statusz debug endpoint binds to, e.g. 127.0.0.1:8082. "+
+			"It dumps the computed state of every GpuOperator CR as JSON. Leave as 0 to disable it.")
+	flag.StringVar(&configFile, "config", "",
+		"Path to a ControllerConfig file. Controller settings are reloaded when the file changes; "+
+			"flags set explicitly on the command line take precedence over the file.")
+	flag.StringVar(&logLevels, "log-levels", "",
+		"Per-subsystem log verbosity as comma-separated <subsystem>=<level> pairs, e.g. helm=2,health=1,webhook=1. "+
+			"Subsystems not listed use the --zap-log-level verbosity.")
 	opts := zap.Options{
 		Development: true,
 	}
 	opts.BindFlags(flag.CommandLine)
 	flag.Parse()
 
-	ctrl.SetLogger(zap.New(zap.UseFlagOptions(&opts)))
+	subsystemLevels, err := logging.ParseLevels(logLevels)
+	if err != nil {
+		setupLog.Error(err, "invalid --log-levels")
+		os.Exit(1)
+	}
+	// The zap sink must be verbose enough for the most verbose subsystem; the per-subsystem
+	// filtering is done by the logging wrapper
+	defaultLevel := 0
+	if opts.Level != nil {
+		for v := 0; v <= 10 && opts.Level.Enabled(zapcore.Level(-v)); v++ {
+			defaultLevel = v
+		}
+	} else if opts.Development {
+		defaultLevel = 1
+	}
+	if maxLevel := subsystemLevels.Max(defaultLevel); maxLevel > defaultLevel {
+		opts.Level = zapcore.Level(-maxLevel)
+	}
+	ctrl.SetLogger(logging.NewLogger(zap.New(zap.UseFlagOptions(&opts)), defaultLevel, subsystemLevels))
+
+	controllerConfig := config.Default()
+	if configFile != "" {
+		if controllerConfig, err = config.Load(configFile); err != nil {
+			setupLog.Error(err, "unable to load controller config")
+			os.Exit(1)
+		}
+
+		// Manager options cannot be reloaded, so they are only taken from the file at startup
+		// and only when the corresponding flag was not set explicitly
+		setFlags := map[string]bool{}
+		flag.Visit(func(f *flag.Flag) { setFlags[f.Name] = true })
+		if !setFlags["metrics-bind-address"] && controllerConfig.Metrics.BindAddress != "" {
+			metricsAddr = controllerConfig.Metrics.BindAddress
+		}
+		if !setFlags["metrics-secure"] && controllerConfig.Metrics.Secure != nil {
+			secureMetrics = *controllerConfig.Metrics.Secure
+		}
+		if !setFlags["metrics-cert-dir"] && controllerConfig.Metrics.CertDir != "" {
+			metricsCertDir = controllerConfig.Metrics.CertDir
+		}
+		if !setFlags["health-probe-bind-address"] && controllerConfig.HealthProbeBindAddress != "" {
+			probeAddr = controllerConfig.HealthProbeBindAddress
+		}
+		if !setFlags["leader-elect"] && controllerConfig.LeaderElection != nil {
+			enableLeaderElection = *controllerConfig.LeaderElection
+		}
+		if !setFlags["leader-election-id"] && controllerConfig.LeaderElectionID != "" {
+			leaderElectionID = controllerConfig.LeaderElectionID
+		}
+		if !setFlags["watch-namespaces"] && len(controllerConfig.WatchNamespaces) > 0 {
+			watchNamespaces = strings.Join(controllerConfig.WatchNamespaces, ",")
+		}
+		if !setFlags["shard-selector"] && controllerConfig.ShardSelector != "" {
+			shardSelector = controllerConfig.ShardSelector
+		}
+		if !setFlags["enable-webhooks"] && controllerConfig.Webhook.Enabled != nil {
+			enableWebhooks = *controllerConfig.Webhook.Enabled
+		}
+		if !setFlags["webhook-cert-mode"] && controllerConfig.Webhook.CertMode != "" {
+			webhookCertMode = controllerConfig.Webhook.CertMode
+		}
+		if !setFlags["webhook-service-name"] && controllerConfig.Webhook.ServiceName != "" {
+			webhookServiceName = controllerConfig.Webhook.ServiceName
+		}
+		if !setFlags["webhook-cert-secret"] && controllerConfig.Webhook.CertSecretName != "" {
+			webhookCertSecret = controllerConfig.Webhook.CertSecretName
+		}
+	}
+	// Helper images given on the command line or in the environment win over the config file,
+	// including after a reload
+	overrideImages := func(cfg *config.ControllerConfig) {
+		if imageMirror != "" {
+			cfg.ImageMirror = imageMirror
+		}
+		if validationImage != "" {
+			cfg.ValidationImage = validationImage
+		}
+		if devicePluginImage != "" {
+			cfg.DevicePluginImage = devicePluginImage
+		}
+	}
+	overrideImages(controllerConfig)
+	configStore := config.NewStore(controllerConfig)
 
 	// if the enable-http2 flag is false (the default), http/2 should be disabled
 	// due to its vulnerabilities. More specifically, disabling http/2 will
@@ -91,8 +249,25 @@ func main() {
 		tlsOpts = append(tlsOpts, disableHTTP2)
 	}
 
+	// The serving certificate is provisioned at runtime by the rotator instead of being mounted from a file
+	var certRotator *certs.Rotator
+	webhookTLSOpts := tlsOpts
+	if enableWebhooks {
+		certRotator = &certs.Rotator{
+			Mode:                  certs.Mode(webhookCertMode),
+			Namespace:             operatorNamespace(),
+			SecretName:            webhookCertSecret,
+			ServiceName:           webhookServiceName,
+			WebhookConfigurations: splitList(webhookConfigurations),
+			ConversionCRDs:        []string{gpuOperatorCRD},
+		}
+		webhookTLSOpts = append(webhookTLSOpts, func(c *tls.Config) {
+			c.GetCertificate = certRotator.GetCertificate
+		})
+	}
+
 	webhookServer := webhook.NewServer(webhook.Options{
-		TLSOpts: tlsOpts,
+		TLSOpts: webhookTLSOpts,
 	})
 
 	// Metrics endpoint is enabled in 'config/default/kustomization.yaml'. The Metrics options configure the server.
@@ -104,14 +279,34 @@ func main() {
 		SecureServing: secureMetrics,
 		TLSOpts:       tlsOpts,
 	}
+	if secureMetrics {
+		// Only authenticated callers allowed to get the /metrics non-resource URL can scrape the endpoint,
+		// see config/rbac/metrics_reader_role.yaml. Without a certificate directory, a self-signed
+		// certificate is generated at startup.
+		metricsServerOptions.FilterProvider = filters.WithAuthenticationAndAuthorization
+		metricsServerOptions.CertDir = metricsCertDir
+	}
+
+	gpuOperatorCache, err := gpuOperatorCacheOptions(watchNamespaces, shardSelector)
+	if err != nil {
+		setupLog.Error(err, "invalid watch restriction")
+		os.Exit(1)
+	}
 
 	mgr, err := ctrl.NewManager(ctrl.GetConfigOrDie(), ctrl.Options{
-		Scheme:                 scheme,
+		Scheme: scheme,
+		// Only the GpuOperator watch is restricted; the resources the controller manages
+		// live in the installation namespaces and must stay visible
+		Cache: cache.Options{
+			ByObject: map[client.Object]cache.ByObject{
+				&operatorv1alpha1.GpuOperator{}: gpuOperatorCache,
+			},
+		},
 		Metrics:                metricsServerOptions,
 		WebhookServer:          webhookServer,
 		HealthProbeBindAddress: probeAddr,
 		LeaderElection:         enableLeaderElection,
-		LeaderElectionID:       "gpu-operator.kyma-project.io",
+		LeaderElectionID:       leaderElectionID,
 		// LeaderElectionReleaseOnCancel defines if the leader should step down voluntarily
 		// when the Manager ends. This requires the binary to immediately end when the
 		// Manager is stopped, otherwise, this setting is unsafe. Setting this significantly
@@ -129,13 +324,60 @@ func main() {
 		os.Exit(1)
 	}
 
+	if configFile != "" {
+		if err := mgr.Add(&config.Watcher{Path: configFile, Store: configStore, Override: overrideImages}); err != nil {
+			setupLog.Error(err, "unable to set up config watcher")
+			os.Exit(1)
+		}
+	}
+
+	if certRotator != nil {
+		certRotator.Client = mgr.GetClient()
+		if err := mgr.Add(certRotator); err != nil {
+			setupLog.Error(err, "unable to set up webhook certificate rotator")
+			os.Exit(1)
+		}
+	}
+
+	// Rewrite the GpuOperators stored in an older version once the storage version of the CRD changed
+	if err := mgr.Add(&migration.StorageVersionMigrator{Client: mgr.GetClient(), CRDName: gpuOperatorCRD}); err != nil {
+		setupLog.Error(err, "unable to set up storage version migration")
+		os.Exit(1)
+	}
+
+	var statuszRecorder *statusz.Recorder
+	if statuszAddr != "0" && statuszAddr != "" {
+		statuszRecorder = statusz.NewRecorder()
+		if err := mgr.Add(&statusz.Server{BindAddress: statuszAddr, Recorder: statuszRecorder}); err != nil {
+			setupLog.Error(err, "unable to set up statusz endpoint")
+			os.Exit(1)
+		}
+	}
+
 	if err = (&controller.GpuOperatorReconciler{
-		Client: mgr.GetClient(),
-		Scheme: mgr.GetScheme(),
+		Client:        mgr.GetClient(),
+		APIReader:     mgr.GetAPIReader(),
+		Scheme:        mgr.GetScheme(),
+		Config:        configStore,
+		Statusz:       statuszRecorder,
+		Recorder:      mgr.GetEventRecorderFor("gpu-operator-module"),
+		ModuleVersion: version,
 	}).SetupWithManager(mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "GpuOperator")
 		os.Exit(1)
 	}
+	if err = (&controller.GPUNodeTracker{
+		Client: mgr.GetClient(),
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "GPUNode")
+		os.Exit(1)
+	}
+	if enableWebhooks {
+		if err = webhookoperatorv1alpha1.SetupGpuOperatorWebhookWithManager(mgr, configStore); err != nil {
+			setupLog.Error(err, "unable to create webhook", "webhook", "GpuOperator")
+			os.Exit(1)
+		}
+	}
 	// +kubebuilder:scaffold:builder
 
 	if err := mgr.AddHealthzCheck("healthz", healthz.Ping); err != nil {
@@ -146,10 +388,60 @@ func main() {
 		setupLog.Error(err, "unable to set up ready check")
 		os.Exit(1)
 	}
+	if certRotator != nil {
+		if err := mgr.AddReadyzCheck("webhook-cert", certRotator.ReadyCheck); err != nil {
+			setupLog.Error(err, "unable to set up webhook certificate check")
+			os.Exit(1)
+		}
+	}
 
-	setupLog.Info("starting manager")
+	setupLog.Info("starting manager", "version", version)
 	if err := mgr.Start(ctrl.SetupSignalHandler()); err != nil {
 		setupLog.Error(err, "problem running manager")
 		os.Exit(1)
 	}
 }
+
+// gpuOperatorCacheOptions restricts the GpuOperator informer to the given namespaces and label selector
+func gpuOperatorCacheOptions(namespaces, selector string) (cache.ByObject, error) {
+	byObject := cache.ByObject{}
+	for _, ns := range splitList(namespaces) {
+		if byObject.Namespaces == nil {
+			byObject.Namespaces = map[string]cache.Config{}
+		}
+		byObject.Namespaces[ns] = cache.Config{}
+	}
+	if selector != "" {
+		parsed, err := labels.Parse(selector)
+		if err != nil {
+			return cache.ByObject{}, err
+		}
+		byObject.Label = parsed
+	}
+	if len(byObject.Namespaces) > 0 || byObject.Label != nil {
+		setupLog.Info("restricting GpuOperator watch", "namespaces", namespaces, "shardSelector", selector)
+	}
+	return byObject, nil
+}
+
+// splitList splits a comma-separated flag value, dropping empty entries
+func splitList(value string) []string {
+	var items []string
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
+// operatorNamespace returns the namespace the manager runs in
+func operatorNamespace() string {
+	if ns := os.Getenv("POD_NAMESPACE"); ns != "" {
+		return ns
+	}
+	if data, err := os.ReadFile("/var/run/secrets/kubernetes.io/serviceaccount/namespace"); err == nil {
+		return strings.TrimSpace(string(data))
+	}
+	return "gpu-operator-system"
+}
@@ -0,0 +1,88 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package certs
+
+import (
+	"context"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+)
+
+// injectCAFromAnnotation tells the cert-manager CA injector which Certificate's CA to inject as caBundle
+const injectCAFromAnnotation = "cert-manager.io/inject-ca-from"
+
+var (
+	certificateGVK = schema.GroupVersionKind{Group: "cert-manager.io", Version: "v1", Kind: "Certificate"}
+	issuerGVK      = schema.GroupVersionKind{Group: "cert-manager.io", Version: "v1", Kind: "Issuer"}
+)
+
+// certManagerInstalled reports whether the cert-manager Certificate CRD is served by the cluster
+func (r *Rotator) certManagerInstalled() bool {
+	_, err := r.Client.RESTMapper().RESTMapping(certificateGVK.GroupKind(), certificateGVK.Version)
+	return err == nil
+}
+
+// ensureCertManagerCertificate creates a self-signed Issuer and a Certificate writing into the webhook Secret.
+// The Certificate carries the Secret's name so the inject-ca-from annotation can reference it directly.
+func (r *Rotator) ensureCertManagerCertificate(ctx context.Context) error {
+	issuerName := r.SecretName + "-issuer"
+	labels := map[string]interface{}{
+		"app.kubernetes.io/name":      "gpu-operator",
+		"app.kubernetes.io/component": "webhook",
+	}
+
+	issuer := &unstructured.Unstructured{}
+	issuer.SetGroupVersionKind(issuerGVK)
+	issuer.SetNamespace(r.Namespace)
+	issuer.SetName(issuerName)
+	if _, err := controllerutil.CreateOrUpdate(ctx, r.Client, issuer, func() error {
+		if err := unstructured.SetNestedMap(issuer.Object, labels, "metadata", "labels"); err != nil {
+			return err
+		}
+		return unstructured.SetNestedMap(issuer.Object, map[string]interface{}{}, "spec", "selfSigned")
+	}); err != nil {
+		return fmt.Errorf("failed to reconcile cert-manager Issuer %s: %w", issuerName, err)
+	}
+
+	dnsNames := make([]interface{}, 0, 4)
+	for _, name := range r.dnsNames() {
+		dnsNames = append(dnsNames, name)
+	}
+	certificate := &unstructured.Unstructured{}
+	certificate.SetGroupVersionKind(certificateGVK)
+	certificate.SetNamespace(r.Namespace)
+	certificate.SetName(r.SecretName)
+	if _, err := controllerutil.CreateOrUpdate(ctx, r.Client, certificate, func() error {
+		if err := unstructured.SetNestedMap(certificate.Object, labels, "metadata", "labels"); err != nil {
+			return err
+		}
+		return unstructured.SetNestedMap(certificate.Object, map[string]interface{}{
+			"secretName": r.SecretName,
+			"dnsNames":   dnsNames,
+			"issuerRef": map[string]interface{}{
+				"kind": issuerGVK.Kind,
+				"name": issuerName,
+			},
+		}, "spec")
+	}); err != nil {
+		return fmt.Errorf("failed to reconcile cert-manager Certificate %s: %w", r.SecretName, err)
+	}
+	return nil
+}
@@ -0,0 +1,158 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package certs provisions the serving certificate of the webhook server. Certificates are issued
+// by cert-manager when it is installed, otherwise by a self-signed CA that is rotated by the controller.
+package certs
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"time"
+)
+
+// Keys of the webhook certificate Secret; they match the kubernetes.io/tls layout used by cert-manager
+const (
+	CACertKey  = "ca.crt"
+	TLSCertKey = "tls.crt"
+	TLSKeyKey  = "tls.key"
+)
+
+// keyPair is a PEM encoded certificate and private key
+type keyPair struct {
+	cert []byte
+	key  []byte
+}
+
+// generateCA creates a self-signed CA valid for the given duration
+func generateCA(commonName string, validity time.Duration) (*keyPair, error) {
+	template := &x509.Certificate{
+		Subject:               pkix.Name{CommonName: commonName},
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageDigitalSignature,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	return issue(template, nil, validity)
+}
+
+// generateServingCert creates a serving certificate for the given DNS names signed by the CA
+func generateServingCert(ca *keyPair, dnsNames []string, validity time.Duration) (*keyPair, error) {
+	template := &x509.Certificate{
+		Subject:     pkix.Name{CommonName: dnsNames[0]},
+		DNSNames:    dnsNames,
+		KeyUsage:    x509.KeyUsageDigitalSignature | x509.KeyUsageKeyEncipherment,
+		ExtKeyUsage: []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+	}
+	return issue(template, ca, validity)
+}
+
+// issue signs the template with the parent key pair, or self-signs it if parent is nil
+func issue(template *x509.Certificate, parent *keyPair, validity time.Duration) (*keyPair, error) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate key: %w", err)
+	}
+	serial, err := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 128))
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate serial number: %w", err)
+	}
+
+	// Backdate slightly to tolerate clock skew between the controller and the API server
+	now := time.Now()
+	template.SerialNumber = serial
+	template.NotBefore = now.Add(-time.Hour)
+	template.NotAfter = now.Add(validity)
+
+	signer, signerKey := template, any(key)
+	if parent != nil {
+		parentCert, parentKey, err := parent.parse()
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse CA: %w", err)
+		}
+		signer, signerKey = parentCert, parentKey
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, signer, &key.PublicKey, signerKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create certificate: %w", err)
+	}
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal key: %w", err)
+	}
+	return &keyPair{
+		cert: pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+		key:  pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}),
+	}, nil
+}
+
+// parse decodes the PEM encoded certificate and private key
+func (k *keyPair) parse() (*x509.Certificate, any, error) {
+	cert, err := parseCert(k.cert)
+	if err != nil {
+		return nil, nil, err
+	}
+	block, _ := pem.Decode(k.key)
+	if block == nil {
+		return nil, nil, fmt.Errorf("no PEM data in private key")
+	}
+	key, err := x509.ParseECPrivateKey(block.Bytes)
+	if err != nil {
+		return nil, nil, err
+	}
+	return cert, key, nil
+}
+
+// parseCert decodes the first PEM encoded certificate
+func parseCert(data []byte) (*x509.Certificate, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, fmt.Errorf("no PEM data in certificate")
+	}
+	return x509.ParseCertificate(block.Bytes)
+}
+
+// needsRotation reports whether the serving certificate is missing, not issued by the CA,
+// not valid for the DNS names, or expires within refreshBefore
+func needsRotation(caPEM, certPEM []byte, dnsNames []string, refreshBefore time.Duration) bool {
+	ca, err := parseCert(caPEM)
+	if err != nil {
+		return true
+	}
+	cert, err := parseCert(certPEM)
+	if err != nil {
+		return true
+	}
+	deadline := time.Now().Add(refreshBefore)
+	if ca.NotAfter.Before(deadline) || cert.NotAfter.Before(deadline) {
+		return true
+	}
+	if err := cert.CheckSignatureFrom(ca); err != nil {
+		return true
+	}
+	for _, name := range dnsNames {
+		if cert.VerifyHostname(name) != nil {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,100 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package certs
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// +kubebuilder:rbac:groups=apiextensions.k8s.io,resources=customresourcedefinitions,verbs=get;list;watch;update;patch
+
+var crdGVK = schema.GroupVersionKind{Group: "apiextensions.k8s.io", Version: "v1", Kind: "CustomResourceDefinition"}
+
+// conversionCRDs returns the managed CRDs that convert their versions with the webhook
+func (r *Rotator) conversionCRDs(ctx context.Context) ([]*unstructured.Unstructured, error) {
+	var crds []*unstructured.Unstructured
+	for _, name := range r.ConversionCRDs {
+		crd := &unstructured.Unstructured{}
+		crd.SetGroupVersionKind(crdGVK)
+		if err := r.Client.Get(ctx, types.NamespacedName{Name: name}, crd); err != nil {
+			if apierrors.IsNotFound(err) {
+				continue
+			}
+			return nil, fmt.Errorf("failed to get CRD %s: %w", name, err)
+		}
+		if strategy, _, _ := unstructured.NestedString(crd.Object, "spec", "conversion", "strategy"); strategy == "Webhook" {
+			crds = append(crds, crd)
+		}
+	}
+	return crds, nil
+}
+
+// injectConversionCABundle patches the CA into the conversion webhook of the managed CRDs
+func (r *Rotator) injectConversionCABundle(ctx context.Context, caBundle []byte) error {
+	crds, err := r.conversionCRDs(ctx)
+	if err != nil {
+		return err
+	}
+	encoded := base64.StdEncoding.EncodeToString(caBundle)
+	for _, crd := range crds {
+		path := []string{"spec", "conversion", "webhook", "clientConfig", "caBundle"}
+		if current, _, _ := unstructured.NestedString(crd.Object, path...); current == encoded {
+			continue
+		}
+		patch := client.MergeFrom(crd.DeepCopy())
+		if err := unstructured.SetNestedField(crd.Object, encoded, path...); err != nil {
+			return err
+		}
+		if err := r.Client.Patch(ctx, crd, patch); err != nil {
+			return fmt.Errorf("failed to patch caBundle of CRD %s: %w", crd.GetName(), err)
+		}
+	}
+	return nil
+}
+
+// annotateConversionCRDs asks the cert-manager CA injector to maintain the caBundle of the conversion webhooks
+func (r *Rotator) annotateConversionCRDs(ctx context.Context) error {
+	crds, err := r.conversionCRDs(ctx)
+	if err != nil {
+		return err
+	}
+	value := r.Namespace + "/" + r.SecretName
+	for _, crd := range crds {
+		if crd.GetAnnotations()[injectCAFromAnnotation] == value {
+			continue
+		}
+		patch := client.MergeFrom(crd.DeepCopy())
+		annotations := crd.GetAnnotations()
+		if annotations == nil {
+			annotations = map[string]string{}
+		}
+		annotations[injectCAFromAnnotation] = value
+		crd.SetAnnotations(annotations)
+		if err := r.Client.Patch(ctx, crd, patch); err != nil {
+			return fmt.Errorf("failed to annotate CRD %s: %w", crd.GetName(), err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,353 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package certs
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net/http"
+	"sync/atomic"
+	"time"
+
+	"github.com/go-logr/logr"
+	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/kyma-project/gpu-operator/internal/logging"
+)
+
+// Mode selects how the webhook serving certificate is provisioned
+type Mode string
+
+const (
+	// ModeAuto uses cert-manager when its CRDs are installed and self-signed certificates otherwise
+	ModeAuto Mode = "auto"
+	// ModeCertManager requests the certificate from cert-manager, which also injects the caBundle
+	ModeCertManager Mode = "cert-manager"
+	// ModeSelfSigned generates and rotates a self-signed CA and patches the caBundle itself
+	ModeSelfSigned Mode = "self-signed"
+)
+
+const (
+	defaultCAValidity      = 10 * 365 * 24 * time.Hour
+	defaultServingValidity = 365 * 24 * time.Hour
+	defaultRefreshBefore   = 30 * 24 * time.Hour
+	defaultCheckInterval   = time.Hour
+)
+
+// +kubebuilder:rbac:groups="",resources=secrets,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=admissionregistration.k8s.io,resources=validatingwebhookconfigurations;mutatingwebhookconfigurations,verbs=get;list;watch;update;patch
+// +kubebuilder:rbac:groups=cert-manager.io,resources=issuers;certificates,verbs=get;list;watch;create;update;patch
+
+// Rotator keeps the webhook serving certificate in a Secret valid and serves it to the webhook server.
+// It runs on every replica: only one replica wins writing a rotated Secret, the others pick it up on
+// their next check.
+type Rotator struct {
+	Client client.Client
+	Mode   Mode
+
+	// Namespace of the webhook Service and the certificate Secret
+	Namespace string
+	// SecretName is the Secret holding ca.crt, tls.crt and tls.key
+	SecretName string
+	// ServiceName is the webhook Service; the certificate is issued for its cluster DNS names
+	ServiceName string
+	// WebhookConfigurations are the names of the Validating/MutatingWebhookConfigurations whose caBundle is managed
+	WebhookConfigurations []string
+	// ConversionCRDs are the names of the CRDs whose conversion webhook caBundle is managed
+	ConversionCRDs []string
+
+	// RefreshBefore is how long before expiry a self-signed certificate is rotated
+	RefreshBefore time.Duration
+	// Interval is how often the certificate and caBundles are checked
+	Interval time.Duration
+
+	current atomic.Pointer[tls.Certificate]
+}
+
+// Start implements manager.Runnable
+func (r *Rotator) Start(ctx context.Context) error {
+	logger := ctrl.Log.WithName(logging.SubsystemWebhook).WithValues("secret", r.SecretName)
+	interval := r.Interval
+	if interval <= 0 {
+		interval = defaultCheckInterval
+	}
+
+	mode, err := r.resolveMode()
+	if err != nil {
+		return err
+	}
+	logger.Info("Managing webhook certificate", "mode", mode)
+
+	// Retry quickly until the first certificate is served, then fall back to the regular interval
+	ticker := time.NewTicker(10 * time.Second)
+	defer ticker.Stop()
+	for {
+		if err := r.reconcile(ctx, logger, mode); err != nil {
+			logger.Error(err, "Failed to reconcile webhook certificate")
+		} else if r.current.Load() != nil {
+			ticker.Reset(interval)
+		}
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// NeedLeaderElection implements manager.LeaderElectionRunnable; every replica serves webhooks
+func (r *Rotator) NeedLeaderElection() bool {
+	return false
+}
+
+// GetCertificate serves the current certificate; it is meant to be set as tls.Config.GetCertificate
+func (r *Rotator) GetCertificate(*tls.ClientHelloInfo) (*tls.Certificate, error) {
+	cert := r.current.Load()
+	if cert == nil {
+		return nil, fmt.Errorf("webhook certificate is not provisioned yet")
+	}
+	return cert, nil
+}
+
+// ReadyCheck fails until a certificate has been loaded, so webhooks are not routed to a replica without one
+func (r *Rotator) ReadyCheck(*http.Request) error {
+	if r.current.Load() == nil {
+		return fmt.Errorf("webhook certificate is not provisioned yet")
+	}
+	return nil
+}
+
+// resolveMode replaces ModeAuto with the mode matching the cluster
+func (r *Rotator) resolveMode() (Mode, error) {
+	switch r.Mode {
+	case ModeCertManager, ModeSelfSigned:
+		return r.Mode, nil
+	case ModeAuto, "":
+		if r.certManagerInstalled() {
+			return ModeCertManager, nil
+		}
+		return ModeSelfSigned, nil
+	default:
+		return "", fmt.Errorf("unsupported webhook certificate mode %q", r.Mode)
+	}
+}
+
+// dnsNames returns the cluster DNS names of the webhook Service
+func (r *Rotator) dnsNames() []string {
+	return []string{
+		fmt.Sprintf("%s.%s.svc", r.ServiceName, r.Namespace),
+		fmt.Sprintf("%s.%s.svc.cluster.local", r.ServiceName, r.Namespace),
+		fmt.Sprintf("%s.%s", r.ServiceName, r.Namespace),
+		r.ServiceName,
+	}
+}
+
+func (r *Rotator) reconcile(ctx context.Context, logger logr.Logger, mode Mode) error {
+	if mode == ModeCertManager {
+		if err := r.ensureCertManagerCertificate(ctx); err != nil {
+			return err
+		}
+		if err := r.annotateWebhookConfigurations(ctx); err != nil {
+			return err
+		}
+		if err := r.annotateConversionCRDs(ctx); err != nil {
+			return err
+		}
+	} else if err := r.ensureSelfSignedSecret(ctx, logger); err != nil {
+		return err
+	}
+
+	secret := &corev1.Secret{}
+	if err := r.Client.Get(ctx, types.NamespacedName{Namespace: r.Namespace, Name: r.SecretName}, secret); err != nil {
+		if apierrors.IsNotFound(err) && mode == ModeCertManager {
+			logger.Info("Waiting for cert-manager to issue the webhook certificate")
+			return nil
+		}
+		return fmt.Errorf("failed to get webhook certificate secret: %w", err)
+	}
+	if err := r.load(secret); err != nil {
+		return err
+	}
+	if mode == ModeSelfSigned {
+		if err := r.injectCABundle(ctx, secret.Data[CACertKey]); err != nil {
+			return err
+		}
+		return r.injectConversionCABundle(ctx, secret.Data[CACertKey])
+	}
+	return nil
+}
+
+// load parses the Secret and swaps the served certificate if it changed
+func (r *Rotator) load(secret *corev1.Secret) error {
+	certPEM, keyPEM := secret.Data[TLSCertKey], secret.Data[TLSKeyKey]
+	if current := r.current.Load(); current != nil && len(current.Certificate) > 0 {
+		if leaf, err := parseCert(certPEM); err == nil && bytes.Equal(leaf.Raw, current.Certificate[0]) {
+			return nil
+		}
+	}
+	cert, err := tls.X509KeyPair(certPEM, keyPEM)
+	if err != nil {
+		return fmt.Errorf("invalid webhook certificate in secret %s: %w", r.SecretName, err)
+	}
+	r.current.Store(&cert)
+	ctrl.Log.WithName(logging.SubsystemWebhook).Info("Loaded webhook certificate", "secret", r.SecretName)
+	return nil
+}
+
+// ensureSelfSignedSecret creates the certificate Secret or rotates it when it is about to expire.
+// The CA is kept as long as it is valid, so caBundles keep working across serving certificate rotations.
+func (r *Rotator) ensureSelfSignedSecret(ctx context.Context, logger logr.Logger) error {
+	refreshBefore := r.RefreshBefore
+	if refreshBefore <= 0 {
+		refreshBefore = defaultRefreshBefore
+	}
+	dnsNames := r.dnsNames()
+
+	secret := &corev1.Secret{}
+	err := r.Client.Get(ctx, types.NamespacedName{Namespace: r.Namespace, Name: r.SecretName}, secret)
+	if err != nil && !apierrors.IsNotFound(err) {
+		return fmt.Errorf("failed to get webhook certificate secret: %w", err)
+	}
+	exists := err == nil
+	if exists && !needsRotation(secret.Data[CACertKey], secret.Data[TLSCertKey], dnsNames, refreshBefore) {
+		return nil
+	}
+
+	ca := &keyPair{cert: secret.Data[CACertKey], key: secret.Data["ca.key"]}
+	if caCert, _, err := ca.parse(); err != nil || caCert.NotAfter.Before(time.Now().Add(refreshBefore)) {
+		if ca, err = generateCA("gpu-operator-webhook-ca", defaultCAValidity); err != nil {
+			return err
+		}
+	}
+	serving, err := generateServingCert(ca, dnsNames, defaultServingValidity)
+	if err != nil {
+		return err
+	}
+
+	secret.Name = r.SecretName
+	secret.Namespace = r.Namespace
+	secret.Type = corev1.SecretTypeTLS
+	if secret.Labels == nil {
+		secret.Labels = map[string]string{}
+	}
+	secret.Labels["app.kubernetes.io/name"] = "gpu-operator"
+	secret.Labels["app.kubernetes.io/component"] = "webhook"
+	secret.Data = map[string][]byte{
+		CACertKey:  ca.cert,
+		"ca.key":   ca.key,
+		TLSCertKey: serving.cert,
+		TLSKeyKey:  serving.key,
+	}
+
+	if exists {
+		// A conflict means another replica rotated first; its certificate is picked up on the next check
+		if err := r.Client.Update(ctx, secret); err != nil {
+			return fmt.Errorf("failed to rotate webhook certificate: %w", err)
+		}
+		logger.Info("Rotated webhook certificate")
+		return nil
+	}
+	if err := r.Client.Create(ctx, secret); err != nil && !apierrors.IsAlreadyExists(err) {
+		return fmt.Errorf("failed to create webhook certificate secret: %w", err)
+	}
+	logger.Info("Created webhook certificate")
+	return nil
+}
+
+// injectCABundle patches the CA into every webhook of the managed webhook configurations.
+// Configurations that do not exist (yet) are skipped.
+func (r *Rotator) injectCABundle(ctx context.Context, caBundle []byte) error {
+	for _, name := range r.WebhookConfigurations {
+		validating := &admissionregistrationv1.ValidatingWebhookConfiguration{}
+		if err := r.Client.Get(ctx, types.NamespacedName{Name: name}, validating); err == nil {
+			patch := client.MergeFrom(validating.DeepCopy())
+			changed := false
+			for i := range validating.Webhooks {
+				if !bytes.Equal(validating.Webhooks[i].ClientConfig.CABundle, caBundle) {
+					validating.Webhooks[i].ClientConfig.CABundle = caBundle
+					changed = true
+				}
+			}
+			if changed {
+				if err := r.Client.Patch(ctx, validating, patch); err != nil {
+					return fmt.Errorf("failed to patch caBundle of ValidatingWebhookConfiguration %s: %w", name, err)
+				}
+			}
+		} else if !apierrors.IsNotFound(err) {
+			return err
+		}
+
+		mutating := &admissionregistrationv1.MutatingWebhookConfiguration{}
+		if err := r.Client.Get(ctx, types.NamespacedName{Name: name}, mutating); err == nil {
+			patch := client.MergeFrom(mutating.DeepCopy())
+			changed := false
+			for i := range mutating.Webhooks {
+				if !bytes.Equal(mutating.Webhooks[i].ClientConfig.CABundle, caBundle) {
+					mutating.Webhooks[i].ClientConfig.CABundle = caBundle
+					changed = true
+				}
+			}
+			if changed {
+				if err := r.Client.Patch(ctx, mutating, patch); err != nil {
+					return fmt.Errorf("failed to patch caBundle of MutatingWebhookConfiguration %s: %w", name, err)
+				}
+			}
+		} else if !apierrors.IsNotFound(err) {
+			return err
+		}
+	}
+	return nil
+}
+
+// annotateWebhookConfigurations asks the cert-manager CA injector to maintain the caBundles
+func (r *Rotator) annotateWebhookConfigurations(ctx context.Context) error {
+	value := r.Namespace + "/" + r.SecretName
+	for _, name := range r.WebhookConfigurations {
+		for _, obj := range []client.Object{
+			&admissionregistrationv1.ValidatingWebhookConfiguration{},
+			&admissionregistrationv1.MutatingWebhookConfiguration{},
+		} {
+			if err := r.Client.Get(ctx, types.NamespacedName{Name: name}, obj); err != nil {
+				if apierrors.IsNotFound(err) {
+					continue
+				}
+				return err
+			}
+			if obj.GetAnnotations()[injectCAFromAnnotation] == value {
+				continue
+			}
+			patch := client.MergeFrom(obj.DeepCopyObject().(client.Object))
+			annotations := obj.GetAnnotations()
+			if annotations == nil {
+				annotations = map[string]string{}
+			}
+			annotations[injectCAFromAnnotation] = value
+			obj.SetAnnotations(annotations)
+			if err := r.Client.Patch(ctx, obj, patch); err != nil {
+				return fmt.Errorf("failed to annotate webhook configuration %s: %w", name, err)
+			}
+		}
+	}
+	return nil
+}
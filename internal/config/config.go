@@ -0,0 +1,214 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package config contains the ComponentConfig-style configuration of the controller manager.
+// The configuration is loaded from a file (usually a mounted ConfigMap) and reloaded at runtime.
+package config
+
+import (
+	"fmt"
+	"os"
+	"slices"
+	"sync/atomic"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/yaml"
+
+	"github.com/kyma-project/gpu-operator/internal/eol"
+)
+
+const (
+	// APIVersion is the apiVersion expected in the configuration file
+	APIVersion = "config.operator.kyma-project.io/v1alpha1"
+	// Kind is the kind expected in the configuration file
+	Kind = "ControllerConfig"
+)
+
+// ControllerConfig defines the settings of the controller manager
+type ControllerConfig struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// RequeueInterval is how often the controller rechecks installations in progress
+	RequeueInterval metav1.Duration `json:"requeueInterval,omitempty"`
+
+	// DefaultNamespace is the installation namespace used when spec.namespace is empty
+	DefaultNamespace string `json:"defaultNamespace,omitempty"`
+
+	// DefaultAMDNamespace is the installation namespace used when spec.namespace is empty and spec.vendor is AMD
+	DefaultAMDNamespace string `json:"defaultAMDNamespace,omitempty"`
+
+	// ValidationImage is the image of the validator and test workload pods
+	ValidationImage string `json:"validationImage,omitempty"`
+
+	// DevicePluginImage is the image of the device plugin serving worker pools with reserved GPUs
+	DevicePluginImage string `json:"devicePluginImage,omitempty"`
+
+	// ImageMirror is a registry prefix all helper images are pulled from, replacing their source
+	// registry. Intended for air-gapped clusters
+	ImageMirror string `json:"imageMirror,omitempty"`
+
+	// ManifestsPath is the file or directory with the rendered manifests applied by the Manifest install engine
+	ManifestsPath string `json:"manifestsPath,omitempty"`
+
+	// EOLMatrix replaces the end-of-life matrix of driver branches and chart versions shipped with the controller
+	EOLMatrix *eol.Matrix `json:"eolMatrix,omitempty"`
+
+	// FIPSImages are the FIPS-validated images used by CRs with spec.fipsMode, by component.
+	// See FIPSComponents for the known components
+	FIPSImages map[string]string `json:"fipsImages,omitempty"`
+
+	// Metrics configures the metrics endpoint; changes require a restart
+	Metrics MetricsConfig `json:"metrics,omitempty"`
+
+	// HealthProbeBindAddress is the address the probe endpoint binds to; changes require a restart
+	HealthProbeBindAddress string `json:"healthProbeBindAddress,omitempty"`
+
+	// LeaderElection enables leader election; changes require a restart
+	LeaderElection *bool `json:"leaderElection,omitempty"`
+
+	// LeaderElectionID is the lease name; shards must use distinct IDs. Changes require a restart
+	LeaderElectionID string `json:"leaderElectionID,omitempty"`
+
+	// WatchNamespaces restricts the namespaces watched for GpuOperator CRs, all namespaces if empty.
+	// Changes require a restart
+	WatchNamespaces []string `json:"watchNamespaces,omitempty"`
+
+	// ShardSelector is a label selector restricting the GpuOperator CRs handled by this instance.
+	// Changes require a restart
+	ShardSelector string `json:"shardSelector,omitempty"`
+
+	// Webhook configures the admission webhook server and its certificate; changes require a restart
+	Webhook WebhookConfig `json:"webhook,omitempty"`
+}
+
+// WebhookConfig defines the webhook server settings
+type WebhookConfig struct {
+	// Enabled serves the admission webhooks
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// CertMode is how the serving certificate is provisioned: auto, cert-manager or self-signed
+	CertMode string `json:"certMode,omitempty"`
+
+	// ServiceName is the Service fronting the webhook server
+	ServiceName string `json:"serviceName,omitempty"`
+
+	// CertSecretName is the Secret holding the serving certificate
+	CertSecretName string `json:"certSecretName,omitempty"`
+}
+
+// MetricsConfig defines the metrics endpoint settings
+type MetricsConfig struct {
+	// BindAddress is the address the metrics endpoint binds to, "0" disables it
+	BindAddress string `json:"bindAddress,omitempty"`
+
+	// Secure serves the metrics endpoint via HTTPS to authenticated and authorized callers only
+	Secure *bool `json:"secure,omitempty"`
+
+	// CertDir contains tls.crt and tls.key of the metrics serving certificate, self-signed if empty
+	CertDir string `json:"certDir,omitempty"`
+}
+
+// FIPSComponents are the components that can have a FIPS-validated image: the GPU operator with
+// its operands
+var FIPSComponents = []string{
+	"operator", "driver", "toolkit", "devicePlugin", "gfd", "nodeFeatureDiscovery",
+	"dcgm", "dcgmExporter", "migManager", "validator",
+}
+
+// Default returns the configuration used when no file is given or a value is not set
+func Default() *ControllerConfig {
+	return &ControllerConfig{
+		TypeMeta:            metav1.TypeMeta{APIVersion: APIVersion, Kind: Kind},
+		RequeueInterval:     metav1.Duration{Duration: 10 * time.Second},
+		DefaultNamespace:    "gpu-operator",
+		DefaultAMDNamespace: "kube-amd-gpu",
+		ValidationImage:     "nvcr.io/nvidia/cuda:12.8.1-base-ubuntu24.04",
+		DevicePluginImage:   "nvcr.io/nvidia/k8s-device-plugin:v0.17.1",
+		ManifestsPath:       "/module-data/rendered",
+	}
+}
+
+// LifecycleMatrix returns the configured end-of-life matrix, or the one shipped with the controller
+func (c *ControllerConfig) LifecycleMatrix() *eol.Matrix {
+	if c.EOLMatrix != nil {
+		return c.EOLMatrix
+	}
+	return eol.Default()
+}
+
+// Load reads the configuration file and fills unset values with defaults
+func Load(path string) (*ControllerConfig, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read config file %s: %w", path, err)
+	}
+	return Parse(data)
+}
+
+// Parse decodes the configuration and fills unset values with defaults
+func Parse(data []byte) (*ControllerConfig, error) {
+	cfg := Default()
+	if err := yaml.UnmarshalStrict(data, cfg); err != nil {
+		return nil, fmt.Errorf("failed to parse controller config: %w", err)
+	}
+	if cfg.APIVersion != APIVersion || cfg.Kind != Kind {
+		return nil, fmt.Errorf("unsupported controller config %s %s, expected %s %s",
+			cfg.APIVersion, cfg.Kind, APIVersion, Kind)
+	}
+	if cfg.RequeueInterval.Duration <= 0 {
+		return nil, fmt.Errorf("requeueInterval must be positive, got %s", cfg.RequeueInterval.Duration)
+	}
+	if cfg.EOLMatrix != nil {
+		if err := cfg.EOLMatrix.Validate(); err != nil {
+			return nil, err
+		}
+	}
+	for component := range cfg.FIPSImages {
+		if !slices.Contains(FIPSComponents, component) {
+			return nil, fmt.Errorf("unknown fipsImages component %q, expected one of %v", component, FIPSComponents)
+		}
+	}
+	return cfg, nil
+}
+
+// Store holds the current configuration and allows it to be swapped at runtime
+type Store struct {
+	current atomic.Pointer[ControllerConfig]
+}
+
+// NewStore returns a Store holding the given configuration
+func NewStore(cfg *ControllerConfig) *Store {
+	s := &Store{}
+	s.Set(cfg)
+	return s
+}
+
+// Get returns the current configuration. A nil Store returns the defaults.
+func (s *Store) Get() *ControllerConfig {
+	if s == nil {
+		return Default()
+	}
+	if cfg := s.current.Load(); cfg != nil {
+		return cfg
+	}
+	return Default()
+}
+
+// Set replaces the current configuration
+func (s *Store) Set(cfg *ControllerConfig) {
+	s.current.Store(cfg)
+}
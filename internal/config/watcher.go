@@ -0,0 +1,86 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package config
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"time"
+
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	"github.com/kyma-project/gpu-operator/internal/logging"
+)
+
+// Watcher reloads the configuration file into a Store whenever its content changes.
+// It polls instead of relying on inotify because ConfigMap volumes are updated through
+// symlink swaps, which are easy to miss with file watches.
+type Watcher struct {
+	Path     string
+	Store    *Store
+	Interval time.Duration
+	// Override is applied to every reloaded configuration, e.g. to keep settings given on the
+	// command line in effect
+	Override func(*ControllerConfig)
+
+	last []byte
+}
+
+// Start implements manager.Runnable
+func (w *Watcher) Start(ctx context.Context) error {
+	logger := ctrl.Log.WithName(logging.SubsystemConfig)
+	interval := w.Interval
+	if interval <= 0 {
+		interval = 10 * time.Second
+	}
+	w.last, _ = os.ReadFile(w.Path)
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			data, err := os.ReadFile(w.Path)
+			if err != nil {
+				logger.Error(err, "Failed to read controller config, keeping current settings", "path", w.Path)
+				continue
+			}
+			if bytes.Equal(data, w.last) {
+				continue
+			}
+			cfg, err := Parse(data)
+			if err != nil {
+				logger.Error(err, "Invalid controller config, keeping current settings", "path", w.Path)
+				continue
+			}
+			w.last = data
+			if w.Override != nil {
+				w.Override(cfg)
+			}
+			w.Store.Set(cfg)
+			logger.Info("Reloaded controller config", "path", w.Path)
+		}
+	}
+}
+
+// NeedLeaderElection implements manager.LeaderElectionRunnable; every replica keeps its config current
+func (w *Watcher) NeedLeaderElection() bool {
+	return false
+}
@@ -0,0 +1,469 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	operatorv1alpha1 "github.com/kyma-project/gpu-operator/api/v1alpha1"
+	"github.com/kyma-project/gpu-operator/internal/instances"
+	"github.com/kyma-project/gpu-operator/internal/logging"
+)
+
+const (
+	// amdHelmRepo is the Helm repository of the AMD GPU operator
+	// Reference: https://instinct.docs.amd.com/projects/gpu-operator
+	amdHelmRepo    = "https://rocm.github.io/gpu-operator"
+	amdChartName   = "gpu-operator-charts"
+	amdReleaseName = "amd-gpu-operator"
+	// amdDeviceConfigKind is the custom resource the AMD GPU operator deploys its operands from
+	amdDeviceConfigKind = "DeviceConfig"
+
+	amdPCIVendor = "1002"
+	// amdPCILabel is published by Node Feature Discovery on nodes with an AMD PCI device
+	amdPCILabel = "feature.node.kubernetes.io/pci-" + amdPCIVendor + ".present"
+
+	amdGPUResourceName corev1.ResourceName = "amd.com/gpu"
+
+	// Labels of the AMD GPU node labeller describing the GPUs of a node
+	amdProductLabel       = "amd.com/gpu.product-name"
+	amdFamilyLabel        = "amd.com/gpu.family"
+	amdVRAMLabel          = "amd.com/gpu.vram"
+	amdDriverVersionLabel = "amd.com/gpu.driver-version"
+
+	// certManagerCRD must exist before the AMD GPU operator chart is installed, its webhooks use cert-manager
+	// certificates
+	certManagerCRD = "certificates.cert-manager.io"
+)
+
+// isAMD reports whether the CR installs the AMD GPU operator
+func isAMD(gpuOperator *operatorv1alpha1.GpuOperator) bool {
+	return instances.Vendor(gpuOperator) == operatorv1alpha1.GPUVendorAMD
+}
+
+// amdDriverVersion returns spec.amd.driverVersion, empty to use the driver of the node image
+func amdDriverVersion(gpuOperator *operatorv1alpha1.GpuOperator) string {
+	if gpuOperator.Spec.AMD == nil {
+		return ""
+	}
+	return gpuOperator.Spec.AMD.DriverVersion
+}
+
+// reconcileAMD installs the AMD GPU operator with the Helm SDK and reports the AMD GPU nodes. The
+// NVIDIA specific steps, such as the ClusterPolicy, the operator validator and the smoke tests, do not apply.
+// The installation is ready once a GPU node advertises allocatable amd.com/gpu, unless spec.skipCapacityCheck is set.
+func (r *GpuOperatorReconciler) reconcileAMD(ctx context.Context, req ctrl.Request, gpuOperator *operatorv1alpha1.GpuOperator,
+	namespace string, baseLogger logr.Logger) (ctrl.Result, error) {
+	logger := log.FromContext(ctx)
+
+	installed := installedForGeneration(gpuOperator)
+	previousState := gpuOperator.Status.State
+	if startsProcessing(gpuOperator) {
+		gpuOperator.Status.State = operatorv1alpha1.StateProcessing
+		gpuOperator.Status.ObservedGeneration = gpuOperator.Generation
+		gpuOperator.Status.ModuleVersion = r.ModuleVersion
+		startOperation(gpuOperator, nextOperation(gpuOperator),
+			fmt.Sprintf("Reconciling generation %d into namespace %s", gpuOperator.Generation, namespace))
+		recordOperationStart(req.String())
+		if err := r.updateStatus(ctx, gpuOperator); err != nil {
+			logger.Error(err, "Failed to update GpuOperator status to Processing")
+			return ctrl.Result{}, err
+		}
+	}
+
+	// Fail early without cert-manager rather than in the middle of the Helm install
+	if err := r.checkCertManager(ctx); err != nil {
+		logger.Error(err, "cert-manager missing")
+		return r.updateStatusError(ctx, gpuOperator, err)
+	}
+
+	if err := r.ensureNamespace(ctx, gpuOperator, namespace); err != nil {
+		logger.Error(err, "Failed to ensure namespace")
+		return r.updateStatusError(ctx, gpuOperator, err)
+	}
+	if err := r.ensureNamespaceDefaults(ctx, gpuOperator, namespace); err != nil {
+		logger.Error(err, "Failed to ensure namespace defaults")
+		return r.updateStatusError(ctx, gpuOperator, err)
+	}
+	if err := r.removeInstallerLeftovers(ctx, gpuOperator, namespace); err != nil {
+		logger.Error(err, "Failed to remove installer leftovers")
+		return r.updateStatusError(ctx, gpuOperator, err)
+	}
+
+	logger = baseLogger.WithValues(logging.KeyPhase, logging.PhaseInstall)
+	ctx = log.IntoContext(ctx, logger)
+	r.recordPhase(req.String(), logging.PhaseInstall)
+
+	// Refuse to downgrade the chart or the driver unless spec.allowDowngrade is set
+	if err := checkDowngrade(gpuOperator); err != nil {
+		logger.Error(err, "Downgrade blocked")
+		return r.updateStatusError(ctx, gpuOperator, err)
+	}
+
+	// Wait out the backoff after a failed installation attempt
+	if wait := installRetryWait(gpuOperator); wait > 0 {
+		logger.Info("Waiting before retrying the failed installation",
+			"attempts", gpuOperator.Status.InstallRetry.Attempts, "retryIn", wait)
+		return ctrl.Result{RequeueAfter: wait}, nil
+	}
+
+	revision, hash, err := r.installAMD(ctx, gpuOperator, namespace)
+	r.recordHelmAction(req.String(), "install", revision, err)
+	var installFailed *installFailedError
+	if errors.As(err, &installFailed) {
+		logger.Error(err, "Helm installation failed")
+		return r.retryInstall(ctx, gpuOperator, err)
+	}
+	if err != nil {
+		logger.Error(err, "Failed to install AMD GPU operator")
+		return r.updateStatusError(ctx, gpuOperator, err)
+	}
+	newlyInstalled := !installReported(gpuOperator) || gpuOperator.Status.InstallHash != hash
+	gpuOperator.Status.InstallHash = hash
+	gpuOperator.Status.InstallRetry = nil
+
+	logger = baseLogger.WithValues(logging.KeyPhase, logging.PhaseReady, logging.KeyHelmRevision, revision)
+	ctx = log.IntoContext(ctx, logger)
+	installedMessage := "AMD GPU Operator installed in-process with the Helm SDK"
+	if newlyInstalled {
+		r.event(gpuOperator, corev1.EventTypeNormal, eventInstallSucceeded, "%s", installedMessage)
+	}
+
+	// Report the chart version the installation actually deployed
+	deployed, _, err := r.helmClient.Latest(r.restConfig, namespace, amdReleaseName)
+	if err != nil {
+		logger.Error(err, "Failed to read the installed AMD GPU operator release")
+		return r.updateStatusError(ctx, gpuOperator, fmt.Errorf("failed to read the installed AMD GPU operator release: %w", err))
+	}
+	gpuOperator.Status.InstalledChartVersion = ""
+	if deployed != nil {
+		gpuOperator.Status.InstalledChartVersion = deployed.ChartVersion
+		installedMessage += fmt.Sprintf(" (chart %s)", deployed.ChartVersion)
+	}
+	gpuOperator.Status.InstalledVersion = amdDriverVersion(gpuOperator)
+
+	// Summarize the AMD GPU fleet
+	if gpuOperator.Status.GPUNodes, gpuOperator.Status.GPUInventory, err = r.amdGPUInventory(ctx); err != nil {
+		logger.Error(err, "Failed to collect AMD GPU node inventory")
+		return r.updateStatusError(ctx, gpuOperator, err)
+	}
+
+	readyCondition := metav1.Condition{
+		Type:               conditionTypeReady,
+		Status:             metav1.ConditionTrue,
+		Reason:             "GpuOperatorReady",
+		Message:            "AMD GPU Operator installed successfully",
+		ObservedGeneration: gpuOperator.Generation,
+	}
+	gpuOperator.Status.State = operatorv1alpha1.StateReady
+	if !gpuOperator.Spec.SkipCapacityCheck && gpuOperator.Status.GPUNodes.AllocatableGPUs == 0 {
+		readyCondition.Status = metav1.ConditionFalse
+		readyCondition.Reason = "NoAllocatableGPUs"
+		readyCondition.Message = fmt.Sprintf("No GPU node advertises allocatable %s yet", amdGPUResourceName)
+		gpuOperator.Status.State = operatorv1alpha1.StateProcessing
+		if installed {
+			gpuOperator.Status.State = operatorv1alpha1.StateWarning
+		}
+		logger.Info("Readiness checks not met, will requeue", "reason", readyCondition.Reason)
+	}
+	gpuOperator.Status.ObservedGeneration = gpuOperator.Generation
+	gpuOperator.Status.ModuleVersion = r.ModuleVersion
+	setConditions(gpuOperator, readyCondition, metav1.Condition{
+		Type:               conditionTypeInstalled,
+		Status:             metav1.ConditionTrue,
+		Reason:             "HelmInstallComplete",
+		Message:            installedMessage,
+		ObservedGeneration: gpuOperator.Generation,
+	})
+	ready := readyCondition.Status == metav1.ConditionTrue
+	if ready || installed {
+		setLastOperation(gpuOperator, operatorv1alpha1.OperationSucceeded, installedMessage)
+		recordOperationEnd(req.String(), gpuOperator.Status.LastOperation.Operation, operatorv1alpha1.OperationSucceeded)
+	} else {
+		setLastOperation(gpuOperator, operatorv1alpha1.OperationProcessing, readyCondition.Message)
+	}
+
+	if err := r.updateStatus(ctx, gpuOperator); err != nil {
+		logger.Error(err, "Failed to update GpuOperator status", "state", gpuOperator.Status.State)
+		return ctrl.Result{}, err
+	}
+	r.failures.Reset(req.String())
+	r.recordStateEvent(gpuOperator, previousState, readyCondition.Message)
+
+	logger.Info("Successfully reconciled GpuOperator")
+	// The Node Feature Discovery subchart follows the NVIDIA GpuOperators of the cluster, which are not watched
+	return ctrl.Result{RequeueAfter: r.Config.Get().RequeueInterval.Duration}, nil
+}
+
+// installAMD installs or upgrades the AMD GPU operator release with the Helm SDK, unless it is deployed with
+// the install hash in status.installHash. It returns the deployed revision and the install hash of the release.
+func (r *GpuOperatorReconciler) installAMD(ctx context.Context, gpuOperator *operatorv1alpha1.GpuOperator, namespace string) (int, string, error) {
+	sharedNFD, err := r.sharesClusterWithNVIDIA(ctx, gpuOperator)
+	if err != nil {
+		return 0, "", err
+	}
+	values := map[string]interface{}{}
+	if err := setChartValues(values, amdChartValues(gpuOperator, sharedNFD)); err != nil {
+		return 0, "", err
+	}
+	chart, err := r.helmChart(ctx, gpuOperator, amdChartName, chartVersion(gpuOperator))
+	if err != nil {
+		return 0, "", err
+	}
+	release := releaseInstall{name: amdReleaseName, chart: chart, values: values}
+	hash, err := installHash(release)
+	if err != nil {
+		return 0, "", err
+	}
+	revision, err := r.upToDateRevision(gpuOperator, namespace, hash, release)
+	if err != nil {
+		return 0, "", err
+	}
+	if revision == 0 {
+		if revision, err = r.upgradeReleases(ctx, namespace, false, release); err != nil {
+			return 0, "", err
+		}
+	}
+	return revision, hash, nil
+}
+
+// amdChartValues returns the Helm values of the AMD GPU operator chart derived from the spec. The default
+// DeviceConfig selects the nodes with an AMD PCI device. With a driver version the Kernel Module Management
+// operator builds and loads the driver, otherwise the driver of the node image is used. Node Feature Discovery
+// is left to the NVIDIA GPU operator if an NVIDIA GpuOperator manages the same cluster, as both charts deploy it.
+func amdChartValues(gpuOperator *operatorv1alpha1.GpuOperator, sharedNFD bool) []chartValue {
+	amd := gpuOperator.Spec.AMD
+	if amd == nil {
+		amd = &operatorv1alpha1.AMDSpec{}
+	}
+	values := []chartValue{
+		{path: "crds.defaultCR.install", value: true},
+		{path: "deviceConfig.spec.selector", value: map[string]string{amdPCILabel: "true"}},
+		{path: "deviceConfig.spec.devicePlugin.enableNodeLabeller", value: true},
+		{path: "deviceConfig.spec.metricsExporter.enable", value: amd.MetricsExporter},
+		{path: "deviceConfig.spec.driver.enable", value: amd.DriverVersion != ""},
+		{path: "kmm.enabled", value: amd.DriverVersion != ""},
+	}
+	if amd.DriverVersion != "" {
+		values = append(values, chartValue{path: "deviceConfig.spec.driver.version", value: amd.DriverVersion})
+	}
+	if sharedNFD {
+		values = append(values, chartValue{path: "node-feature-discovery.enabled", value: false})
+	}
+	return values
+}
+
+// sharesClusterWithNVIDIA reports whether an NVIDIA GpuOperator that is not being deleted manages the cluster
+// the AMD GpuOperator installs into
+func (r *GpuOperatorReconciler) sharesClusterWithNVIDIA(ctx context.Context, gpuOperator *operatorv1alpha1.GpuOperator) (bool, error) {
+	gpuOperators := &operatorv1alpha1.GpuOperatorList{}
+	if err := r.gpuOperatorReader().List(ctx, gpuOperators); err != nil {
+		return false, fmt.Errorf("failed to list GpuOperators: %w", err)
+	}
+	for i := range gpuOperators.Items {
+		other := &gpuOperators.Items[i]
+		if !isAMD(other) && other.DeletionTimestamp == nil && instances.TargetCluster(other) == instances.TargetCluster(gpuOperator) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// checkCertManager returns an error if the cert-manager CRDs the AMD GPU operator depends on are missing
+func (r *GpuOperatorReconciler) checkCertManager(ctx context.Context) error {
+	crd := &unstructured.Unstructured{}
+	crd.SetGroupVersionKind(crdGVK)
+	if err := r.Get(ctx, client.ObjectKey{Name: certManagerCRD}, crd); err != nil {
+		if apierrors.IsNotFound(err) {
+			return fmt.Errorf("the AMD GPU operator requires cert-manager, CRD %s not found", certManagerCRD)
+		}
+		return fmt.Errorf("failed to get CRD %s: %w", certManagerCRD, err)
+	}
+	return nil
+}
+
+// amdGPUNodes lists the nodes with an AMD GPU according to NFD
+func (r *GpuOperatorReconciler) amdGPUNodes(ctx context.Context) ([]corev1.Node, error) {
+	nodes := &corev1.NodeList{}
+	if err := r.List(ctx, nodes, client.MatchingLabels{amdPCILabel: "true"}); err != nil {
+		return nil, fmt.Errorf("failed to list AMD GPU nodes: %w", err)
+	}
+	return nodes.Items, nil
+}
+
+// amdGPUInventory summarizes the AMD GPU nodes, and groups them by the GPU model the node labeller labeled
+// them with. A node's driver works once the device plugin advertises its GPUs.
+func (r *GpuOperatorReconciler) amdGPUInventory(ctx context.Context) (*operatorv1alpha1.GPUNodeInventory,
+	[]operatorv1alpha1.GPUModelInventory, error) {
+	nodes, err := r.amdGPUNodes(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	inventory := &operatorv1alpha1.GPUNodeInventory{Nodes: make([]operatorv1alpha1.GPUNode, 0, len(nodes))}
+	models := map[string]*operatorv1alpha1.GPUModelInventory{}
+	for i := range nodes {
+		node := &nodes[i]
+		capacity := amdGPUQuantity(node.Status.Capacity)
+		gpuNode := operatorv1alpha1.GPUNode{
+			Name:            node.Name,
+			AllocatableGPUs: amdGPUQuantity(node.Status.Allocatable),
+			DriverReady:     capacity > 0,
+			DriverVersion:   node.Labels[amdDriverVersionLabel],
+			KernelVersion:   node.Labels[kernelVersionLabel],
+		}
+		if gpuNode.KernelVersion == "" {
+			gpuNode.KernelVersion = node.Status.NodeInfo.KernelVersion
+		}
+		inventory.Count++
+		inventory.AllocatableGPUs += gpuNode.AllocatableGPUs
+		if gpuNode.DriverReady {
+			inventory.DriverReadyNodes++
+		}
+		inventory.Nodes = append(inventory.Nodes, gpuNode)
+
+		product := node.Labels[amdProductLabel]
+		if product == "" {
+			continue
+		}
+		model, ok := models[product]
+		if !ok {
+			model = &operatorv1alpha1.GPUModelInventory{Product: product}
+			models[product] = model
+		}
+		if model.Family == "" {
+			model.Family = node.Labels[amdFamilyLabel]
+		}
+		if memory := amdVRAMMiB(node.Labels[amdVRAMLabel]); memory > 0 && model.MemoryMiB == 0 {
+			model.MemoryMiB = memory
+		}
+		model.Nodes++
+		model.GPUs += capacity
+		model.AllocatableGPUs += gpuNode.AllocatableGPUs
+		if pool := node.Labels[gardenerPoolLabel]; pool != "" && !slices.Contains(model.Pools, pool) {
+			model.Pools = append(model.Pools, pool)
+		}
+	}
+	sort.Slice(inventory.Nodes, func(i, j int) bool { return inventory.Nodes[i].Name < inventory.Nodes[j].Name })
+
+	byModel := make([]operatorv1alpha1.GPUModelInventory, 0, len(models))
+	for _, model := range models {
+		sort.Strings(model.Pools)
+		byModel = append(byModel, *model)
+	}
+	sort.Slice(byModel, func(i, j int) bool { return byModel[i].Product < byModel[j].Product })
+	return inventory, byModel, nil
+}
+
+// amdGPUQuantity returns the amd.com/gpu of a node's capacity or allocatable resources
+func amdGPUQuantity(resources corev1.ResourceList) int64 {
+	quantity, ok := resources[amdGPUResourceName]
+	if !ok {
+		return 0
+	}
+	return quantity.Value()
+}
+
+// amdVRAMMiB converts the memory the node labeller reports per GPU, e.g. 192G, to MiB, 0 if it is not set
+func amdVRAMMiB(vram string) int64 {
+	factor := int64(1)
+	value, found := strings.CutSuffix(vram, "G")
+	if found {
+		factor = 1024
+	} else {
+		value = strings.TrimSuffix(vram, "M")
+	}
+	n, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		return 0
+	}
+	return n * factor
+}
+
+// observeAMDStatus collects the state of the AMD GPU stack into the status. Failures are logged and leave the
+// previous status in place.
+func (r *GpuOperatorReconciler) observeAMDStatus(ctx context.Context, gpuOperator *operatorv1alpha1.GpuOperator, namespace string) {
+	logger := log.FromContext(ctx)
+	if deployed, _, err := r.helmClient.Latest(r.restConfig, namespace, amdReleaseName); err != nil {
+		logger.Error(err, "Failed to read the installed AMD GPU operator release")
+	} else if deployed != nil {
+		gpuOperator.Status.InstalledChartVersion = deployed.ChartVersion
+	}
+	if nodes, models, err := r.amdGPUInventory(ctx); err != nil {
+		logger.Error(err, "Failed to collect AMD GPU node inventory")
+	} else {
+		gpuOperator.Status.GPUNodes = nodes
+		gpuOperator.Status.GPUInventory = models
+	}
+}
+
+// finishAMDFinalization removes the leftovers of the installer Jobs and the installation namespace
+func (r *GpuOperatorReconciler) finishAMDFinalization(ctx context.Context, gpuOperator *operatorv1alpha1.GpuOperator, namespace string) {
+	logger := log.FromContext(ctx)
+	if err := r.removeInstallerLeftovers(ctx, gpuOperator, namespace); err != nil {
+		logger.Error(err, "Failed to remove installer leftovers, continuing with cleanup")
+	}
+	if err := r.cleanupInstallationNamespace(ctx, gpuOperator, namespace); err != nil {
+		logger.Error(err, "Failed to clean up installation namespace, continuing with cleanup")
+	}
+	logger.Info("Successfully finalized GpuOperator")
+}
+
+// amdGPUNodeChangedPredicate passes the AMD GPU nodes that join or leave the cluster, and the updates that
+// change their allocatable GPUs or their node labeller labels
+var amdGPUNodeChangedPredicate = predicate.Funcs{
+	CreateFunc: func(e event.CreateEvent) bool { return e.Object.GetLabels()[amdPCILabel] == "true" },
+	UpdateFunc: func(e event.UpdateEvent) bool {
+		oldNode, ok := e.ObjectOld.(*corev1.Node)
+		if !ok {
+			return false
+		}
+		newNode, ok := e.ObjectNew.(*corev1.Node)
+		if !ok || newNode.Labels[amdPCILabel] != "true" {
+			return false
+		}
+		if oldNode.Labels[amdPCILabel] != "true" ||
+			amdGPUQuantity(oldNode.Status.Allocatable) != amdGPUQuantity(newNode.Status.Allocatable) {
+			return true
+		}
+		for _, label := range []string{amdProductLabel, amdDriverVersionLabel} {
+			if oldNode.Labels[label] != newNode.Labels[label] {
+				return true
+			}
+		}
+		return false
+	},
+	DeleteFunc:  func(e event.DeleteEvent) bool { return e.Object.GetLabels()[amdPCILabel] == "true" },
+	GenericFunc: func(event.GenericEvent) bool { return false },
+}
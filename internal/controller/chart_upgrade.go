@@ -0,0 +1,225 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Masterminds/semver/v3"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	operatorv1alpha1 "github.com/kyma-project/gpu-operator/api/v1alpha1"
+	"github.com/kyma-project/gpu-operator/internal/logging"
+)
+
+const (
+	// defaultChartVersionCheckInterval is how often the Helm repository is queried for new chart versions
+	defaultChartVersionCheckInterval = 6 * time.Hour
+
+	eventChartUpgradeAvailable = "ChartUpgradeAvailable"
+	eventChartUpgradeStarted   = "ChartUpgradeStarted"
+)
+
+// upgradeChannel returns spec.upgradePolicy.channel, None without an upgrade policy
+func upgradeChannel(gpuOperator *operatorv1alpha1.GpuOperator) operatorv1alpha1.UpgradeChannel {
+	if gpuOperator.Spec.UpgradePolicy == nil || gpuOperator.Spec.UpgradePolicy.Channel == "" {
+		return operatorv1alpha1.UpgradeChannelNone
+	}
+	return gpuOperator.Spec.UpgradePolicy.Channel
+}
+
+// chartVersion returns the chart version to install: the version the upgrade channel selected if it is newer
+// than spec.chartVersion, spec.chartVersion otherwise. Empty installs the latest version.
+func chartVersion(gpuOperator *operatorv1alpha1.GpuOperator) string {
+	pinned, upgraded := gpuOperator.Spec.ChartVersion, gpuOperator.Status.UpgradedChartVersion
+	if gpuOperator.Spec.UpgradePolicy == nil || upgraded == "" {
+		return pinned
+	}
+	if pinned == "" || chartVersionNewer(upgraded, pinned) {
+		return upgraded
+	}
+	return pinned
+}
+
+// reconcileChartUpgrade looks up the chart versions in the Helm repository once per check interval and
+// reports the newest one within the scope of the upgrade channel. Unless the channel is None, a newer
+// version is selected for installation once the maintenance window is open. The selection is recorded
+// before the installation starts, so an interrupted upgrade resumes with the same version.
+func (r *GpuOperatorReconciler) reconcileChartUpgrade(ctx context.Context, gpuOperator *operatorv1alpha1.GpuOperator) error {
+	policy := gpuOperator.Spec.UpgradePolicy
+	if policy == nil {
+		gpuOperator.Status.AvailableChartVersion = ""
+		gpuOperator.Status.UpgradedChartVersion = ""
+		gpuOperator.Status.LastChartVersionCheckTime = nil
+		return nil
+	}
+	logger := log.FromContext(ctx).WithName(logging.SubsystemHelm)
+	channel := upgradeChannel(gpuOperator)
+	current := currentChartVersion(gpuOperator)
+
+	now := time.Now()
+	changed := false
+	if last := gpuOperator.Status.LastChartVersionCheckTime; last == nil || now.Sub(last.Time) >= chartVersionCheckInterval(policy) {
+		chart, err := r.helmChart(ctx, gpuOperator, helmReleaseName, "")
+		var versions []string
+		if err == nil {
+			versions, err = r.helmClient.ChartVersions(ctx, chart)
+		}
+		if err != nil {
+			// The installation does not depend on the lookup, retry on the next reconcile
+			logger.Error(err, "Failed to look up new chart versions", "repository", helmRepoURL(gpuOperator))
+			return nil
+		}
+		available := newestChartVersion(versions, channel, current)
+		if available != gpuOperator.Status.AvailableChartVersion && chartVersionNewer(available, current) {
+			logger.Info("New GPU operator chart version available", "available", available, "installed", current)
+			r.event(gpuOperator, corev1.EventTypeNormal, eventChartUpgradeAvailable,
+				"GPU operator chart %s is available, %s is installed", available, current)
+		}
+		gpuOperator.Status.AvailableChartVersion = available
+		gpuOperator.Status.LastChartVersionCheckTime = &metav1.Time{Time: now}
+		changed = true
+	}
+
+	if available := chartUpgradeCandidate(gpuOperator, current); available != "" &&
+		inMaintenanceWindow(policy.MaintenanceWindow, now) && chartUpgradeAllowed(gpuOperator, available) {
+		gpuOperator.Status.UpgradedChartVersion = available
+		logger.Info("Upgrading GPU operator chart", "from", current, "to", available, "channel", channel)
+		r.event(gpuOperator, corev1.EventTypeNormal, eventChartUpgradeStarted,
+			"Upgrading the GPU operator chart from %s to %s within the %s channel", current, available, channel)
+		changed = true
+	}
+
+	if !changed {
+		return nil
+	}
+	if err := r.updateStatus(ctx, gpuOperator); err != nil {
+		return fmt.Errorf("failed to record chart versions: %w", err)
+	}
+	return nil
+}
+
+// currentChartVersion returns the chart version to install, or the installed version if the latest version is installed
+func currentChartVersion(gpuOperator *operatorv1alpha1.GpuOperator) string {
+	if version := chartVersion(gpuOperator); version != "" {
+		return version
+	}
+	return gpuOperator.Status.InstalledChartVersion
+}
+
+// chartUpgradeCandidate returns the available chart version the upgrade channel upgrades the current version to,
+// empty if there is none
+func chartUpgradeCandidate(gpuOperator *operatorv1alpha1.GpuOperator, current string) string {
+	available := gpuOperator.Status.AvailableChartVersion
+	channel := upgradeChannel(gpuOperator)
+	if channel == operatorv1alpha1.UpgradeChannelNone || !chartVersionNewer(available, current) ||
+		!chartUpgradeInScope(channel, current, available) {
+		return ""
+	}
+	return available
+}
+
+// chartVersionCheckInterval returns spec.upgradePolicy.checkInterval, or its default
+func chartVersionCheckInterval(policy *operatorv1alpha1.UpgradePolicySpec) time.Duration {
+	if policy.CheckInterval != nil && policy.CheckInterval.Duration > 0 {
+		return policy.CheckInterval.Duration
+	}
+	return defaultChartVersionCheckInterval
+}
+
+// newestChartVersion returns the newest released chart version within the scope of the channel from the
+// installed version, or the newest released version for the None channel or without an installed version
+func newestChartVersion(versions []string, channel operatorv1alpha1.UpgradeChannel, installed string) string {
+	scoped := channel != operatorv1alpha1.UpgradeChannelNone && installed != ""
+	var newest *semver.Version
+	newestVersion := ""
+	for _, v := range versions {
+		version, err := semver.NewVersion(v)
+		if err != nil || version.Prerelease() != "" {
+			continue
+		}
+		if scoped && !chartUpgradeInScope(channel, installed, v) {
+			continue
+		}
+		if newest == nil || version.GreaterThan(newest) {
+			newest, newestVersion = version, v
+		}
+	}
+	return newestVersion
+}
+
+// chartUpgradeInScope reports whether the channel allows an upgrade between two chart versions
+func chartUpgradeInScope(channel operatorv1alpha1.UpgradeChannel, from, to string) bool {
+	fromVersion, err := semver.NewVersion(from)
+	if err != nil {
+		return false
+	}
+	toVersion, err := semver.NewVersion(to)
+	if err != nil {
+		return false
+	}
+	switch channel {
+	case operatorv1alpha1.UpgradeChannelPatch:
+		return toVersion.Major() == fromVersion.Major() && toVersion.Minor() == fromVersion.Minor()
+	case operatorv1alpha1.UpgradeChannelMinor:
+		return toVersion.Major() == fromVersion.Major()
+	case operatorv1alpha1.UpgradeChannelLatest:
+		return true
+	}
+	return false
+}
+
+// chartVersionNewer reports whether a chart version is newer than another; versions that are not semantic
+// versions are never newer
+func chartVersionNewer(version, than string) bool {
+	v, err := semver.NewVersion(version)
+	if err != nil {
+		return false
+	}
+	t, err := semver.NewVersion(than)
+	if err != nil {
+		return false
+	}
+	return v.GreaterThan(t)
+}
+
+// inMaintenanceWindow reports whether the time is within the daily maintenance window, always without one
+func inMaintenanceWindow(window *operatorv1alpha1.MaintenanceWindow, now time.Time) bool {
+	if window == nil {
+		return true
+	}
+	begin, err := time.Parse("15:04", window.Begin)
+	if err != nil {
+		return false
+	}
+	end, err := time.Parse("15:04", window.End)
+	if err != nil {
+		return false
+	}
+	now = now.UTC()
+	minute := now.Hour()*60 + now.Minute()
+	beginMinute, endMinute := begin.Hour()*60+begin.Minute(), end.Hour()*60+end.Minute()
+	if beginMinute < endMinute {
+		return minute >= beginMinute && minute < endMinute
+	}
+	// The window spans midnight
+	return minute >= beginMinute || minute < endMinute
+}
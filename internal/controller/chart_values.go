@@ -0,0 +1,104 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"helm.sh/helm/v3/pkg/strvals"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	operatorv1alpha1 "github.com/kyma-project/gpu-operator/api/v1alpha1"
+)
+
+// chartValue is a Helm value of the GPU operator chart derived from the spec. The operand sections
+// of the chart values mirror the ClusterPolicy spec, so the Manifest install engine sets the same
+// path on the rendered ClusterPolicy.
+type chartValue struct {
+	// path is the dotted path of the value, e.g. dcgm.hostPort
+	path  string
+	value interface{}
+	// chartOnly values have no ClusterPolicy counterpart
+	chartOnly bool
+}
+
+// chartValues returns the Helm values derived from the spec, in the order they are applied
+func (r *GpuOperatorReconciler) chartValues(gpuOperator *operatorv1alpha1.GpuOperator) []chartValue {
+	var values []chartValue
+	values = append(values, spotValues(gpuOperator)...)
+	values = append(values, operandTolerationValues(gpuOperator)...)
+	values = append(values, dcgmValues(gpuOperator)...)
+	values = append(values, migValues(gpuOperator)...)
+	values = append(values, timeSlicingValues(gpuOperator)...)
+	values = append(values, vmPassthroughValues(gpuOperator)...)
+	values = append(values, driverImageValues(gpuOperator)...)
+	values = append(values, driverUpgradeValues(gpuOperator)...)
+	values = append(values, componentVersionValues(gpuOperator)...)
+	values = append(values, validatorValues(gpuOperator)...)
+	values = append(values, daemonSetValues(gpuOperator)...)
+	values = append(values, toolkitValues(gpuOperator)...)
+	values = append(values, runtimeClassValues(gpuOperator)...)
+	values = append(values, draValues(gpuOperator)...)
+	values = append(values, ngcValues(gpuOperator)...)
+	values = append(values, vgpuValues(gpuOperator)...)
+	values = append(values, proxyValues(gpuOperator)...)
+	values = append(values, r.fipsValues(gpuOperator)...)
+	// Rewrite the image references last so they cover the images set by the settings above
+	return registryValues(gpuOperator, values)
+}
+
+// setChartValues sets the values on a Helm values tree, with the semantics of helm upgrade --set-json
+func setChartValues(values map[string]interface{}, chartValues []chartValue) error {
+	for _, v := range chartValues {
+		data, err := json.Marshal(v.value)
+		if err != nil {
+			return fmt.Errorf("failed to encode chart value %s: %w", v.path, err)
+		}
+		if err := strvals.ParseJSON(v.path+"="+string(data), values); err != nil {
+			return fmt.Errorf("failed to set chart value %s: %w", v.path, err)
+		}
+	}
+	return nil
+}
+
+// applyChartValues sets the values on a rendered ClusterPolicy, the equivalent of passing them to Helm
+func applyChartValues(values []chartValue, obj *unstructured.Unstructured) error {
+	if obj.GetKind() != clusterPolicyGVK.Kind {
+		return nil
+	}
+	for _, v := range values {
+		if v.chartOnly {
+			continue
+		}
+		// Round-trip through JSON to get the value types unstructured objects support
+		data, err := json.Marshal(v.value)
+		if err != nil {
+			return fmt.Errorf("failed to encode chart value %s: %w", v.path, err)
+		}
+		var value interface{}
+		if err := json.Unmarshal(data, &value); err != nil {
+			return fmt.Errorf("failed to decode chart value %s: %w", v.path, err)
+		}
+		fields := append([]string{"spec"}, strings.Split(v.path, ".")...)
+		if err := unstructured.SetNestedField(obj.Object, value, fields...); err != nil {
+			return fmt.Errorf("failed to set %s on ClusterPolicy: %w", v.path, err)
+		}
+	}
+	return nil
+}
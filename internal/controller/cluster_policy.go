@@ -0,0 +1,92 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	operatorv1alpha1 "github.com/kyma-project/gpu-operator/api/v1alpha1"
+)
+
+// managedOperands are installed disabled by the chart and enabled on the ClusterPolicy by the controller
+// when it manages the ClusterPolicy
+var managedOperands = []string{"driver", "toolkit", "devicePlugin", "dcgmExporter", "gfd", "migManager"}
+
+// controllerManagedClusterPolicy reports whether the controller keeps the ClusterPolicy in sync with the spec
+func controllerManagedClusterPolicy(gpuOperator *operatorv1alpha1.GpuOperator) bool {
+	return gpuOperator.Spec.ClusterPolicyManagement == operatorv1alpha1.ClusterPolicyManagementController
+}
+
+// installValues returns the values passed to the chart. With a controller-managed ClusterPolicy the chart
+// installs the operands disabled and only gets the values that have no ClusterPolicy counterpart, so the
+// operands are only deployed once the controller configured them.
+func (r *GpuOperatorReconciler) installValues(gpuOperator *operatorv1alpha1.GpuOperator) []chartValue {
+	values := r.chartValues(gpuOperator)
+	if !controllerManagedClusterPolicy(gpuOperator) {
+		return values
+	}
+	installValues := make([]chartValue, 0, len(managedOperands)+len(values))
+	for _, operand := range managedOperands {
+		installValues = append(installValues, chartValue{path: operand + ".enabled", value: false})
+	}
+	for _, v := range values {
+		if v.chartOnly {
+			installValues = append(installValues, v)
+		}
+	}
+	return installValues
+}
+
+// reconcileManagedClusterPolicy applies the operand settings of the spec to the ClusterPolicy of the release
+// with server-side apply. The fields set by the controller are restored on every reconcile, the settings
+// from the Garden Linux values rendered by the chart are kept.
+func (r *GpuOperatorReconciler) reconcileManagedClusterPolicy(ctx context.Context, gpuOperator *operatorv1alpha1.GpuOperator) error {
+	if !controllerManagedClusterPolicy(gpuOperator) {
+		return nil
+	}
+	clusterPolicy, err := r.releaseClusterPolicy(ctx)
+	if err != nil {
+		return err
+	}
+	if clusterPolicy == nil {
+		return fmt.Errorf("the GPU operator release has no ClusterPolicy to manage")
+	}
+
+	desired := &unstructured.Unstructured{}
+	desired.SetGroupVersionKind(clusterPolicyGVK)
+	desired.SetName(clusterPolicy.GetName())
+	for _, operand := range managedOperands {
+		if err := unstructured.SetNestedField(desired.Object, true, "spec", operand, "enabled"); err != nil {
+			return fmt.Errorf("failed to enable %s on ClusterPolicy: %w", operand, err)
+		}
+	}
+	if err := applyChartValues(r.chartValues(gpuOperator), desired); err != nil {
+		return err
+	}
+	if err := r.Patch(ctx, desired, client.Apply, client.FieldOwner(manifestFieldOwner), client.ForceOwnership); err != nil {
+		return fmt.Errorf("failed to apply ClusterPolicy %s: %w", desired.GetName(), err)
+	}
+	if desired.GetResourceVersion() != clusterPolicy.GetResourceVersion() {
+		log.FromContext(ctx).Info("Updated ClusterPolicy from spec", "clusterPolicy", desired.GetName())
+	}
+	return nil
+}
@@ -0,0 +1,165 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sync"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+
+	operatorv1alpha1 "github.com/kyma-project/gpu-operator/api/v1alpha1"
+)
+
+const conditionTypeClusterPolicyReady = "ClusterPolicyReady"
+
+// lazyWatch starts a watch on a kind whose CRD is installed by the GPU operator chart, so it may not exist
+// when the controller starts
+type lazyWatch struct {
+	mu      sync.Mutex
+	started bool
+}
+
+// ensureClusterPolicyWatch watches the ClusterPolicies once their CRD exists. The ClusterPolicies of remote
+// clusters are not watched.
+func (r *GpuOperatorReconciler) ensureClusterPolicyWatch(ctx context.Context) error {
+	if r.remote || r.controller == nil {
+		return nil
+	}
+	r.clusterPolicyWatch.mu.Lock()
+	defer r.clusterPolicyWatch.mu.Unlock()
+	if r.clusterPolicyWatch.started {
+		return nil
+	}
+	if _, err := r.RESTMapper().RESTMapping(clusterPolicyGVK.GroupKind(), clusterPolicyGVK.Version); err != nil {
+		if meta.IsNoMatchError(err) {
+			return nil
+		}
+		return fmt.Errorf("failed to look up the ClusterPolicy API: %w", err)
+	}
+	clusterPolicy := &unstructured.Unstructured{}
+	clusterPolicy.SetGroupVersionKind(clusterPolicyGVK)
+	if err := r.controller.Watch(source.Kind[client.Object](r.cache, clusterPolicy,
+		handler.EnqueueRequestsFromMapFunc(r.gpuOperatorsForClusterPolicy), clusterPolicyStatusChangedPredicate)); err != nil {
+		return fmt.Errorf("failed to watch ClusterPolicies: %w", err)
+	}
+	r.clusterPolicyWatch.started = true
+	log.FromContext(ctx).Info("Watching ClusterPolicies")
+	return nil
+}
+
+// gpuOperatorsForClusterPolicy enqueues every GpuOperator CR, so the state of the ClusterPolicy is reflected
+// in the status without waiting for the next requeue
+func (r *GpuOperatorReconciler) gpuOperatorsForClusterPolicy(ctx context.Context, _ client.Object) []reconcile.Request {
+	return r.gpuOperatorRequests(ctx)
+}
+
+// clusterPolicyStatusChangedPredicate passes the ClusterPolicies that are created, deleted or whose status changes
+var clusterPolicyStatusChangedPredicate = predicate.Funcs{
+	CreateFunc: func(event.CreateEvent) bool { return true },
+	UpdateFunc: func(e event.UpdateEvent) bool {
+		oldPolicy, ok := e.ObjectOld.(*unstructured.Unstructured)
+		if !ok {
+			return true
+		}
+		newPolicy, ok := e.ObjectNew.(*unstructured.Unstructured)
+		if !ok {
+			return true
+		}
+		return !equality.Semantic.DeepEqual(oldPolicy.Object["status"], newPolicy.Object["status"])
+	},
+	DeleteFunc:  func(event.DeleteEvent) bool { return true },
+	GenericFunc: func(event.GenericEvent) bool { return false },
+}
+
+// clusterPolicyStatus returns the state and conditions of the ClusterPolicy of the release, nil if none is deployed
+func (r *GpuOperatorReconciler) clusterPolicyStatus(ctx context.Context) (*operatorv1alpha1.ClusterPolicyStatus, error) {
+	clusterPolicy, err := r.releaseClusterPolicy(ctx)
+	if err != nil || clusterPolicy == nil {
+		return nil, err
+	}
+	status := &operatorv1alpha1.ClusterPolicyStatus{Name: clusterPolicy.GetName()}
+	status.State, _, _ = unstructured.NestedString(clusterPolicy.Object, "status", "state")
+	if conditions, found, _ := unstructured.NestedSlice(clusterPolicy.Object, "status", "conditions"); found {
+		var parsed struct {
+			Conditions []metav1.Condition `json:"conditions"`
+		}
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(
+			map[string]interface{}{"conditions": conditions}, &parsed); err != nil {
+			return nil, fmt.Errorf("failed to read conditions of ClusterPolicy %s: %w", clusterPolicy.GetName(), err)
+		}
+		for _, condition := range parsed.Conditions {
+			if condition.Type == "" || condition.Status == "" {
+				continue
+			}
+			// The conditions of older GPU operator versions lack fields the API requires
+			if !conditionReasonPattern.MatchString(condition.Reason) {
+				condition.Reason = "Unknown"
+			}
+			if condition.LastTransitionTime.IsZero() {
+				condition.LastTransitionTime = metav1.Now()
+			}
+			status.Conditions = append(status.Conditions, condition)
+		}
+	}
+	return status, nil
+}
+
+// conditionReasonPattern is the format the API requires for the reason of a condition
+var conditionReasonPattern = regexp.MustCompile(`^[A-Za-z]([A-Za-z0-9_,:]*[A-Za-z0-9_])?$`)
+
+// clusterPolicyCondition summarizes the ClusterPolicy state in the ClusterPolicyReady condition, nil if no
+// ClusterPolicy is deployed. The message of a failing ClusterPolicy is taken from its Error or Ready condition.
+func clusterPolicyCondition(gpuOperator *operatorv1alpha1.GpuOperator) *metav1.Condition {
+	status := gpuOperator.Status.ClusterPolicy
+	if status == nil {
+		return nil
+	}
+	condition := &metav1.Condition{
+		Type:               conditionTypeClusterPolicyReady,
+		Status:             metav1.ConditionTrue,
+		Reason:             "ClusterPolicyReady",
+		Message:            fmt.Sprintf("ClusterPolicy %s is ready", status.Name),
+		ObservedGeneration: gpuOperator.Generation,
+	}
+	if status.State != clusterPolicyReady {
+		condition.Status = metav1.ConditionFalse
+		condition.Reason = "ClusterPolicyNotReady"
+		condition.Message = fmt.Sprintf("ClusterPolicy %s is in state %q", status.Name, status.State)
+		cause := meta.FindStatusCondition(status.Conditions, "Error")
+		if cause == nil || cause.Status != metav1.ConditionTrue {
+			cause = meta.FindStatusCondition(status.Conditions, "Ready")
+		}
+		if cause != nil && cause.Message != "" {
+			condition.Message += ": " + cause.Message
+		}
+	}
+	return condition
+}
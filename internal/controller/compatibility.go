@@ -0,0 +1,223 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/version"
+	"k8s.io/client-go/discovery"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	operatorv1alpha1 "github.com/kyma-project/gpu-operator/api/v1alpha1"
+	"github.com/kyma-project/gpu-operator/internal/eol"
+)
+
+const (
+	// minKubernetesMinor is the oldest Kubernetes 1.x release supported by the GPU operator
+	minKubernetesMinor = 29
+	// maxKubernetesMinor is the newest Kubernetes 1.x release the module was validated with
+	maxKubernetesMinor = 33
+
+	// gardenLinuxOSImage identifies Garden Linux nodes, the only OS precompiled drivers are validated with
+	gardenLinuxOSImage = "Garden Linux"
+)
+
+// compatibilityReport checks the Kubernetes version, the kernels and GPU models of the GPU nodes, the driver
+// branch and the deployed chart version. Versions that cannot be determined are reported as unknown.
+func (r *GpuOperatorReconciler) compatibilityReport(ctx context.Context, driverVersion string) *operatorv1alpha1.CompatibilityReport {
+	logger := log.FromContext(ctx)
+	matrix := r.Config.Get().LifecycleMatrix()
+	now := time.Now()
+
+	nodes, err := r.gpuNodes(ctx)
+	if err != nil {
+		logger.Error(err, "Failed to list GPU nodes, reporting their compatibility as unknown")
+	}
+	driverBranch, _, _ := strings.Cut(driverVersion, ".")
+
+	checks := []operatorv1alpha1.CompatibilityCheck{
+		r.kubernetesCompatibility(),
+		kernelCompatibility(nodes, err),
+		gpuModelCompatibility(nodes, err, driverBranch),
+		lifecycleCompatibility("driverBranch", "Driver branch", driverBranch, matrix.Driver(driverBranch), now),
+	}
+	chartVersion, err := r.deployedChartVersion(ctx)
+	if err != nil {
+		logger.Error(err, "Failed to determine deployed chart version, reporting its compatibility as unknown")
+	}
+	if chartVersion == "" {
+		checks = append(checks, operatorv1alpha1.CompatibilityCheck{Component: "chartVersion",
+			Result: operatorv1alpha1.CompatibilityUnknown, Message: "No GPU operator chart is deployed yet"})
+	} else {
+		checks = append(checks, lifecycleCompatibility("chartVersion", "GPU operator chart", chartVersion, matrix.Chart(chartVersion), now))
+	}
+
+	report := &operatorv1alpha1.CompatibilityReport{
+		Result: operatorv1alpha1.CompatibilitySupported,
+		Checks: checks,
+		Time:   metav1.NewTime(now),
+	}
+	for _, check := range checks {
+		switch {
+		case check.Result == operatorv1alpha1.CompatibilityUnsupported:
+			report.Result = operatorv1alpha1.CompatibilityUnsupported
+		case check.Result == operatorv1alpha1.CompatibilityUnknown && report.Result == operatorv1alpha1.CompatibilitySupported:
+			report.Result = operatorv1alpha1.CompatibilityUnknown
+		}
+	}
+	return report
+}
+
+// kubernetesCompatibility checks the Kubernetes version of the API server
+func (r *GpuOperatorReconciler) kubernetesCompatibility() operatorv1alpha1.CompatibilityCheck {
+	check := operatorv1alpha1.CompatibilityCheck{Component: "kubernetes", Result: operatorv1alpha1.CompatibilityUnknown}
+	discoveryClient, err := discovery.NewDiscoveryClientForConfig(r.restConfig)
+	if err != nil {
+		check.Message = fmt.Sprintf("Failed to determine the Kubernetes version: %v", err)
+		return check
+	}
+	info, err := discoveryClient.ServerVersion()
+	if err != nil {
+		check.Message = fmt.Sprintf("Failed to determine the Kubernetes version: %v", err)
+		return check
+	}
+	check.Version = info.GitVersion
+	serverVersion, err := version.ParseGeneric(info.GitVersion)
+	if err != nil {
+		check.Message = fmt.Sprintf("Failed to parse the Kubernetes version: %v", err)
+		return check
+	}
+	switch minor := int(serverVersion.Minor()); {
+	case serverVersion.Major() != 1 || minor < minKubernetesMinor:
+		check.Result = operatorv1alpha1.CompatibilityUnsupported
+		check.Message = fmt.Sprintf("Kubernetes 1.%d or later is required", minKubernetesMinor)
+	case minor > maxKubernetesMinor:
+		check.Message = fmt.Sprintf("Kubernetes 1.%d is newer than the validated versions 1.%d to 1.%d",
+			minor, minKubernetesMinor, maxKubernetesMinor)
+	default:
+		check.Result = operatorv1alpha1.CompatibilitySupported
+	}
+	return check
+}
+
+// kernelCompatibility checks that the GPU nodes run Garden Linux, which the precompiled drivers are built for
+func kernelCompatibility(nodes []corev1.Node, listErr error) operatorv1alpha1.CompatibilityCheck {
+	check := operatorv1alpha1.CompatibilityCheck{Component: "kernel", Result: operatorv1alpha1.CompatibilityUnknown}
+	switch {
+	case listErr != nil:
+		check.Message = "Failed to list the GPU nodes"
+		return check
+	case len(nodes) == 0:
+		check.Message = "No GPU nodes detected"
+		return check
+	}
+
+	var kernels, otherOS []string
+	for i := range nodes {
+		info := nodes[i].Status.NodeInfo
+		kernels = append(kernels, info.KernelVersion)
+		if !strings.HasPrefix(info.OSImage, gardenLinuxOSImage) {
+			otherOS = append(otherOS, info.OSImage)
+		}
+	}
+	check.Version = strings.Join(uniqueSorted(kernels), ", ")
+	if len(otherOS) > 0 {
+		check.Message = fmt.Sprintf("Precompiled drivers are only validated with Garden Linux, GPU nodes run %s",
+			strings.Join(uniqueSorted(otherOS), ", "))
+		return check
+	}
+	check.Result = operatorv1alpha1.CompatibilitySupported
+	return check
+}
+
+// gpuModelCompatibility checks the GPU models of the GPU nodes against the driver branch
+func gpuModelCompatibility(nodes []corev1.Node, listErr error, driverBranch string) operatorv1alpha1.CompatibilityCheck {
+	check := operatorv1alpha1.CompatibilityCheck{Component: "gpuModels", Result: operatorv1alpha1.CompatibilityUnknown}
+	switch {
+	case listErr != nil:
+		check.Message = "Failed to list the GPU nodes"
+		return check
+	case len(nodes) == 0:
+		check.Message = "No GPU nodes detected"
+		return check
+	}
+
+	families := map[string]string{}
+	for i := range nodes {
+		if model, family := gpuModel(&nodes[i]); model != "" {
+			families[model] = family
+		}
+	}
+	models := make([]string, 0, len(families))
+	for model := range families {
+		models = append(models, model)
+	}
+	sort.Strings(models)
+	check.Version = strings.Join(models, ", ")
+
+	var unsupported, unknown []string
+	for _, model := range models {
+		support, known := driverSupportByFamily[families[model]]
+		switch {
+		case !known:
+			unknown = append(unknown, model)
+		case support.min != "" && compareBranches(driverBranch, support.min) < 0,
+			support.max != "" && compareBranches(driverBranch, support.max) > 0:
+			unsupported = append(unsupported, fmt.Sprintf("%s (%s)", model, families[model]))
+		}
+	}
+	switch {
+	case len(unsupported) > 0:
+		check.Result = operatorv1alpha1.CompatibilityUnsupported
+		check.Message = fmt.Sprintf("Driver branch %s does not support %s", driverBranch, strings.Join(unsupported, ", "))
+	case len(unknown) > 0 || len(models) == 0:
+		check.Message = "Unknown GPU architecture"
+		if len(unknown) > 0 {
+			check.Message += " of " + strings.Join(unknown, ", ")
+		}
+	default:
+		check.Result = operatorv1alpha1.CompatibilitySupported
+	}
+	return check
+}
+
+// lifecycleCompatibility checks a version against the end-of-life matrix. Deprecated versions are still supported.
+func lifecycleCompatibility(component, kind, version string, entry *eol.Entry, now time.Time) operatorv1alpha1.CompatibilityCheck {
+	check := operatorv1alpha1.CompatibilityCheck{Component: component, Version: version, Result: operatorv1alpha1.CompatibilityUnknown}
+	if entry == nil {
+		check.Message = fmt.Sprintf("%s %s is not listed in the lifecycle matrix", kind, version)
+		return check
+	}
+	switch entry.Lifecycle(now) {
+	case eol.EndOfLife:
+		check.Result = operatorv1alpha1.CompatibilityUnsupported
+		check.Message = fmt.Sprintf("%s %s reached end-of-life on %s", kind, version, entry.EndOfLife)
+	case eol.Deprecated:
+		check.Result = operatorv1alpha1.CompatibilitySupported
+		check.Message = fmt.Sprintf("%s %s is deprecated", kind, version)
+	default:
+		check.Result = operatorv1alpha1.CompatibilitySupported
+	}
+	return check
+}
@@ -0,0 +1,44 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	operatorv1alpha1 "github.com/kyma-project/gpu-operator/api/v1alpha1"
+)
+
+// componentVersionValues returns the chart values of spec.componentVersions, the version of each operand section
+func componentVersionValues(gpuOperator *operatorv1alpha1.GpuOperator) []chartValue {
+	versions := gpuOperator.Spec.ComponentVersions
+	if versions == nil {
+		return nil
+	}
+	var values []chartValue
+	for _, component := range []struct {
+		section string
+		version string
+	}{
+		{"driver", versions.Driver},
+		{"toolkit", versions.Toolkit},
+		{"devicePlugin", versions.DevicePlugin},
+		{"dcgmExporter", versions.DCGMExporter},
+	} {
+		if component.version != "" {
+			values = append(values, chartValue{path: component.section + ".version", value: component.version})
+		}
+	}
+	return values
+}
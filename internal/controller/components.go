@@ -0,0 +1,219 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	appsv1 "k8s.io/api/apps/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	operatorv1alpha1 "github.com/kyma-project/gpu-operator/api/v1alpha1"
+)
+
+const (
+	// operatorComponent is the Deployment of the GPU operator, named after the release
+	operatorComponent = helmReleaseName
+	// clusterPolicyComponent is the ClusterPolicy, whose state aggregates the operands
+	clusterPolicyComponent = "cluster-policy"
+	// clusterPolicyReady is the state of a ClusterPolicy whose operands are all ready
+	clusterPolicyReady = "ready"
+)
+
+// componentStatuses reports the rollout of the GPU operator Deployment, the ClusterPolicy and the deployed operand
+// DaemonSets, in the order of operandApps. Components that are not deployed are not reported.
+func (r *GpuOperatorReconciler) componentStatuses(ctx context.Context, namespace string) ([]operatorv1alpha1.ComponentStatus, error) {
+	var components []operatorv1alpha1.ComponentStatus
+
+	deployment := &appsv1.Deployment{}
+	err := r.Get(ctx, types.NamespacedName{Name: operatorComponent, Namespace: namespace}, deployment)
+	switch {
+	case err == nil:
+		components = append(components, deploymentComponent(deployment))
+	case !apierrors.IsNotFound(err):
+		return nil, fmt.Errorf("failed to get GPU operator deployment: %w", err)
+	}
+
+	clusterPolicy, err := r.releaseClusterPolicy(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if clusterPolicy != nil {
+		components = append(components, clusterPolicyComponentStatus(clusterPolicy))
+	}
+
+	daemonSets := &appsv1.DaemonSetList{}
+	if err := r.List(ctx, daemonSets, client.InNamespace(namespace)); err != nil {
+		return nil, fmt.Errorf("failed to list operand daemonsets: %w", err)
+	}
+	byApp := map[string]*appsv1.DaemonSet{}
+	for i := range daemonSets.Items {
+		if app := daemonSets.Items[i].Labels["app"]; app != "" {
+			byApp[app] = &daemonSets.Items[i]
+		}
+	}
+	for _, operand := range operandApps {
+		if daemonSet, deployed := byApp[operand.app]; deployed {
+			components = append(components, daemonSetComponent(operand.name, daemonSet))
+		}
+	}
+	return components, nil
+}
+
+func deploymentComponent(deployment *appsv1.Deployment) operatorv1alpha1.ComponentStatus {
+	status := deployment.Status
+	desired := int32(1)
+	if deployment.Spec.Replicas != nil {
+		desired = *deployment.Spec.Replicas
+	}
+	component := operatorv1alpha1.ComponentStatus{
+		Name:        operatorComponent,
+		Kind:        "Deployment",
+		DesiredPods: desired,
+		ReadyPods:   status.ReadyReplicas,
+		UpdatedPods: status.UpdatedReplicas,
+		Ready: status.ObservedGeneration >= deployment.Generation &&
+			status.UpdatedReplicas == desired && status.ReadyReplicas == desired,
+	}
+	if !component.Ready {
+		component.Message = fmt.Sprintf("%d/%d ready, %d updated", status.ReadyReplicas, desired, status.UpdatedReplicas)
+	}
+	return component
+}
+
+func daemonSetComponent(name string, daemonSet *appsv1.DaemonSet) operatorv1alpha1.ComponentStatus {
+	status := daemonSet.Status
+	component := operatorv1alpha1.ComponentStatus{
+		Name:        name,
+		Kind:        "DaemonSet",
+		DesiredPods: status.DesiredNumberScheduled,
+		ReadyPods:   status.NumberReady,
+		UpdatedPods: status.UpdatedNumberScheduled,
+		Ready:       isDaemonSetReady(daemonSet),
+	}
+	if !component.Ready {
+		component.Message = fmt.Sprintf("%d/%d ready, %d updated", status.NumberReady, status.DesiredNumberScheduled,
+			status.UpdatedNumberScheduled)
+	}
+	return component
+}
+
+func clusterPolicyComponentStatus(clusterPolicy *unstructured.Unstructured) operatorv1alpha1.ComponentStatus {
+	state, _, _ := unstructured.NestedString(clusterPolicy.Object, "status", "state")
+	component := operatorv1alpha1.ComponentStatus{
+		Name:  clusterPolicyComponent,
+		Kind:  clusterPolicyGVK.Kind,
+		Ready: state == clusterPolicyReady,
+	}
+	if !component.Ready {
+		if state == "" {
+			state = "not reported"
+		}
+		component.Message = "state " + state
+	}
+	return component
+}
+
+// componentsRolledOut checks that the GPU operator and the ClusterPolicy are deployed and that every reported
+// component is ready. It is the readiness criterion without spec.readinessChecks.
+func componentsRolledOut(components []operatorv1alpha1.ComponentStatus) readinessResult {
+	deployed := map[string]bool{}
+	var problems []string
+	for _, component := range components {
+		deployed[component.Name] = true
+		if !component.Ready {
+			problems = append(problems, fmt.Sprintf("%s (%s)", component.Name, component.Message))
+		}
+	}
+	for _, name := range []string{operatorComponent, clusterPolicyComponent} {
+		if !deployed[name] {
+			problems = append(problems, name+" (not deployed)")
+		}
+	}
+	if len(problems) > 0 {
+		return readinessResult{
+			reason:  "OperandsNotReady",
+			message: "Components not rolled out: " + strings.Join(problems, ", "),
+		}
+	}
+	return readinessResult{ready: true}
+}
+
+// gpuOperatorsForComponent enqueues the GpuOperator CRs installing into the namespace of a component
+func (r *GpuOperatorReconciler) gpuOperatorsForComponent(ctx context.Context, obj client.Object) []reconcile.Request {
+	gpuOperators := &operatorv1alpha1.GpuOperatorList{}
+	if err := r.List(ctx, gpuOperators); err != nil {
+		log.FromContext(ctx).Error(err, "Failed to list GpuOperators")
+		return nil
+	}
+	var requests []reconcile.Request
+	for i := range gpuOperators.Items {
+		gpuOperator := &gpuOperators.Items[i]
+		if gpuOperator.DeletionTimestamp == nil && gpuOperator.Spec.TargetClusterKubeconfigSecretRef == nil &&
+			r.targetNamespace(gpuOperator) == obj.GetNamespace() {
+			requests = append(requests, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(gpuOperator)})
+		}
+	}
+	return requests
+}
+
+// isComponent reports whether an object is the GPU operator Deployment or an operand DaemonSet
+func isComponent(obj client.Object) bool {
+	switch obj.(type) {
+	case *appsv1.Deployment:
+		return obj.GetName() == operatorComponent
+	case *appsv1.DaemonSet:
+		app := obj.GetLabels()["app"]
+		for _, operand := range operandApps {
+			if operand.app == app {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// componentRolloutChangedPredicate passes the GPU operator components that are created, deleted or whose rollout
+// progresses
+var componentRolloutChangedPredicate = predicate.Funcs{
+	CreateFunc: func(e event.CreateEvent) bool { return isComponent(e.Object) },
+	UpdateFunc: func(e event.UpdateEvent) bool {
+		if !isComponent(e.ObjectNew) {
+			return false
+		}
+		switch newObj := e.ObjectNew.(type) {
+		case *appsv1.Deployment:
+			oldObj, ok := e.ObjectOld.(*appsv1.Deployment)
+			return !ok || deploymentComponent(oldObj) != deploymentComponent(newObj)
+		case *appsv1.DaemonSet:
+			oldObj, ok := e.ObjectOld.(*appsv1.DaemonSet)
+			return !ok || daemonSetComponent("", oldObj) != daemonSetComponent("", newObj)
+		}
+		return false
+	},
+	DeleteFunc:  func(e event.DeleteEvent) bool { return isComponent(e.Object) },
+	GenericFunc: func(event.GenericEvent) bool { return false },
+}
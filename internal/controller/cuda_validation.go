@@ -0,0 +1,205 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	operatorv1alpha1 "github.com/kyma-project/gpu-operator/api/v1alpha1"
+	"github.com/kyma-project/gpu-operator/internal/logging"
+)
+
+const (
+	cudaValidationComponent = "cuda-validation"
+	cudaValidationPrefix    = "gpu-cuda-validation-"
+
+	// cudaValidationGenerationLabel records the generation of the spec a CUDA validation pod validates
+	cudaValidationGenerationLabel = "operator.kyma-project.io/generation"
+	// cudaValidationTimeout bounds a CUDA validation pod, including the image pull
+	cudaValidationTimeout = 10 * time.Minute
+)
+
+// +kubebuilder:rbac:groups="",resources=pods,verbs=get;list;watch;create;delete
+
+// reconcileCUDAValidation runs the CUDA workload of spec.validation once per generation of the spec on every GPU
+// node serving containers, as soon as the node advertises allocatable GPUs, and reports the results in
+// status.cudaValidation. The validation pods and the results are removed when the validation is disabled.
+func (r *GpuOperatorReconciler) reconcileCUDAValidation(ctx context.Context, gpuOperator *operatorv1alpha1.GpuOperator, namespace string) error {
+	logger := log.FromContext(ctx).WithName(logging.SubsystemHealth)
+
+	pods := &corev1.PodList{}
+	if err := r.List(ctx, pods, client.InNamespace(namespace),
+		client.MatchingLabels{"app.kubernetes.io/component": cudaValidationComponent}); err != nil {
+		return fmt.Errorf("failed to list CUDA validation pods: %w", err)
+	}
+	existing := map[string]*corev1.Pod{}
+	for i := range pods.Items {
+		existing[pods.Items[i].Spec.NodeName] = &pods.Items[i]
+	}
+
+	validated := map[string]bool{}
+	var status *operatorv1alpha1.CUDAValidationStatus
+	if gpuOperator.Spec.Validation != nil && gpuOperator.Spec.Validation.Enabled {
+		nodes, err := r.gpuNodes(ctx)
+		if err != nil {
+			return err
+		}
+		generation := strconv.FormatInt(gpuOperator.Generation, 10)
+		status = &operatorv1alpha1.CUDAValidationStatus{ObservedGeneration: gpuOperator.Generation}
+		for i := range nodes {
+			node := &nodes[i]
+			// Nodes passing their GPUs through to VMs cannot run the CUDA container
+			if !servesContainers(gpuOperator, node) {
+				continue
+			}
+			validated[node.Name] = true
+			result := operatorv1alpha1.CUDAValidationNode{Name: node.Name, Result: operatorv1alpha1.ValidationPending}
+			pod := existing[node.Name]
+			switch {
+			case pod != nil && pod.DeletionTimestamp != nil:
+				result.Message = "Waiting for the previous CUDA validation pod to be deleted"
+			case pod != nil && pod.Labels[cudaValidationGenerationLabel] != generation:
+				// Validate the node again for the new spec
+				if err := r.Delete(ctx, pod); err != nil && !apierrors.IsNotFound(err) {
+					return fmt.Errorf("failed to delete CUDA validation pod %s: %w", pod.Name, err)
+				}
+				result.Message = "Waiting for the previous CUDA validation pod to be deleted"
+			case pod != nil:
+				result.Result, result.Message = cudaValidationPodResult(pod)
+			case allocatableGPUs(node) == 0:
+				result.Message = fmt.Sprintf("Waiting for the node to advertise allocatable %s", gpuResourceName)
+			default:
+				pod = r.cudaValidationPod(gpuOperator, node.Name, namespace, generation)
+				if err := r.setControllerReference(gpuOperator, pod); err != nil {
+					return err
+				}
+				if err := r.Create(ctx, pod); err != nil && !apierrors.IsAlreadyExists(err) {
+					return fmt.Errorf("failed to create CUDA validation pod for node %s: %w", node.Name, err)
+				}
+				logger.Info("Started CUDA validation", "node", node.Name, "pod", pod.Name)
+				result.Message = "CUDA workload started"
+			}
+			status.Nodes = append(status.Nodes, result)
+		}
+		sort.Slice(status.Nodes, func(i, j int) bool { return status.Nodes[i].Name < status.Nodes[j].Name })
+	}
+
+	// Remove the pods of nodes that are gone or of a validation that was disabled
+	for name, pod := range existing {
+		if validated[name] || pod.DeletionTimestamp != nil {
+			continue
+		}
+		if err := r.Delete(ctx, pod); err != nil && !apierrors.IsNotFound(err) {
+			return fmt.Errorf("failed to delete CUDA validation pod %s: %w", pod.Name, err)
+		}
+		logger.Info("Deleted CUDA validation pod", "pod", pod.Name)
+	}
+	gpuOperator.Status.CUDAValidation = status
+	return nil
+}
+
+// cudaValidationPod runs the CUDA workload on one GPU of the node
+func (r *GpuOperatorReconciler) cudaValidationPod(gpuOperator *operatorv1alpha1.GpuOperator, nodeName, namespace, generation string) *corev1.Pod {
+	command := []string{"nvidia-smi"}
+	if len(gpuOperator.Spec.Validation.Command) > 0 {
+		command = gpuOperator.Spec.Validation.Command
+	}
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      cudaValidationPrefix + nodeName,
+			Namespace: namespace,
+			Labels:    cudaValidationLabels(generation),
+		},
+		Spec: corev1.PodSpec{
+			// Bypass the scheduler, the node is validated whatever else runs on it
+			NodeName:              nodeName,
+			RestartPolicy:         corev1.RestartPolicyNever,
+			ActiveDeadlineSeconds: ptr.To(int64(cudaValidationTimeout.Seconds())),
+			RuntimeClassName:      ptr.To(runtimeClassName(gpuOperator)),
+			ImagePullSecrets:      registryPullSecrets(gpuOperator),
+			Tolerations:           []corev1.Toleration{{Operator: corev1.TolerationOpExists}},
+			Containers: []corev1.Container{
+				{
+					Name:    "cuda-validation",
+					Image:   r.validationImage(gpuOperator),
+					Command: command,
+					Resources: corev1.ResourceRequirements{
+						Limits: corev1.ResourceList{gpuResourceName: resource.MustParse("1")},
+					},
+				},
+			},
+		},
+	}
+}
+
+// cudaValidationPodResult maps the phase of a CUDA validation pod to the result of the node
+func cudaValidationPodResult(pod *corev1.Pod) (operatorv1alpha1.ValidationResult, string) {
+	switch pod.Status.Phase {
+	case corev1.PodSucceeded:
+		return operatorv1alpha1.ValidationPassed, ""
+	case corev1.PodFailed:
+		message := pod.Status.Reason
+		for _, status := range pod.Status.ContainerStatuses {
+			if status.State.Terminated != nil {
+				_, message = containerValidationResult(status)
+			}
+		}
+		if message == "" {
+			message = "failed"
+		}
+		return operatorv1alpha1.ValidationFailed, "CUDA workload " + message
+	case corev1.PodRunning:
+		return operatorv1alpha1.ValidationPending, "CUDA workload is running"
+	default:
+		return operatorv1alpha1.ValidationPending, "CUDA workload is starting"
+	}
+}
+
+// cudaValidationPending reports whether the CUDA validation of any GPU node has not completed yet
+func cudaValidationPending(status *operatorv1alpha1.CUDAValidationStatus) bool {
+	if status == nil {
+		return false
+	}
+	for _, node := range status.Nodes {
+		if node.Result == operatorv1alpha1.ValidationPending {
+			return true
+		}
+	}
+	return false
+}
+
+// cudaValidationLabels returns the labels of the CUDA validation pods
+func cudaValidationLabels(generation string) map[string]string {
+	return map[string]string{
+		"app.kubernetes.io/name":       "gpu-operator",
+		"app.kubernetes.io/managed-by": "gpu-operator-module",
+		"app.kubernetes.io/component":  cudaValidationComponent,
+		cudaValidationGenerationLabel:  generation,
+	}
+}
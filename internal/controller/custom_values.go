@@ -0,0 +1,201 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/yaml"
+
+	operatorv1alpha1 "github.com/kyma-project/gpu-operator/api/v1alpha1"
+	"github.com/kyma-project/gpu-operator/internal/gardener"
+	"github.com/kyma-project/gpu-operator/internal/logging"
+)
+
+// valuesConfigMapKey holds the custom values in the ConfigMap referenced by spec.valuesConfigMapName
+const valuesConfigMapKey = "values.yaml"
+
+// customValues returns the custom values of the CR, nil if it has none: the values of the ConfigMap referenced by
+// spec.valuesConfigMapName, with spec.values and then spec.rawValues merged over them. The ConfigMap is read from
+// the namespace of the CR.
+func (r *GpuOperatorReconciler) customValues(ctx context.Context, gpuOperator *operatorv1alpha1.GpuOperator) (map[string]interface{}, error) {
+	if !hasCustomValues(gpuOperator) {
+		return nil, nil
+	}
+	values, err := r.configMapValues(ctx, gpuOperator)
+	if err != nil {
+		return nil, err
+	}
+	if gpuOperator.Spec.Values != nil {
+		typed, err := specValues(gpuOperator.Spec.Values)
+		if err != nil {
+			return nil, err
+		}
+		values = mergeValues(values, typed)
+	}
+	if raw := gpuOperator.Spec.RawValues; raw != nil && len(raw.Raw) > 0 {
+		rawValues := map[string]interface{}{}
+		if err := json.Unmarshal(raw.Raw, &rawValues); err != nil {
+			return nil, fmt.Errorf("invalid rawValues: %w", err)
+		}
+		values = mergeValues(values, rawValues)
+	}
+	return values, nil
+}
+
+// hasCustomValues reports whether the CR sets values over the Gardener values
+func hasCustomValues(gpuOperator *operatorv1alpha1.GpuOperator) bool {
+	spec := gpuOperator.Spec
+	return spec.ValuesConfigMapName != "" || spec.Values != nil || spec.RawValues != nil && len(spec.RawValues.Raw) > 0
+}
+
+// configMapValues returns the values of the ConfigMap referenced by spec.valuesConfigMapName, empty if none is referenced
+func (r *GpuOperatorReconciler) configMapValues(ctx context.Context, gpuOperator *operatorv1alpha1.GpuOperator) (map[string]interface{}, error) {
+	values := map[string]interface{}{}
+	name := gpuOperator.Spec.ValuesConfigMapName
+	if name == "" {
+		return values, nil
+	}
+	configMap := &corev1.ConfigMap{}
+	if err := r.localReader().Get(ctx, types.NamespacedName{Name: name, Namespace: gpuOperator.Namespace}, configMap); err != nil {
+		return nil, fmt.Errorf("failed to get values ConfigMap %s: %w", name, err)
+	}
+	data, ok := configMap.Data[valuesConfigMapKey]
+	if !ok {
+		return nil, fmt.Errorf("values ConfigMap %s has no key %s", name, valuesConfigMapKey)
+	}
+	if err := yaml.Unmarshal([]byte(data), &values); err != nil {
+		return nil, fmt.Errorf("invalid values in ConfigMap %s: %w", name, err)
+	}
+	if values == nil {
+		values = map[string]interface{}{}
+	}
+	return values, nil
+}
+
+// specValues converts spec.values into a Helm values tree. The fields of spec.values are named after the
+// chart values, so the JSON encoding is the values tree.
+func specValues(helmValues *operatorv1alpha1.HelmValues) (map[string]interface{}, error) {
+	data, err := json.Marshal(helmValues)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode values: %w", err)
+	}
+	values := map[string]interface{}{}
+	if err := json.Unmarshal(data, &values); err != nil {
+		return nil, fmt.Errorf("failed to decode values: %w", err)
+	}
+	return values, nil
+}
+
+// baseValues returns the Gardener values from spec.valuesSource with the custom values merged over them, and
+// records the source the Gardener values came from in the status
+func (r *GpuOperatorReconciler) baseValues(ctx context.Context, gpuOperator *operatorv1alpha1.GpuOperator) (map[string]interface{}, error) {
+	custom, err := r.customValues(ctx, gpuOperator)
+	if err != nil {
+		return nil, err
+	}
+	values, source, err := r.gardenerValues(ctx, gpuOperator)
+	if err != nil {
+		return nil, err
+	}
+	gpuOperator.Status.ValuesSource = source
+	return mergeValues(values, custom), nil
+}
+
+// gardenerValues returns the Gardener values from spec.valuesSource and the source they came from. Remote values
+// that cannot be fetched fall back to the copy embedded in the controller; with the ConfigMap source the values
+// of spec.valuesConfigMapName replace the Gardener values, so there are none.
+func (r *GpuOperatorReconciler) gardenerValues(ctx context.Context, gpuOperator *operatorv1alpha1.GpuOperator) (map[string]interface{}, operatorv1alpha1.ValuesSource, error) {
+	switch gpuOperator.Spec.ValuesSource {
+	case operatorv1alpha1.ValuesSourceConfigMap:
+		return map[string]interface{}{}, operatorv1alpha1.ValuesSourceConfigMap, nil
+	case operatorv1alpha1.ValuesSourceEmbedded:
+		values, err := gardener.Values()
+		return values, operatorv1alpha1.ValuesSourceEmbedded, err
+	}
+	values, err := r.helmClient.Values(ctx, gardener.ValuesURL, helmProxy(gpuOperator))
+	if err == nil {
+		return values, operatorv1alpha1.ValuesSourceRemote, nil
+	}
+	log.FromContext(ctx).WithName(logging.SubsystemHelm).Error(err, "Failed to fetch the Gardener values, using the embedded copy",
+		"url", gardener.ValuesURL)
+	values, err = gardener.Values()
+	return values, operatorv1alpha1.ValuesSourceEmbedded, err
+}
+
+// mergeValues deep-merges override into base the way Helm merges values files: nested maps are merged,
+// all other values, including lists, are replaced, and null removes a key
+func mergeValues(base, override map[string]interface{}) map[string]interface{} {
+	for key, value := range override {
+		if value == nil {
+			delete(base, key)
+			continue
+		}
+		overrideMap, isMap := value.(map[string]interface{})
+		baseMap, baseIsMap := base[key].(map[string]interface{})
+		if isMap && baseIsMap {
+			base[key] = mergeValues(baseMap, overrideMap)
+			continue
+		}
+		base[key] = value
+	}
+	return base
+}
+
+// gpuOperatorsForValuesConfigMap enqueues the GpuOperator CRs referencing a values ConfigMap, so edits are installed
+func (r *GpuOperatorReconciler) gpuOperatorsForValuesConfigMap(ctx context.Context, obj client.Object) []reconcile.Request {
+	gpuOperators := &operatorv1alpha1.GpuOperatorList{}
+	if err := r.List(ctx, gpuOperators, client.InNamespace(obj.GetNamespace())); err != nil {
+		log.FromContext(ctx).Error(err, "Failed to list GpuOperators")
+		return nil
+	}
+	var requests []reconcile.Request
+	for _, gpuOperator := range gpuOperators.Items {
+		if gpuOperator.DeletionTimestamp == nil && gpuOperator.Spec.ValuesConfigMapName == obj.GetName() {
+			requests = append(requests, reconcile.Request{
+				NamespacedName: types.NamespacedName{Name: gpuOperator.Name, Namespace: gpuOperator.Namespace},
+			})
+		}
+	}
+	return requests
+}
+
+// valuesConfigMapPredicate passes the ConfigMaps that carry custom values
+var valuesConfigMapPredicate = predicate.Funcs{
+	CreateFunc:  func(e event.CreateEvent) bool { return hasValuesKey(e.Object) },
+	UpdateFunc:  func(e event.UpdateEvent) bool { return hasValuesKey(e.ObjectOld) || hasValuesKey(e.ObjectNew) },
+	DeleteFunc:  func(e event.DeleteEvent) bool { return hasValuesKey(e.Object) },
+	GenericFunc: func(event.GenericEvent) bool { return false },
+}
+
+func hasValuesKey(obj client.Object) bool {
+	configMap, ok := obj.(*corev1.ConfigMap)
+	if !ok {
+		return false
+	}
+	_, found := configMap.Data[valuesConfigMapKey]
+	return found && configMap.Labels["app.kubernetes.io/managed-by"] != "gpu-operator-module"
+}
@@ -0,0 +1,190 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	operatorv1alpha1 "github.com/kyma-project/gpu-operator/api/v1alpha1"
+)
+
+func TestMergeValues(t *testing.T) {
+	tests := []struct {
+		name     string
+		base     map[string]interface{}
+		override map[string]interface{}
+		want     map[string]interface{}
+	}{
+		{
+			name:     "override wins over base",
+			base:     map[string]interface{}{"key": "base"},
+			override: map[string]interface{}{"key": "override"},
+			want:     map[string]interface{}{"key": "override"},
+		},
+		{
+			name:     "nested maps are merged",
+			base:     map[string]interface{}{"driver": map[string]interface{}{"version": "570", "usePrecompiled": true}},
+			override: map[string]interface{}{"driver": map[string]interface{}{"version": "580"}},
+			want:     map[string]interface{}{"driver": map[string]interface{}{"version": "580", "usePrecompiled": true}},
+		},
+		{
+			name:     "lists are replaced",
+			base:     map[string]interface{}{"args": []interface{}{"a", "b"}},
+			override: map[string]interface{}{"args": []interface{}{"c"}},
+			want:     map[string]interface{}{"args": []interface{}{"c"}},
+		},
+		{
+			name:     "null removes a key",
+			base:     map[string]interface{}{"driver": map[string]interface{}{"version": "570", "usePrecompiled": true}},
+			override: map[string]interface{}{"driver": map[string]interface{}{"usePrecompiled": nil}},
+			want:     map[string]interface{}{"driver": map[string]interface{}{"version": "570"}},
+		},
+		{
+			name:     "a map replaces a scalar",
+			base:     map[string]interface{}{"nfd": true},
+			override: map[string]interface{}{"nfd": map[string]interface{}{"enabled": false}},
+			want:     map[string]interface{}{"nfd": map[string]interface{}{"enabled": false}},
+		},
+		{
+			name:     "a scalar replaces a map",
+			base:     map[string]interface{}{"nfd": map[string]interface{}{"enabled": false}},
+			override: map[string]interface{}{"nfd": true},
+			want:     map[string]interface{}{"nfd": true},
+		},
+		{
+			name:     "keys missing from the override are kept",
+			base:     map[string]interface{}{"cdi": map[string]interface{}{"enabled": true}},
+			override: map[string]interface{}{"driver": map[string]interface{}{"version": "580"}},
+			want: map[string]interface{}{
+				"cdi":    map[string]interface{}{"enabled": true},
+				"driver": map[string]interface{}{"version": "580"},
+			},
+		},
+		{
+			name:     "empty override",
+			base:     map[string]interface{}{"key": "base"},
+			override: nil,
+			want:     map[string]interface{}{"key": "base"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := mergeValues(tt.base, tt.override)
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("mergeValues() (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestBaseValuesPrecedence(t *testing.T) {
+	const configMapName = "custom-values"
+
+	tests := []struct {
+		name      string
+		configMap string
+		spec      operatorv1alpha1.GpuOperatorSpec
+		settings  []chartValue
+		want      map[string]interface{}
+	}{
+		{
+			name: "Garden Linux values without custom values",
+			spec: operatorv1alpha1.GpuOperatorSpec{},
+			want: map[string]interface{}{"imagePullPolicy": "Always", "usePrecompiled": true, "version": "570"},
+		},
+		{
+			name:      "ConfigMap over the Garden Linux values",
+			configMap: "driver:\n  imagePullPolicy: IfNotPresent\n  usePrecompiled: null\n",
+			spec:      operatorv1alpha1.GpuOperatorSpec{ValuesConfigMapName: configMapName},
+			want:      map[string]interface{}{"imagePullPolicy": "IfNotPresent", "version": "570"},
+		},
+		{
+			name:      "values over the ConfigMap",
+			configMap: "driver:\n  imagePullPolicy: IfNotPresent\n  usePrecompiled: false\n",
+			spec: operatorv1alpha1.GpuOperatorSpec{
+				ValuesConfigMapName: configMapName,
+				Values: &operatorv1alpha1.HelmValues{Driver: &operatorv1alpha1.DriverValues{
+					OperandValues: operatorv1alpha1.OperandValues{ImagePullPolicy: corev1.PullNever},
+				}},
+			},
+			want: map[string]interface{}{"imagePullPolicy": "Never", "usePrecompiled": false, "version": "570"},
+		},
+		{
+			name:      "rawValues over values",
+			configMap: "driver:\n  imagePullPolicy: IfNotPresent\n",
+			spec: operatorv1alpha1.GpuOperatorSpec{
+				ValuesConfigMapName: configMapName,
+				Values: &operatorv1alpha1.HelmValues{Driver: &operatorv1alpha1.DriverValues{
+					OperandValues: operatorv1alpha1.OperandValues{ImagePullPolicy: corev1.PullNever},
+				}},
+				RawValues: &runtime.RawExtension{Raw: []byte(`{"driver":{"imagePullPolicy":"Always","version":"575"}}`)},
+			},
+			want: map[string]interface{}{"imagePullPolicy": "Always", "usePrecompiled": true, "version": "575"},
+		},
+		{
+			name: "spec settings over rawValues",
+			spec: operatorv1alpha1.GpuOperatorSpec{
+				RawValues: &runtime.RawExtension{Raw: []byte(`{"driver":{"version":"575"}}`)},
+			},
+			settings: []chartValue{{path: "driver.version", value: "580"}},
+			want:     map[string]interface{}{"imagePullPolicy": "Always", "usePrecompiled": true, "version": "580"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gpuOperator := &operatorv1alpha1.GpuOperator{
+				ObjectMeta: metav1.ObjectMeta{Name: "gpu-operator", Namespace: "default"},
+				Spec:       tt.spec,
+			}
+			gpuOperator.Spec.ValuesSource = operatorv1alpha1.ValuesSourceEmbedded
+			var objects []client.Object
+			if tt.configMap != "" {
+				objects = append(objects, &corev1.ConfigMap{
+					ObjectMeta: metav1.ObjectMeta{Name: configMapName, Namespace: "default"},
+					Data:       map[string]string{valuesConfigMapKey: tt.configMap},
+				})
+			}
+			r := &GpuOperatorReconciler{Client: fake.NewClientBuilder().WithObjects(objects...).Build()}
+
+			values, err := r.baseValues(context.Background(), gpuOperator)
+			if err != nil {
+				t.Fatalf("baseValues: %v", err)
+			}
+			if err := setChartValues(values, tt.settings); err != nil {
+				t.Fatalf("setChartValues: %v", err)
+			}
+			driver, _ := values["driver"].(map[string]interface{})
+			got := map[string]interface{}{}
+			for _, key := range []string{"imagePullPolicy", "usePrecompiled", "version"} {
+				if value, found := driver[key]; found {
+					got[key] = value
+				}
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("driver values (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
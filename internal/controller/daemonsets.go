@@ -0,0 +1,40 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	operatorv1alpha1 "github.com/kyma-project/gpu-operator/api/v1alpha1"
+)
+
+// daemonSetValues returns the chart values of spec.daemonsets, applied by the GPU operator to all operand DaemonSets
+func daemonSetValues(gpuOperator *operatorv1alpha1.GpuOperator) []chartValue {
+	daemonSets := gpuOperator.Spec.DaemonSets
+	if daemonSets == nil || daemonSets.UpdateStrategy == nil {
+		return nil
+	}
+	strategy := daemonSets.UpdateStrategy
+	strategyType := strategy.Type
+	if strategyType == "" {
+		strategyType = "RollingUpdate"
+	}
+	values := []chartValue{{path: "daemonsets.updateStrategy", value: strategyType}}
+	if strategy.MaxUnavailable != nil {
+		// The chart and the ClusterPolicy take the value as a string
+		values = append(values, chartValue{path: "daemonsets.rollingUpdate.maxUnavailable", value: strategy.MaxUnavailable.String()})
+	}
+	return values
+}
@@ -0,0 +1,119 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	operatorv1alpha1 "github.com/kyma-project/gpu-operator/api/v1alpha1"
+	"github.com/kyma-project/gpu-operator/internal/eol"
+)
+
+const (
+	conditionTypeDeprecatedVersion = "DeprecatedVersion"
+
+	// helmChartLabel is set by the GPU operator chart on the ClusterPolicy, e.g. gpu-operator-v25.3.0
+	helmChartLabel = "helm.sh/chart"
+)
+
+// deprecatedVersionCondition checks the driver branch and the deployed chart version against the
+// end-of-life matrix and reports whether any of them is deprecated or end-of-life
+func (r *GpuOperatorReconciler) deprecatedVersionCondition(ctx context.Context, gpuOperator *operatorv1alpha1.GpuOperator, driverVersion string) metav1.Condition {
+	matrix := r.Config.Get().LifecycleMatrix()
+	now := time.Now()
+
+	var deprecated, endOfLife []string
+	check := func(kind, version string, entry *eol.Entry) {
+		if entry == nil {
+			return
+		}
+		switch entry.Lifecycle(now) {
+		case eol.EndOfLife:
+			endOfLife = append(endOfLife, fmt.Sprintf("%s %s reached end-of-life on %s", kind, version, entry.EndOfLife))
+		case eol.Deprecated:
+			message := fmt.Sprintf("%s %s is deprecated", kind, version)
+			if entry.EndOfLife != "" {
+				message += fmt.Sprintf(" and reaches end-of-life on %s", entry.EndOfLife)
+			}
+			deprecated = append(deprecated, message)
+		}
+	}
+
+	check("Driver branch", driverVersion, matrix.Driver(driverVersion))
+	chartVersion, err := r.deployedChartVersion(ctx)
+	if err != nil {
+		log.FromContext(ctx).Error(err, "Failed to determine deployed chart version, skipping its lifecycle check")
+	}
+	if chartVersion != "" {
+		check("GPU operator chart", chartVersion, matrix.Chart(chartVersion))
+	}
+
+	condition := metav1.Condition{
+		Type:               conditionTypeDeprecatedVersion,
+		Status:             metav1.ConditionFalse,
+		Reason:             "Supported",
+		Message:            "The configured driver branch and chart version are supported",
+		ObservedGeneration: gpuOperator.Generation,
+	}
+	switch {
+	case len(endOfLife) > 0:
+		condition.Status = metav1.ConditionTrue
+		condition.Reason = string(eol.EndOfLife)
+		condition.Message = strings.Join(append(endOfLife, deprecated...), "; ")
+	case len(deprecated) > 0:
+		condition.Status = metav1.ConditionTrue
+		condition.Reason = string(eol.Deprecated)
+		condition.Message = strings.Join(deprecated, "; ")
+	}
+	return condition
+}
+
+// deployedChartVersion returns the GPU operator chart version from the labels of the deployed
+// ClusterPolicy, empty if none is deployed yet
+func (r *GpuOperatorReconciler) deployedChartVersion(ctx context.Context) (string, error) {
+	clusterPolicy, err := r.releaseClusterPolicy(ctx)
+	if err != nil || clusterPolicy == nil {
+		return "", err
+	}
+	return strings.TrimPrefix(clusterPolicy.GetLabels()[helmChartLabel], helmReleaseName+"-"), nil
+}
+
+// releaseClusterPolicy returns the ClusterPolicy of the GPU operator release, nil if none is deployed yet
+func (r *GpuOperatorReconciler) releaseClusterPolicy(ctx context.Context) (*unstructured.Unstructured, error) {
+	clusterPolicies := &unstructured.UnstructuredList{}
+	clusterPolicies.SetGroupVersionKind(clusterPolicyGVK.GroupVersion().WithKind(clusterPolicyGVK.Kind + "List"))
+	if err := r.List(ctx, clusterPolicies); err != nil {
+		if meta.IsNoMatchError(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to list ClusterPolicies: %w", err)
+	}
+	for i := range clusterPolicies.Items {
+		if chart := clusterPolicies.Items[i].GetLabels()[helmChartLabel]; strings.HasPrefix(chart, helmReleaseName+"-") {
+			return &clusterPolicies.Items[i], nil
+		}
+	}
+	return nil, nil
+}
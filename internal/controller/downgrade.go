@@ -0,0 +1,86 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	operatorv1alpha1 "github.com/kyma-project/gpu-operator/api/v1alpha1"
+)
+
+// conditionTypeDowngradeBlocked reports a spec refused because it downgrades the chart or the driver
+const conditionTypeDowngradeBlocked = "DowngradeBlocked"
+
+// downgradeBlockedError reports the chart and driver versions of the spec that are lower than the installed ones
+type downgradeBlockedError struct {
+	downgrades []string
+}
+
+func (e *downgradeBlockedError) Error() string {
+	return fmt.Sprintf("refusing to downgrade %s, set spec.allowDowngrade to downgrade anyway",
+		strings.Join(e.downgrades, " and "))
+}
+
+// checkDowngrade refuses a chart or driver version lower than the installed one unless spec.allowDowngrade
+// is set, e.g. when a GitOps rollback restores an older spec. The GPU operator does not support downgrades.
+func checkDowngrade(gpuOperator *operatorv1alpha1.GpuOperator) error {
+	if gpuOperator.Spec.AllowDowngrade {
+		return nil
+	}
+	var downgrades []string
+	if requested, installed := chartVersion(gpuOperator), gpuOperator.Status.InstalledChartVersion; requested != "" &&
+		chartVersionNewer(installed, requested) {
+		downgrades = append(downgrades, fmt.Sprintf("the chart from %s to %s", installed, requested))
+	}
+	if requested, installed := specDriverVersion(gpuOperator), gpuOperator.Status.InstalledVersion; requested != "" &&
+		driverVersionLower(requested, installed) {
+		downgrades = append(downgrades, fmt.Sprintf("the driver from %s to %s", installed, requested))
+	}
+	if len(downgrades) == 0 {
+		return nil
+	}
+	return &downgradeBlockedError{downgrades: downgrades}
+}
+
+// driverVersionLower reports whether a driver version is lower than the installed driver version. Only the
+// components of the requested version are compared, so a driver branch is not lower than its releases.
+// Versions that are not dotted numbers are never lower.
+func driverVersionLower(requested, installed string) bool {
+	if installed == "" {
+		return false
+	}
+	installedParts := strings.Split(installed, ".")
+	for i, part := range strings.Split(requested, ".") {
+		if i >= len(installedParts) {
+			return false
+		}
+		requestedNumber, err := strconv.Atoi(part)
+		if err != nil {
+			return false
+		}
+		installedNumber, err := strconv.Atoi(installedParts[i])
+		if err != nil {
+			return false
+		}
+		if requestedNumber != installedNumber {
+			return requestedNumber < installedNumber
+		}
+	}
+	return false
+}
@@ -0,0 +1,65 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	operatorv1alpha1 "github.com/kyma-project/gpu-operator/api/v1alpha1"
+)
+
+const (
+	draReleaseName = "nvidia-dra-driver-gpu"
+	// draDriverRoot is where the driver container of the GPU operator exposes the driver on the host
+	draDriverRoot = "/run/nvidia/driver"
+)
+
+// deviceClassGK is served by Kubernetes versions supporting Dynamic Resource Allocation
+var deviceClassGK = schema.GroupKind{Group: "resource.k8s.io", Kind: "DeviceClass"}
+
+// draEnabled reports whether GPUs are allocated with Dynamic Resource Allocation
+func draEnabled(gpuOperator *operatorv1alpha1.GpuOperator) bool {
+	return gpuOperator.Spec.DRA != nil && gpuOperator.Spec.DRA.Enabled
+}
+
+// draValues returns the chart values of spec.dra. The DRA driver allocates the GPUs, so the device plugin
+// must not advertise them as well.
+func draValues(gpuOperator *operatorv1alpha1.GpuOperator) []chartValue {
+	if !draEnabled(gpuOperator) {
+		return nil
+	}
+	return []chartValue{{path: "devicePlugin.enabled", value: false}}
+}
+
+// checkDRASupport fails if spec.dra is enabled on a cluster that does not serve the resource.k8s.io API
+func (r *GpuOperatorReconciler) checkDRASupport(ctx context.Context, gpuOperator *operatorv1alpha1.GpuOperator) error {
+	if !draEnabled(gpuOperator) {
+		return nil
+	}
+	if _, err := r.RESTMapper().RESTMapping(deviceClassGK); err != nil {
+		if meta.IsNoMatchError(err) {
+			return fmt.Errorf("spec.dra requires Dynamic Resource Allocation, which needs Kubernetes 1.32 or later " +
+				"with the resource.k8s.io API enabled")
+		}
+		return fmt.Errorf("failed to check for Dynamic Resource Allocation support: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,138 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"helm.sh/helm/v3/pkg/release"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	operatorv1alpha1 "github.com/kyma-project/gpu-operator/api/v1alpha1"
+	"github.com/kyma-project/gpu-operator/internal/helm"
+	"github.com/kyma-project/gpu-operator/internal/logging"
+)
+
+const defaultResyncPeriod = time.Hour
+
+// resyncPeriod returns how often the deployed Helm release is compared with the desired installation
+func resyncPeriod(gpuOperator *operatorv1alpha1.GpuOperator) time.Duration {
+	if gpuOperator.Spec.ResyncPeriod != nil && gpuOperator.Spec.ResyncPeriod.Duration > 0 {
+		return gpuOperator.Spec.ResyncPeriod.Duration
+	}
+	return defaultResyncPeriod
+}
+
+// resyncDue reports whether the deployed Helm release was not compared with the desired installation
+// within the resync period
+func resyncDue(gpuOperator *operatorv1alpha1.GpuOperator) bool {
+	last := gpuOperator.Status.LastResyncTime
+	return last == nil || time.Since(last.Time) >= resyncPeriod(gpuOperator)
+}
+
+// reconcileDrift compares the deployed Helm release with the desired installation once per resync period. A
+// release that was changed by hand is recorded in the ReleaseRecovered condition, and status.installHash is
+// cleared so the next reconcile reinstalls it. It reports whether the release drifted.
+func (r *GpuOperatorReconciler) reconcileDrift(ctx context.Context, gpuOperator *operatorv1alpha1.GpuOperator, namespace string) (bool, error) {
+	if !resyncDue(gpuOperator) {
+		return false, nil
+	}
+	drift, err := r.releaseDrift(ctx, gpuOperator, namespace)
+	if err != nil {
+		return false, err
+	}
+	gpuOperator.Status.LastResyncTime = &metav1.Time{Time: time.Now()}
+	if drift == "" {
+		return false, nil
+	}
+	log.FromContext(ctx).WithName(logging.SubsystemHelm).Info("Helm release drifted from the desired installation, reinstalling",
+		"drift", drift)
+
+	condition := metav1.Condition{
+		Type:               conditionTypeReleaseRecovered,
+		Status:             metav1.ConditionTrue,
+		Reason:             "ReleaseDrifted",
+		Message:            fmt.Sprintf("Helm release %s drifted from the desired installation, reinstalled it: %s", helmReleaseName, drift),
+		ObservedGeneration: gpuOperator.Generation,
+	}
+	meta.SetStatusCondition(&gpuOperator.Status.Conditions, condition)
+	r.event(gpuOperator, corev1.EventTypeWarning, condition.Reason, "%s", condition.Message)
+	resetInstallHash(gpuOperator)
+	if err := r.updateStatus(ctx, gpuOperator); err != nil {
+		return false, fmt.Errorf("failed to record Helm release drift: %w", err)
+	}
+	return true, nil
+}
+
+// releaseDrift returns how the deployed GPU operator release differs from the desired installation,
+// empty if it does not
+func (r *GpuOperatorReconciler) releaseDrift(ctx context.Context, gpuOperator *operatorv1alpha1.GpuOperator, namespace string) (string, error) {
+	deployed, deployedValues, err := r.helmClient.Latest(r.restConfig, namespace, helmReleaseName)
+	if err != nil {
+		return "", err
+	}
+	if deployed == nil || deployed.Status != release.StatusDeployed {
+		return compareRelease(deployed, nil, "", nil)
+	}
+	desiredValues, err := r.baseValues(ctx, gpuOperator)
+	if err != nil {
+		return "", err
+	}
+	if err := setChartValues(desiredValues, r.installValues(gpuOperator)); err != nil {
+		return "", err
+	}
+	return compareRelease(deployed, deployedValues, chartVersion(gpuOperator), desiredValues)
+}
+
+// compareRelease returns how a deployed release differs from the desired chart version, empty for any version,
+// and values, empty if it does not. A release left pending is recovered separately and does not count as drift.
+func compareRelease(deployed *helm.Release, deployedValues map[string]interface{}, desiredVersion string,
+	desiredValues map[string]interface{}) (string, error) {
+	switch {
+	case deployed == nil:
+		return "the release is not installed", nil
+	case slices.Contains(pendingReleaseStatuses, string(deployed.Status)):
+		return "", nil
+	case deployed.Status != release.StatusDeployed:
+		return fmt.Sprintf("revision %d is %s", deployed.Revision, deployed.Status), nil
+	}
+	if desiredVersion != "" &&
+		strings.TrimPrefix(deployed.ChartVersion, "v") != strings.TrimPrefix(desiredVersion, "v") {
+		return fmt.Sprintf("chart version %s is deployed instead of %s", deployed.ChartVersion, desiredVersion), nil
+	}
+
+	deployedHash, err := helm.ValuesHash(deployedValues)
+	if err != nil {
+		return "", err
+	}
+	desiredHash, err := helm.ValuesHash(desiredValues)
+	if err != nil {
+		return "", err
+	}
+	if deployedHash != desiredHash {
+		return fmt.Sprintf("revision %d was installed with other values (hash %s instead of %s)",
+			deployed.Revision, deployedHash, desiredHash), nil
+	}
+	return "", nil
+}
@@ -0,0 +1,119 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"strings"
+	"testing"
+
+	"helm.sh/helm/v3/pkg/release"
+
+	"github.com/kyma-project/gpu-operator/internal/helm"
+)
+
+func TestCompareRelease(t *testing.T) {
+	values := `
+driver:
+  version: "570"
+  usePrecompiled: true
+`
+	deployed := &helm.Release{Revision: 3, Status: release.StatusDeployed, ChartVersion: "v25.3.0"}
+
+	tests := []struct {
+		name           string
+		deployed       *helm.Release
+		deployedValues string
+		desiredVersion string
+		desiredValues  string
+		wantDrift      string
+	}{
+		{
+			name:           "up to date",
+			deployed:       deployed,
+			deployedValues: values,
+			desiredVersion: "v25.3.0",
+			desiredValues:  values,
+		},
+		{
+			name:           "values in another key order",
+			deployed:       deployed,
+			deployedValues: values,
+			desiredValues:  "driver:\n  usePrecompiled: true\n  version: \"570\"\n",
+		},
+		{
+			name:           "chart version without the v prefix",
+			deployed:       deployed,
+			deployedValues: values,
+			desiredVersion: "25.3.0",
+			desiredValues:  values,
+		},
+		{
+			name:           "latest chart version",
+			deployed:       deployed,
+			deployedValues: values,
+			desiredValues:  values,
+		},
+		{
+			name:      "not installed",
+			wantDrift: "the release is not installed",
+		},
+		{
+			name:      "uninstalled by hand",
+			deployed:  &helm.Release{Revision: 3, Status: release.StatusUninstalled},
+			wantDrift: "revision 3 is uninstalled",
+		},
+		{
+			name:      "failed upgrade",
+			deployed:  &helm.Release{Revision: 4, Status: release.StatusFailed},
+			wantDrift: "revision 4 is failed",
+		},
+		{
+			name:     "pending operation",
+			deployed: &helm.Release{Revision: 4, Status: release.StatusPendingUpgrade},
+		},
+		{
+			name:           "other chart version",
+			deployed:       deployed,
+			deployedValues: values,
+			desiredVersion: "v25.10.0",
+			desiredValues:  values,
+			wantDrift:      "chart version v25.3.0 is deployed instead of v25.10.0",
+		},
+		{
+			name:           "values changed by hand",
+			deployed:       deployed,
+			deployedValues: "driver:\n  version: \"580\"\n  usePrecompiled: true\n",
+			desiredValues:  values,
+			wantDrift:      "revision 3 was installed with other values",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			drift, err := compareRelease(tt.deployed, parseValues(t, tt.deployedValues), tt.desiredVersion,
+				parseValues(t, tt.desiredValues))
+			if err != nil {
+				t.Fatalf("compareRelease: %v", err)
+			}
+			if tt.wantDrift == "" && drift != "" {
+				t.Errorf("compareRelease() = %q, want no drift", drift)
+			}
+			if !strings.HasPrefix(drift, tt.wantDrift) {
+				t.Errorf("compareRelease() = %q, want %q", drift, tt.wantDrift)
+			}
+		})
+	}
+}
@@ -0,0 +1,85 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"strings"
+
+	operatorv1alpha1 "github.com/kyma-project/gpu-operator/api/v1alpha1"
+)
+
+// gardenLinuxDriverRepository serves the precompiled Garden Linux driver images, the driver repository of the Garden Linux values
+const gardenLinuxDriverRepository = "ghcr.io/gardenlinux/gardenlinux-nvidia-installer"
+
+// driverMode returns spec.driver.mode, Managed if it is not set
+func driverMode(gpuOperator *operatorv1alpha1.GpuOperator) operatorv1alpha1.DriverMode {
+	if gpuOperator.Spec.Driver == nil || gpuOperator.Spec.Driver.Mode == "" {
+		return operatorv1alpha1.DriverModeManaged
+	}
+	return gpuOperator.Spec.Driver.Mode
+}
+
+// driverImageValues returns the chart values of spec.driver: whether the driver is deployed, and the images
+// the driver DaemonSet runs
+func driverImageValues(gpuOperator *operatorv1alpha1.GpuOperator) []chartValue {
+	driver := gpuOperator.Spec.Driver
+	if driver == nil {
+		return nil
+	}
+	switch driver.Mode {
+	case operatorv1alpha1.DriverModePreinstalled:
+		return []chartValue{{path: "driver.enabled", value: false}}
+	case operatorv1alpha1.DriverModePrecompiled:
+		repository := driver.Repository
+		if repository == "" && !isPinnedImage(driver.Image) {
+			repository = gardenLinuxDriverRepository
+		}
+		return append([]chartValue{
+			{path: "driver.enabled", value: true},
+			{path: "driver.usePrecompiled", value: true},
+		}, operandImageValues("driver", repository, driver.Image)...)
+	case operatorv1alpha1.DriverModeManaged:
+		return append([]chartValue{{path: "driver.enabled", value: true}},
+			operandImageValues("driver", driver.Repository, driver.Image)...)
+	}
+	return operandImageValues("driver", driver.Repository, driver.Image)
+}
+
+// operandImageValues returns the repository and image chart values of an operand section. A pinned image
+// reference clears the repository and version, so the GPU operator uses it unchanged.
+func operandImageValues(section, repository, image string) []chartValue {
+	if isPinnedImage(image) {
+		return []chartValue{
+			{path: section + ".repository", value: ""},
+			{path: section + ".image", value: image},
+			{path: section + ".version", value: ""},
+		}
+	}
+	var values []chartValue
+	if repository != "" {
+		values = append(values, chartValue{path: section + ".repository", value: repository})
+	}
+	if image != "" {
+		values = append(values, chartValue{path: section + ".image", value: image})
+	}
+	return values
+}
+
+// isPinnedImage reports whether an image is a complete reference with a tag or digest
+func isPinnedImage(image string) bool {
+	return strings.ContainsAny(image, ":@")
+}
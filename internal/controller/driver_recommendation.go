@@ -0,0 +1,243 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+
+	operatorv1alpha1 "github.com/kyma-project/gpu-operator/api/v1alpha1"
+)
+
+const (
+	// latestDriverBranch is the newest production driver branch validated with Garden Linux
+	latestDriverBranch = "580"
+	// fallbackDriverBranch is used while no GPU node can be inspected
+	fallbackDriverBranch = "570"
+
+	// GFD labels describing the GPU model of a node
+	gpuProductLabel = "nvidia.com/gpu.product"
+	gpuFamilyLabel  = "nvidia.com/gpu.family"
+)
+
+// driverBranchSupport is the range of driver branches supporting a GPU architecture; empty means unbounded
+type driverBranchSupport struct {
+	min string
+	max string
+}
+
+// driverSupportByFamily maps the GFD gpu.family values to the driver branches supporting them.
+// The 580 branch is the last one for Maxwell, Pascal and Volta; Kepler ended with 470.
+var driverSupportByFamily = map[string]driverBranchSupport{
+	"kepler":       {max: "470"},
+	"maxwell":      {max: "580"},
+	"pascal":       {max: "580"},
+	"volta":        {max: "580"},
+	"turing":       {},
+	"ampere":       {},
+	"ada-lovelace": {},
+	"hopper":       {min: "525"},
+	"blackwell":    {min: "570"},
+}
+
+// instanceTypeFamilies maps provider machine types to GPU architectures for nodes that GFD has not
+// labeled yet, e.g. before the first installation. Entries are matched by prefix, in order.
+var instanceTypeFamilies = []struct {
+	prefix string
+	family string
+}{
+	// AWS
+	{"p2.", "kepler"},
+	{"g3.", "maxwell"},
+	{"g3s.", "maxwell"},
+	{"p3.", "volta"},
+	{"p3dn.", "volta"},
+	{"g4dn.", "turing"},
+	{"g5g.", "turing"},
+	{"g5.", "ampere"},
+	{"p4d.", "ampere"},
+	{"p4de.", "ampere"},
+	{"g6.", "ada-lovelace"},
+	{"g6e.", "ada-lovelace"},
+	{"gr6.", "ada-lovelace"},
+	{"p5.", "hopper"},
+	{"p5e.", "hopper"},
+	{"p5en.", "hopper"},
+	{"p6-b200.", "blackwell"},
+	// GCP
+	{"a2-", "ampere"},
+	{"g2-", "ada-lovelace"},
+	{"a3-", "hopper"},
+	{"a4-", "blackwell"},
+}
+
+// azureGPUModels maps the GPU model part of Azure VM sizes to architectures, matched by substring in order
+var azureGPUModels = []struct {
+	model  string
+	family string
+}{
+	{"GB200", "blackwell"},
+	{"B200", "blackwell"},
+	{"H100", "hopper"},
+	{"H200", "hopper"},
+	{"A100", "ampere"},
+	{"A10", "ampere"},
+	{"T4", "turing"},
+}
+
+// driverVersion returns the driver version to install: spec.componentVersions.driver or spec.driverVersion
+// if set, otherwise the branch recommended for the GPU models in the cluster, which is recorded in the status
+func (r *GpuOperatorReconciler) driverVersion(ctx context.Context, gpuOperator *operatorv1alpha1.GpuOperator) (string, error) {
+	if versions := gpuOperator.Spec.ComponentVersions; versions != nil && versions.Driver != "" {
+		gpuOperator.Status.DriverRecommendation = nil
+		return versions.Driver, nil
+	}
+	if gpuOperator.Spec.DriverVersion != "" {
+		gpuOperator.Status.DriverRecommendation = nil
+		return gpuOperator.Spec.DriverVersion, nil
+	}
+
+	nodes, err := r.gpuNodes(ctx)
+	if err != nil {
+		return "", err
+	}
+	recommendation := recommendDriverBranch(nodes)
+	gpuOperator.Status.DriverRecommendation = recommendation
+	return recommendation.Version, nil
+}
+
+// recommendDriverBranch selects the newest driver branch supported by every GPU model on the given nodes
+func recommendDriverBranch(nodes []corev1.Node) *operatorv1alpha1.DriverRecommendation {
+	models := map[string]string{}
+	for _, node := range nodes {
+		model, family := gpuModel(&node)
+		if model != "" {
+			models[model] = family
+		}
+	}
+	if len(models) == 0 {
+		return &operatorv1alpha1.DriverRecommendation{
+			Version: fallbackDriverBranch,
+			Reason:  "No GPU nodes detected, using the default driver branch",
+		}
+	}
+
+	recommendation := &operatorv1alpha1.DriverRecommendation{}
+	maxBranch, minBranch := latestDriverBranch, ""
+	var limitedBy, requiredBy, unknown []string
+	for model, family := range models {
+		recommendation.GPUModels = append(recommendation.GPUModels, fmt.Sprintf("%s (%s)", model, family))
+		support, known := driverSupportByFamily[family]
+		if !known {
+			unknown = append(unknown, model)
+			continue
+		}
+		if support.max != "" && compareBranches(support.max, maxBranch) < 0 {
+			maxBranch = support.max
+		}
+		if support.max != "" && support.max != latestDriverBranch {
+			limitedBy = append(limitedBy, family)
+		}
+		if support.min != "" && compareBranches(support.min, minBranch) > 0 {
+			minBranch = support.min
+			requiredBy = append(requiredBy, family)
+		}
+	}
+	sort.Strings(recommendation.GPUModels)
+	recommendation.Version = maxBranch
+
+	switch {
+	case minBranch != "" && compareBranches(minBranch, maxBranch) > 0:
+		recommendation.Reason = fmt.Sprintf("No driver branch supports all detected GPUs: %s requires at least %s, "+
+			"but %s is only supported up to %s. Use separate clusters or set spec.driverVersion",
+			strings.Join(uniqueSorted(requiredBy), ", "), minBranch, strings.Join(uniqueSorted(limitedBy), ", "), maxBranch)
+	case len(limitedBy) > 0:
+		recommendation.Reason = fmt.Sprintf("Newest driver branch supporting %s", strings.Join(uniqueSorted(limitedBy), ", "))
+	default:
+		recommendation.Reason = "Newest driver branch; all detected GPUs are supported"
+	}
+	if len(unknown) > 0 {
+		sort.Strings(unknown)
+		recommendation.Reason += fmt.Sprintf(". Unknown architecture of %s was not considered", strings.Join(unknown, ", "))
+	}
+	return recommendation
+}
+
+// gpuModel returns the GPU model and architecture of a node from the GFD labels, or from its
+// instance type if GFD has not labeled it yet
+func gpuModel(node *corev1.Node) (string, string) {
+	product, family := node.Labels[gpuProductLabel], node.Labels[gpuFamilyLabel]
+	if product != "" && family != "" {
+		return product, family
+	}
+	instanceType := node.Labels[corev1.LabelInstanceTypeStable]
+	model := product
+	if model == "" {
+		model = instanceType
+	}
+	if model == "" {
+		return "", ""
+	}
+	return model, instanceTypeFamily(instanceType)
+}
+
+// instanceTypeFamily returns the GPU architecture of a provider machine type, "unknown" if it is not known
+func instanceTypeFamily(instanceType string) string {
+	for _, entry := range instanceTypeFamilies {
+		if strings.HasPrefix(instanceType, entry.prefix) {
+			return entry.family
+		}
+	}
+	if strings.HasPrefix(instanceType, "Standard_N") {
+		for _, entry := range azureGPUModels {
+			if strings.Contains(instanceType, entry.model) {
+				return entry.family
+			}
+		}
+		// The NCv3 series carries V100 GPUs
+		if strings.HasPrefix(instanceType, "Standard_NC") && strings.HasSuffix(instanceType, "s_v3") {
+			return "volta"
+		}
+	}
+	return "unknown"
+}
+
+// compareBranches compares two numeric driver branches; an empty branch sorts first
+func compareBranches(a, b string) int {
+	av, _ := strconv.Atoi(a)
+	bv, _ := strconv.Atoi(b)
+	return av - bv
+}
+
+// uniqueSorted returns the sorted distinct values
+func uniqueSorted(values []string) []string {
+	seen := map[string]bool{}
+	var unique []string
+	for _, value := range values {
+		if !seen[value] {
+			seen[value] = true
+			unique = append(unique, value)
+		}
+	}
+	sort.Strings(unique)
+	return unique
+}
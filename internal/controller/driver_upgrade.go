@@ -0,0 +1,88 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"sort"
+
+	operatorv1alpha1 "github.com/kyma-project/gpu-operator/api/v1alpha1"
+)
+
+// driverUpgradeValues returns the chart values of spec.driver.upgrade, which configure the upgrade controller
+// of the GPU operator that rolls a driver version change through the GPU nodes
+func driverUpgradeValues(gpuOperator *operatorv1alpha1.GpuOperator) []chartValue {
+	if gpuOperator.Spec.Driver == nil || gpuOperator.Spec.Driver.Upgrade == nil {
+		return nil
+	}
+	upgrade := gpuOperator.Spec.Driver.Upgrade
+	values := []chartValue{
+		{path: "driver.upgradePolicy.autoUpgrade", value: true},
+		{path: "driver.upgradePolicy.gpuPodDeletion.force", value: upgrade.Force},
+		{path: "driver.upgradePolicy.gpuPodDeletion.deleteEmptyDir", value: upgrade.DeleteEmptyDir},
+		{path: "driver.upgradePolicy.drain.enable", value: upgrade.Drain},
+		{path: "driver.upgradePolicy.drain.force", value: upgrade.Force},
+		{path: "driver.upgradePolicy.drain.deleteEmptyDir", value: upgrade.DeleteEmptyDir},
+	}
+	if upgrade.MaxParallelUpgrades != nil {
+		values = append(values, chartValue{path: "driver.upgradePolicy.maxParallelUpgrades", value: *upgrade.MaxParallelUpgrades})
+	}
+	if upgrade.MaxUnavailable != nil {
+		// Unlike the DaemonSet update strategy, the upgrade policy takes a number or a percentage
+		values = append(values, chartValue{path: "driver.upgradePolicy.maxUnavailable", value: upgrade.MaxUnavailable})
+	}
+	if upgrade.Timeout != nil {
+		seconds := int64(upgrade.Timeout.Seconds())
+		values = append(values,
+			chartValue{path: "driver.upgradePolicy.gpuPodDeletion.timeoutSeconds", value: seconds},
+			chartValue{path: "driver.upgradePolicy.drain.timeoutSeconds", value: seconds})
+	}
+	return values
+}
+
+// driverUpgradeStatus reports the driver upgrade state of the GPU nodes tracked by the upgrade controller,
+// nil once every node is upgraded or no upgrade was started
+func (r *GpuOperatorReconciler) driverUpgradeStatus(ctx context.Context) (*operatorv1alpha1.DriverUpgradeStatus, error) {
+	nodes, err := r.gpuNodes(ctx)
+	if err != nil {
+		return nil, err
+	}
+	status := &operatorv1alpha1.DriverUpgradeStatus{}
+	for i := range nodes {
+		state := nodes[i].Labels[driverUpgradeStateLabel]
+		switch state {
+		case "":
+			continue
+		case driverUpgradeDone:
+			status.UpgradedNodes++
+		case driverUpgradeFailed:
+			status.FailedNodes++
+		default:
+			status.UpgradingNodes++
+		}
+		status.Nodes = append(status.Nodes, operatorv1alpha1.DriverUpgradeNode{
+			Name:          nodes[i].Name,
+			State:         state,
+			DriverVersion: nodes[i].Labels[driverVersionLabel],
+		})
+	}
+	if status.UpgradingNodes == 0 && status.FailedNodes == 0 {
+		return nil, nil
+	}
+	sort.Slice(status.Nodes, func(i, j int) bool { return status.Nodes[i].Name < status.Nodes[j].Name })
+	return status, nil
+}
@@ -0,0 +1,97 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	operatorv1alpha1 "github.com/kyma-project/gpu-operator/api/v1alpha1"
+)
+
+// Reasons of the Events recorded on the GpuOperator CR, so kubectl describe tells the story of an installation
+const (
+	eventNamespaceCreated   = "NamespaceCreated"
+	eventInstallSucceeded   = "InstallSucceeded"
+	eventInstallFailed      = "InstallFailed"
+	eventReconcileFailed    = "ReconciliationFailed"
+	eventReady              = "Ready"
+	eventDegraded           = "Degraded"
+	eventUninstallStarted   = "UninstallStarted"
+	eventUninstallSucceeded = "UninstallSucceeded"
+)
+
+// +kubebuilder:rbac:groups="",resources=events,verbs=create;patch
+
+// maxEventMessageBytes is the longest Event message the API server accepts
+const maxEventMessageBytes = 1024
+
+// event records an Event on the GpuOperator CR; nothing is recorded without a Recorder
+func (r *GpuOperatorReconciler) event(gpuOperator *operatorv1alpha1.GpuOperator, eventType, reason, messageFmt string, args ...interface{}) {
+	if r.Recorder == nil {
+		return
+	}
+	r.Recorder.Event(gpuOperator, eventType, reason, eventMessage(fmt.Sprintf(messageFmt, args...)))
+}
+
+// eventMessage shortens a message to maxEventMessageBytes, keeping its first line and its end, e.g. the
+// cause of a failed Helm operation
+func eventMessage(message string) string {
+	if len(message) <= maxEventMessageBytes {
+		return message
+	}
+	head, _, _ := strings.Cut(message, "\n")
+	head = head[:min(len(head), maxEventMessageBytes/2)] + "\n..."
+	return head + message[len(message)-(maxEventMessageBytes-len(head)):]
+}
+
+// failureEventReason returns the reason of the Warning Event of a reconcile failure
+func failureEventReason(err error, conditionReason string) string {
+	var installFailed *installFailedError
+	if errors.As(err, &installFailed) {
+		return eventInstallFailed
+	}
+	if conditionReason == "ReconciliationFailed" {
+		return eventReconcileFailed
+	}
+	return conditionReason
+}
+
+// installReported reports whether the installation of the current generation was already recorded in the
+// Installed condition, so InstallSucceeded is recorded once per installed spec
+func installReported(gpuOperator *operatorv1alpha1.GpuOperator) bool {
+	installed := meta.FindStatusCondition(gpuOperator.Status.Conditions, conditionTypeInstalled)
+	return installed != nil && installed.Status == metav1.ConditionTrue && installed.ObservedGeneration == gpuOperator.Generation
+}
+
+// recordStateEvent records the transitions to Ready and Warning
+func (r *GpuOperatorReconciler) recordStateEvent(gpuOperator *operatorv1alpha1.GpuOperator, previous operatorv1alpha1.State, message string) {
+	if gpuOperator.Status.State == previous {
+		return
+	}
+	switch gpuOperator.Status.State {
+	case operatorv1alpha1.StateReady:
+		r.event(gpuOperator, corev1.EventTypeNormal, eventReady, "%s", message)
+	case operatorv1alpha1.StateWarning:
+		r.event(gpuOperator, corev1.EventTypeWarning, eventDegraded, "%s", message)
+	}
+}
@@ -0,0 +1,125 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"fmt"
+	"strings"
+
+	operatorv1alpha1 "github.com/kyma-project/gpu-operator/api/v1alpha1"
+	"github.com/kyma-project/gpu-operator/internal/images"
+)
+
+// fipsMode reports whether spec.fipsMode is set
+func fipsMode(gpuOperator *operatorv1alpha1.GpuOperator) bool {
+	return gpuOperator.Spec.FIPSMode
+}
+
+// fipsComponents returns the components deployed for the CR that need a FIPS-validated image
+func fipsComponents(gpuOperator *operatorv1alpha1.GpuOperator) []string {
+	components := []string{"operator"}
+	if driverMode(gpuOperator) != operatorv1alpha1.DriverModePreinstalled {
+		components = append(components, "driver")
+	}
+	components = append(components, "toolkit")
+	if !draEnabled(gpuOperator) {
+		components = append(components, "devicePlugin")
+	}
+	components = append(components, "gfd", "nodeFeatureDiscovery")
+	if monitoring := gpuOperator.Spec.Monitoring; monitoring != nil && monitoring.DCGM != nil && monitoring.DCGM.Standalone {
+		components = append(components, "dcgm")
+	}
+	return append(components, "dcgmExporter", "migManager", "validator")
+}
+
+// checkFIPSImages fails if spec.fipsMode is set and a deployed component has no FIPS image in the controller
+// configuration. The node feature discovery chart only takes a tag, so its image must not be pinned by digest.
+func (r *GpuOperatorReconciler) checkFIPSImages(gpuOperator *operatorv1alpha1.GpuOperator) error {
+	if !fipsMode(gpuOperator) {
+		return nil
+	}
+	fipsImages := r.Config.Get().FIPSImages
+	var missing []string
+	for _, component := range fipsComponents(gpuOperator) {
+		image := fipsImages[component]
+		if image == "" {
+			missing = append(missing, component)
+			continue
+		}
+		if !isPinnedImage(image) {
+			return fmt.Errorf("spec.fipsMode: the %s FIPS image %s must be a complete reference with a tag or digest",
+				component, image)
+		}
+	}
+	if len(missing) > 0 {
+		return fmt.Errorf("spec.fipsMode: no FIPS-validated image available for %s; add them to fipsImages "+
+			"of the controller configuration or disable the components", strings.Join(missing, ", "))
+	}
+	if _, _, ok := splitImageTag(fipsImages["nodeFeatureDiscovery"]); !ok {
+		return fmt.Errorf("spec.fipsMode: the nodeFeatureDiscovery FIPS image %s must have a tag and no digest",
+			fipsImages["nodeFeatureDiscovery"])
+	}
+	return nil
+}
+
+// fipsValues returns the chart values deploying the FIPS images of the operands. They are applied last and
+// take precedence over spec.driver, spec.validator and spec.componentVersions.
+func (r *GpuOperatorReconciler) fipsValues(gpuOperator *operatorv1alpha1.GpuOperator) []chartValue {
+	if !fipsMode(gpuOperator) {
+		return nil
+	}
+	fipsImages := r.Config.Get().FIPSImages
+	var values []chartValue
+	for _, component := range fipsComponents(gpuOperator) {
+		image := fipsImages[component]
+		switch component {
+		case "nodeFeatureDiscovery":
+			if repository, tag, ok := splitImageTag(image); ok {
+				values = append(values,
+					chartValue{path: "node-feature-discovery.image.repository", value: repository, chartOnly: true},
+					chartValue{path: "node-feature-discovery.image.tag", value: tag, chartOnly: true})
+			}
+		case "operator":
+			// The operator Deployment is not part of the ClusterPolicy
+			for _, value := range operandImageValues(component, "", image) {
+				value.chartOnly = true
+				values = append(values, value)
+			}
+		default:
+			values = append(values, operandImageValues(component, "", image)...)
+		}
+	}
+	return values
+}
+
+// fipsHelperImage returns the FIPS image of a helper component, mirrored like the other helper images,
+// empty if spec.fipsMode is not set
+func (r *GpuOperatorReconciler) fipsHelperImage(gpuOperator *operatorv1alpha1.GpuOperator, component string) string {
+	if !fipsMode(gpuOperator) || r.Config.Get().FIPSImages[component] == "" {
+		return ""
+	}
+	return images.Mirror(r.imageMirror(gpuOperator), r.Config.Get().FIPSImages[component])
+}
+
+// splitImageTag splits an image reference with a tag into repository and tag
+func splitImageTag(image string) (string, string, bool) {
+	i := strings.LastIndex(image, ":")
+	if i < 0 || strings.Contains(image, "@") || strings.Contains(image[i:], "/") {
+		return "", "", false
+	}
+	return image[:i], image[i+1:], true
+}
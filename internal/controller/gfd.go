@@ -0,0 +1,142 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	operatorv1alpha1 "github.com/kyma-project/gpu-operator/api/v1alpha1"
+)
+
+const (
+	gfdExtraLabelsRuleName = "gpu-operator-gfd-extra-labels"
+
+	// GFD writes its labels into an NFD feature file, which exposes them to rules as local.label features
+	gfdLabelFeature  = "local.label"
+	nvidiaPCIVendor  = "10de"
+	pciDeviceFeature = "pci.device"
+)
+
+var nodeFeatureRuleGVK = schema.GroupVersionKind{
+	Group:   "nfd.k8s-sigs.io",
+	Version: "v1alpha1",
+	Kind:    "NodeFeatureRule",
+}
+
+// ensureGFDLabelRules renders spec.gfd.extraLabelRules into a NodeFeatureRule so the custom labels
+// are published alongside the standard GFD labels. The rule is removed when no extra rules are configured.
+func (r *GpuOperatorReconciler) ensureGFDLabelRules(ctx context.Context, gpuOperator *operatorv1alpha1.GpuOperator) error {
+	var rules []operatorv1alpha1.GFDLabelRule
+	if gpuOperator.Spec.GFD != nil {
+		rules = gpuOperator.Spec.GFD.ExtraLabelRules
+	}
+	if len(rules) == 0 {
+		return r.deleteGFDLabelRules(ctx)
+	}
+
+	nodeFeatureRule := &unstructured.Unstructured{}
+	nodeFeatureRule.SetGroupVersionKind(nodeFeatureRuleGVK)
+	nodeFeatureRule.SetName(gfdExtraLabelsRuleName)
+
+	result, err := controllerutil.CreateOrUpdate(ctx, r.Client, nodeFeatureRule, func() error {
+		nodeFeatureRule.SetLabels(map[string]string{
+			"app.kubernetes.io/name":       "gpu-operator",
+			"app.kubernetes.io/managed-by": "gpu-operator-module",
+			"app.kubernetes.io/component":  "gfd",
+		})
+		return unstructured.SetNestedSlice(nodeFeatureRule.Object, renderNodeFeatureRules(rules), "spec", "rules")
+	})
+	if err != nil {
+		if meta.IsNoMatchError(err) {
+			return fmt.Errorf("NodeFeatureRule CRD not found, Node Feature Discovery is required for GFD extra label rules: %w", err)
+		}
+		return fmt.Errorf("failed to reconcile NodeFeatureRule %s: %w", gfdExtraLabelsRuleName, err)
+	}
+
+	if result != controllerutil.OperationResultNone {
+		log.FromContext(ctx).Info("Reconciled GFD extra label rules", "nodeFeatureRule", gfdExtraLabelsRuleName,
+			"rules", len(rules), "operation", result)
+	}
+	return nil
+}
+
+// deleteGFDLabelRules removes the NodeFeatureRule created for GFD extra label rules, if any
+func (r *GpuOperatorReconciler) deleteGFDLabelRules(ctx context.Context) error {
+	nodeFeatureRule := &unstructured.Unstructured{}
+	nodeFeatureRule.SetGroupVersionKind(nodeFeatureRuleGVK)
+	nodeFeatureRule.SetName(gfdExtraLabelsRuleName)
+
+	if err := r.Delete(ctx, nodeFeatureRule); err != nil && !apierrors.IsNotFound(err) && !meta.IsNoMatchError(err) {
+		return fmt.Errorf("failed to delete NodeFeatureRule %s: %w", gfdExtraLabelsRuleName, err)
+	}
+	return nil
+}
+
+// renderNodeFeatureRules converts GFD label rules into the NodeFeatureRule spec.rules format
+func renderNodeFeatureRules(rules []operatorv1alpha1.GFDLabelRule) []interface{} {
+	rendered := make([]interface{}, 0, len(rules))
+	for _, rule := range rules {
+		labels := make(map[string]interface{}, len(rule.Labels))
+		for key, value := range rule.Labels {
+			labels[key] = value
+		}
+
+		// Without expressions the rule applies to every node with an NVIDIA PCI device
+		matchFeature := map[string]interface{}{
+			"feature": pciDeviceFeature,
+			"matchExpressions": map[string]interface{}{
+				"vendor": map[string]interface{}{
+					"op":    "In",
+					"value": []interface{}{nvidiaPCIVendor},
+				},
+			},
+		}
+		if len(rule.MatchExpressions) > 0 {
+			expressions := make(map[string]interface{}, len(rule.MatchExpressions))
+			for _, expr := range rule.MatchExpressions {
+				values := make([]interface{}, 0, len(expr.Values))
+				for _, value := range expr.Values {
+					values = append(values, value)
+				}
+				expression := map[string]interface{}{"op": expr.Operator}
+				if len(values) > 0 {
+					expression["value"] = values
+				}
+				expressions[expr.Key] = expression
+			}
+			matchFeature = map[string]interface{}{
+				"feature":          gfdLabelFeature,
+				"matchExpressions": expressions,
+			}
+		}
+
+		rendered = append(rendered, map[string]interface{}{
+			"name":          rule.Name,
+			"labels":        labels,
+			"matchFeatures": []interface{}{matchFeature},
+		})
+	}
+	return rendered
+}
@@ -0,0 +1,102 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	operatorv1alpha1 "github.com/kyma-project/gpu-operator/api/v1alpha1"
+)
+
+const (
+	// maxAllocationNamespaces limits the namespaces listed in status.gpuAllocation
+	maxAllocationNamespaces = 20
+
+	// gpuAllocationInterval is how often status.gpuAllocation is refreshed while nothing else requeues
+	gpuAllocationInterval = 5 * time.Minute
+)
+
+// gpuAllocation sums the nvidia.com/gpu allocated to the scheduled pods that have not terminated, per namespace,
+// and updates the allocation metric
+func (r *GpuOperatorReconciler) gpuAllocation(ctx context.Context) (*operatorv1alpha1.GPUAllocation, error) {
+	pods := &corev1.PodList{}
+	if err := r.List(ctx, pods); err != nil {
+		return nil, fmt.Errorf("failed to list pods: %w", err)
+	}
+	perNamespace := map[string]*operatorv1alpha1.NamespaceGPUAllocation{}
+	allocation := &operatorv1alpha1.GPUAllocation{Time: metav1.Now()}
+	for i := range pods.Items {
+		pod := &pods.Items[i]
+		if pod.Spec.NodeName == "" || pod.Status.Phase == corev1.PodSucceeded || pod.Status.Phase == corev1.PodFailed {
+			continue
+		}
+		gpus := podGPURequest(pod)
+		if gpus == 0 {
+			continue
+		}
+		namespace, ok := perNamespace[pod.Namespace]
+		if !ok {
+			namespace = &operatorv1alpha1.NamespaceGPUAllocation{Namespace: pod.Namespace}
+			perNamespace[pod.Namespace] = namespace
+		}
+		namespace.GPUs += gpus
+		namespace.Pods++
+		allocation.GPUs += gpus
+	}
+
+	allocatedGPUsGauge.Reset()
+	for _, namespace := range perNamespace {
+		allocatedGPUsGauge.WithLabelValues(namespace.Namespace).Set(float64(namespace.GPUs))
+		allocation.Namespaces = append(allocation.Namespaces, *namespace)
+	}
+	sort.Slice(allocation.Namespaces, func(i, j int) bool {
+		a, b := allocation.Namespaces[i], allocation.Namespaces[j]
+		return a.GPUs > b.GPUs || a.GPUs == b.GPUs && a.Namespace < b.Namespace
+	})
+	if len(allocation.Namespaces) > maxAllocationNamespaces {
+		allocation.Namespaces = allocation.Namespaces[:maxAllocationNamespaces]
+	}
+	return allocation, nil
+}
+
+// podGPURequest returns the nvidia.com/gpu the scheduler allocates to a pod: the sum over its containers,
+// or the largest init container request if that is higher
+func podGPURequest(pod *corev1.Pod) int64 {
+	request := func(container corev1.Container) int64 {
+		if quantity, ok := container.Resources.Limits[gpuResourceName]; ok {
+			return quantity.Value()
+		}
+		quantity := container.Resources.Requests[gpuResourceName]
+		return quantity.Value()
+	}
+	var sum, maxInit int64
+	for _, container := range pod.Spec.Containers {
+		sum += request(container)
+	}
+	for _, container := range pod.Spec.InitContainers {
+		if gpus := request(container); gpus > maxInit {
+			maxInit = gpus
+		}
+	}
+	return max(sum, maxInit)
+}
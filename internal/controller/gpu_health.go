@@ -0,0 +1,356 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/prometheus/common/expfmt"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	operatorv1alpha1 "github.com/kyma-project/gpu-operator/api/v1alpha1"
+	"github.com/kyma-project/gpu-operator/internal/logging"
+)
+
+const (
+	conditionTypeGPUHealthy = "GPUHealthy"
+
+	// gpuUnhealthyLabel marks the GPU nodes with unhealthy GPUs
+	gpuUnhealthyLabel = "operator.kyma-project.io/gpu-unhealthy"
+	// healthCordonAnnotation marks the nodes the controller cordoned, the only ones it uncordons
+	healthCordonAnnotation = "operator.kyma-project.io/cordoned-for-gpu-health"
+
+	// driverApp is the app label of the driver pods
+	driverApp = "nvidia-driver-daemonset"
+	// driverRestartInterval is the minimum age of a crash looping driver pod before it is restarted
+	driverRestartInterval = 10 * time.Minute
+
+	// dcgmExporterMetricsPort is the port DCGM Exporter serves its metrics on
+	dcgmExporterMetricsPort = 9400
+	dcgmScrapeTimeout       = 5 * time.Second
+	xidMetric               = "DCGM_FI_DEV_XID_ERRORS"
+)
+
+// applicationXIDs are the XID errors caused by applications, which leave the GPU healthy. The device plugin
+// withdraws a GPU on any other XID error.
+var applicationXIDs = map[int]bool{13: true, 31: true, 43: true, 45: true, 68: true, 109: true}
+
+// +kubebuilder:rbac:groups="",resources=pods,verbs=get;list;watch;delete
+
+// reconcileGPUHealth reports the GPU nodes with unhealthy GPUs if spec.healthCheck is enabled, labels them
+// and cordons them if requested, and restarts crash looping driver pods. Nodes the controller marked are
+// restored once their GPUs are healthy again or the health check is disabled.
+func (r *GpuOperatorReconciler) reconcileGPUHealth(ctx context.Context, gpuOperator *operatorv1alpha1.GpuOperator,
+	namespace string) (*operatorv1alpha1.GPUHealthStatus, error) {
+	spec := gpuOperator.Spec.HealthCheck
+	if spec == nil || !spec.Enabled {
+		return nil, r.markUnhealthyNodes(ctx, gpuOperator, nil, false)
+	}
+
+	nodes, err := r.gpuNodes(ctx)
+	if err != nil {
+		return nil, err
+	}
+	unhealthy := map[string]*operatorv1alpha1.UnhealthyGPUNode{}
+	for i := range nodes {
+		node := &nodes[i]
+		// The device plugin withdraws all GPUs while the driver of the node is upgraded
+		if state := node.Labels[driverUpgradeStateLabel]; state != "" && state != driverUpgradeDone {
+			continue
+		}
+		capacity := node.Status.Capacity[gpuResourceName]
+		withdrawn := capacity.Value() - allocatableGPUs(node)
+		if withdrawn > 0 {
+			unhealthy[node.Name] = &operatorv1alpha1.UnhealthyGPUNode{
+				Name:          node.Name,
+				UnhealthyGPUs: withdrawn,
+				Reason:        fmt.Sprintf("device plugin withdrew %d of %d GPUs", withdrawn, capacity.Value()),
+			}
+		}
+	}
+	if spec.Source == operatorv1alpha1.HealthSourceDCGMExporter {
+		if err := r.dcgmXIDErrors(ctx, namespace, unhealthy); err != nil {
+			// The device plugin still reports the withdrawn GPUs
+			log.FromContext(ctx).WithName(logging.SubsystemHealth).Error(err, "Failed to read XID errors from DCGM Exporter")
+		}
+	}
+
+	previous := map[string]operatorv1alpha1.UnhealthyGPUNode{}
+	if gpuOperator.Status.GPUHealth != nil {
+		for _, node := range gpuOperator.Status.GPUHealth.Nodes {
+			previous[node.Name] = node
+		}
+	}
+	health := &operatorv1alpha1.GPUHealthStatus{}
+	for name, node := range unhealthy {
+		if last, ok := previous[name]; ok {
+			node.Since = last.Since
+		} else {
+			node.Since = metav1.Now()
+			r.event(gpuOperator, corev1.EventTypeWarning, "GPUUnhealthy", "Node %s: %s", name, node.Reason)
+		}
+		health.UnhealthyGPUs += node.UnhealthyGPUs
+		health.Nodes = append(health.Nodes, *node)
+	}
+	sort.Slice(health.Nodes, func(i, j int) bool { return health.Nodes[i].Name < health.Nodes[j].Name })
+
+	if err := r.markUnhealthyNodes(ctx, gpuOperator, health, spec.CordonUnhealthyNodes); err != nil {
+		return nil, err
+	}
+	if spec.RestartCrashedDriverPods {
+		if health.RestartedDriverPods, err = r.restartCrashedDriverPods(ctx, namespace); err != nil {
+			return nil, err
+		}
+	}
+	return health, nil
+}
+
+// dcgmXIDErrors adds the GPUs for which DCGM Exporter reports a critical XID error to the unhealthy nodes.
+// The exporter pods are scraped directly, which requires the controller to reach the pod network of the
+// cluster it manages, so nothing is read for a remote cluster.
+func (r *GpuOperatorReconciler) dcgmXIDErrors(ctx context.Context, namespace string,
+	unhealthy map[string]*operatorv1alpha1.UnhealthyGPUNode) error {
+	if r.remote {
+		return nil
+	}
+	pods := &corev1.PodList{}
+	if err := r.List(ctx, pods, client.InNamespace(namespace), client.MatchingLabels{"app": dcgmExporterApp}); err != nil {
+		return fmt.Errorf("failed to list DCGM Exporter pods: %w", err)
+	}
+	httpClient := &http.Client{Timeout: dcgmScrapeTimeout}
+	var errs []string
+	for i := range pods.Items {
+		pod := &pods.Items[i]
+		if pod.Spec.NodeName == "" || pod.Status.PodIP == "" || pod.Status.Phase != corev1.PodRunning {
+			continue
+		}
+		xids, err := scrapeXIDErrors(ctx, httpClient, pod.Status.PodIP)
+		if err != nil {
+			errs = append(errs, fmt.Sprintf("%s: %v", pod.Name, err))
+			continue
+		}
+		if len(xids) == 0 {
+			continue
+		}
+		gpus := make([]string, 0, len(xids))
+		for gpu, xid := range xids {
+			gpus = append(gpus, fmt.Sprintf("XID %d on GPU %s", xid, gpu))
+		}
+		sort.Strings(gpus)
+		node, ok := unhealthy[pod.Spec.NodeName]
+		if !ok {
+			node = &operatorv1alpha1.UnhealthyGPUNode{Name: pod.Spec.NodeName}
+			unhealthy[pod.Spec.NodeName] = node
+		}
+		node.UnhealthyGPUs = max(node.UnhealthyGPUs, int64(len(xids)))
+		reasons := append([]string{}, gpus...)
+		if node.Reason != "" {
+			reasons = append([]string{node.Reason}, reasons...)
+		}
+		node.Reason = strings.Join(reasons, ", ")
+	}
+	if len(errs) > 0 {
+		return fmt.Errorf("failed to scrape DCGM Exporter: %s", strings.Join(errs, "; "))
+	}
+	return nil
+}
+
+// scrapeXIDErrors returns the last critical XID error per GPU index from the metrics of a DCGM Exporter pod
+func scrapeXIDErrors(ctx context.Context, httpClient *http.Client, podIP string) (map[string]int, error) {
+	url := "http://" + net.JoinHostPort(podIP, strconv.Itoa(dcgmExporterMetricsPort)) + "/metrics"
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	response, err := httpClient.Do(request)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+	if response.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status %s", response.Status)
+	}
+	var parser expfmt.TextParser
+	families, err := parser.TextToMetricFamilies(response.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse metrics: %w", err)
+	}
+	xids := map[string]int{}
+	family, ok := families[xidMetric]
+	if !ok {
+		return xids, nil
+	}
+	for _, metric := range family.GetMetric() {
+		var value float64
+		switch {
+		case metric.GetGauge() != nil:
+			value = metric.GetGauge().GetValue()
+		case metric.GetCounter() != nil:
+			value = metric.GetCounter().GetValue()
+		case metric.GetUntyped() != nil:
+			value = metric.GetUntyped().GetValue()
+		}
+		xid := int(value)
+		if xid == 0 || applicationXIDs[xid] {
+			continue
+		}
+		gpu := ""
+		for _, label := range metric.GetLabel() {
+			if label.GetName() == "gpu" {
+				gpu = label.GetValue()
+			}
+		}
+		xids[gpu] = xid
+	}
+	return xids, nil
+}
+
+// markUnhealthyNodes labels the nodes with unhealthy GPUs and cordons them if requested. The nodes that are
+// healthy again, or all nodes if health is nil, get their label removed and are uncordoned if the controller
+// cordoned them. The Cordoned field of the reported nodes is set accordingly.
+func (r *GpuOperatorReconciler) markUnhealthyNodes(ctx context.Context, gpuOperator *operatorv1alpha1.GpuOperator,
+	health *operatorv1alpha1.GPUHealthStatus, cordon bool) error {
+	logger := log.FromContext(ctx).WithName(logging.SubsystemHealth)
+	unhealthy := map[string]*operatorv1alpha1.UnhealthyGPUNode{}
+	if health != nil {
+		for i := range health.Nodes {
+			unhealthy[health.Nodes[i].Name] = &health.Nodes[i]
+		}
+	}
+
+	nodes := &corev1.NodeList{}
+	if err := r.List(ctx, nodes); err != nil {
+		return fmt.Errorf("failed to list nodes: %w", err)
+	}
+	for i := range nodes.Items {
+		node := &nodes.Items[i]
+		report, isUnhealthy := unhealthy[node.Name]
+		_, labeled := node.Labels[gpuUnhealthyLabel]
+		_, cordoned := node.Annotations[healthCordonAnnotation]
+		if !isUnhealthy && !labeled && !cordoned {
+			continue
+		}
+
+		original := node.DeepCopy()
+		patch := client.MergeFrom(original)
+		if isUnhealthy {
+			if node.Labels == nil {
+				node.Labels = map[string]string{}
+			}
+			node.Labels[gpuUnhealthyLabel] = "true"
+		} else {
+			delete(node.Labels, gpuUnhealthyLabel)
+		}
+		switch {
+		case isUnhealthy && cordon && !node.Spec.Unschedulable:
+			// Nodes cordoned by someone else are left to them
+			if node.Annotations == nil {
+				node.Annotations = map[string]string{}
+			}
+			node.Annotations[healthCordonAnnotation] = "true"
+			node.Spec.Unschedulable = true
+			r.event(gpuOperator, corev1.EventTypeWarning, "NodeCordoned", "Cordoned node %s with unhealthy GPUs", node.Name)
+		case cordoned && (!isUnhealthy || !cordon):
+			delete(node.Annotations, healthCordonAnnotation)
+			node.Spec.Unschedulable = false
+			logger.Info("Uncordoning node", "node", node.Name)
+		}
+		if isUnhealthy {
+			_, report.Cordoned = node.Annotations[healthCordonAnnotation]
+		}
+		if node.Labels[gpuUnhealthyLabel] == original.Labels[gpuUnhealthyLabel] &&
+			node.Annotations[healthCordonAnnotation] == original.Annotations[healthCordonAnnotation] &&
+			node.Spec.Unschedulable == original.Spec.Unschedulable {
+			continue
+		}
+		if err := r.Patch(ctx, node, patch); err != nil {
+			return fmt.Errorf("failed to mark GPU health of node %s: %w", node.Name, err)
+		}
+	}
+	return nil
+}
+
+// restartCrashedDriverPods deletes the driver pods in CrashLoopBackOff that are older than driverRestartInterval
+// and returns their names
+func (r *GpuOperatorReconciler) restartCrashedDriverPods(ctx context.Context, namespace string) ([]string, error) {
+	pods := &corev1.PodList{}
+	if err := r.List(ctx, pods, client.InNamespace(namespace), client.MatchingLabels{"app": driverApp}); err != nil {
+		return nil, fmt.Errorf("failed to list driver pods: %w", err)
+	}
+	var restarted []string
+	for i := range pods.Items {
+		pod := &pods.Items[i]
+		if pod.DeletionTimestamp != nil || time.Since(pod.CreationTimestamp.Time) < driverRestartInterval || !crashLooping(pod) {
+			continue
+		}
+		if err := r.Delete(ctx, pod); err != nil && !apierrors.IsNotFound(err) {
+			return nil, fmt.Errorf("failed to restart driver pod %s: %w", pod.Name, err)
+		}
+		log.FromContext(ctx).WithName(logging.SubsystemHealth).Info("Restarted crash looping driver pod",
+			"pod", pod.Name, "node", pod.Spec.NodeName)
+		restarted = append(restarted, pod.Name)
+	}
+	return restarted, nil
+}
+
+// crashLooping reports whether a container of the pod waits in CrashLoopBackOff
+func crashLooping(pod *corev1.Pod) bool {
+	for _, statuses := range [][]corev1.ContainerStatus{pod.Status.InitContainerStatuses, pod.Status.ContainerStatuses} {
+		for _, status := range statuses {
+			if status.State.Waiting != nil && status.State.Waiting.Reason == "CrashLoopBackOff" {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// gpuHealthCondition reports the GPU health in the GPUHealthy condition, nil if the health check is disabled
+func gpuHealthCondition(gpuOperator *operatorv1alpha1.GpuOperator) *metav1.Condition {
+	health := gpuOperator.Status.GPUHealth
+	if health == nil {
+		return nil
+	}
+	condition := &metav1.Condition{
+		Type:               conditionTypeGPUHealthy,
+		Status:             metav1.ConditionTrue,
+		Reason:             "GPUsHealthy",
+		Message:            "All GPUs are healthy",
+		ObservedGeneration: gpuOperator.Generation,
+	}
+	if len(health.Nodes) > 0 {
+		names := make([]string, 0, len(health.Nodes))
+		for _, node := range health.Nodes {
+			names = append(names, node.Name)
+		}
+		condition.Status = metav1.ConditionFalse
+		condition.Reason = "UnhealthyGPUs"
+		condition.Message = fmt.Sprintf("%d GPUs unhealthy on %d nodes (%s)", health.UnhealthyGPUs, len(health.Nodes), namedNodes(names))
+	}
+	return condition
+}
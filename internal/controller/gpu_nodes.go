@@ -0,0 +1,180 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	operatorv1alpha1 "github.com/kyma-project/gpu-operator/api/v1alpha1"
+)
+
+// kernelVersionLabel is set by NFD to the full kernel version of a node
+const kernelVersionLabel = "feature.node.kubernetes.io/kernel-version.full"
+
+// GFD labels describing the GPUs of a node
+const (
+	gpuMemoryLabel       = "nvidia.com/gpu.memory"
+	gpuComputeMajorLabel = "nvidia.com/gpu.compute.major"
+	gpuComputeMinorLabel = "nvidia.com/gpu.compute.minor"
+	migCapableLabel      = "nvidia.com/mig.capable"
+)
+
+// gpuNodeInventory summarizes the GPU nodes, their allocatable GPUs and their driver and kernel versions.
+// A node's driver works if the operator validator passed its driver validation, or, where the validator
+// did not run, if GFD could read the driver version.
+func (r *GpuOperatorReconciler) gpuNodeInventory(ctx context.Context, nodeValidations []operatorv1alpha1.NodeStatus) (*operatorv1alpha1.GPUNodeInventory, error) {
+	nodes, err := r.gpuNodes(ctx)
+	if err != nil {
+		return nil, err
+	}
+	validations := make(map[string]operatorv1alpha1.NodeValidations, len(nodeValidations))
+	for _, node := range nodeValidations {
+		validations[node.Name] = node.Validations
+	}
+
+	inventory := &operatorv1alpha1.GPUNodeInventory{Nodes: make([]operatorv1alpha1.GPUNode, 0, len(nodes))}
+	gpuNodesGauge.Reset()
+	allocatableGPUsGauge.Reset()
+	for i := range nodes {
+		node := &nodes[i]
+		gpuNode := operatorv1alpha1.GPUNode{
+			Name:            node.Name,
+			AllocatableGPUs: allocatableGPUs(node),
+			DriverVersion:   node.Labels[driverVersionLabel],
+			KernelVersion:   node.Labels[kernelVersionLabel],
+		}
+		if gpuNode.KernelVersion == "" {
+			gpuNode.KernelVersion = node.Status.NodeInfo.KernelVersion
+		}
+		switch validations[node.Name].Driver {
+		case operatorv1alpha1.ValidationPassed:
+			gpuNode.DriverReady = true
+		case "":
+			gpuNode.DriverReady = gpuNode.DriverVersion != ""
+		}
+
+		inventory.Count++
+		inventory.AllocatableGPUs += gpuNode.AllocatableGPUs
+		pool := node.Labels[gardenerPoolLabel]
+		gpuNodesGauge.WithLabelValues(pool).Inc()
+		allocatableGPUsGauge.WithLabelValues(pool).Add(float64(gpuNode.AllocatableGPUs))
+		if gpuNode.DriverReady {
+			inventory.DriverReadyNodes++
+		}
+		inventory.Nodes = append(inventory.Nodes, gpuNode)
+	}
+	sort.Slice(inventory.Nodes, func(i, j int) bool { return inventory.Nodes[i].Name < inventory.Nodes[j].Name })
+	return inventory, nil
+}
+
+// gpuInventory groups the GPU nodes by the GPU model GFD labeled them with. Nodes GFD has not labeled yet
+// are left out.
+func (r *GpuOperatorReconciler) gpuInventory(ctx context.Context) ([]operatorv1alpha1.GPUModelInventory, error) {
+	nodes, err := r.gpuNodes(ctx)
+	if err != nil {
+		return nil, err
+	}
+	models := map[string]*operatorv1alpha1.GPUModelInventory{}
+	for i := range nodes {
+		node := &nodes[i]
+		product := baseGPUProduct(node.Labels[gpuProductLabel])
+		if product == "" {
+			continue
+		}
+		model, ok := models[product]
+		if !ok {
+			model = &operatorv1alpha1.GPUModelInventory{Product: product}
+			models[product] = model
+		}
+		// The labels of the nodes of one model agree, except while GFD has not finished labeling a node
+		if model.Family == "" {
+			model.Family = node.Labels[gpuFamilyLabel]
+		}
+		if memory, err := strconv.ParseInt(node.Labels[gpuMemoryLabel], 10, 64); err == nil && model.MemoryMiB == 0 {
+			model.MemoryMiB = memory
+		}
+		if major := node.Labels[gpuComputeMajorLabel]; major != "" && model.ComputeCapability == "" {
+			model.ComputeCapability = major + "." + node.Labels[gpuComputeMinorLabel]
+		}
+		model.MIGCapable = model.MIGCapable || node.Labels[migCapableLabel] == "true"
+		model.Nodes++
+		if count, err := strconv.ParseInt(node.Labels[gpuCountLabel], 10, 64); err == nil {
+			model.GPUs += count
+		}
+		model.AllocatableGPUs += allocatableGPUs(node)
+		if pool := node.Labels[gardenerPoolLabel]; pool != "" && !slices.Contains(model.Pools, pool) {
+			model.Pools = append(model.Pools, pool)
+		}
+	}
+
+	inventory := make([]operatorv1alpha1.GPUModelInventory, 0, len(models))
+	for _, model := range models {
+		sort.Strings(model.Pools)
+		inventory = append(inventory, *model)
+	}
+	sort.Slice(inventory, func(i, j int) bool { return inventory[i].Product < inventory[j].Product })
+	return inventory, nil
+}
+
+// baseGPUProduct returns the GPU model of a GFD product label without the suffixes GFD appends for the single MIG
+// strategy and for shared GPUs, e.g. NVIDIA-A100-SXM4-40GB for NVIDIA-A100-SXM4-40GB-MIG-1g.5gb
+func baseGPUProduct(product string) string {
+	if i := strings.Index(product, "-MIG-"); i >= 0 {
+		product = product[:i]
+	}
+	return strings.TrimSuffix(product, "-SHARED")
+}
+
+// allocatableGPUs returns the nvidia.com/gpu the node advertises to the scheduler
+func allocatableGPUs(node *corev1.Node) int64 {
+	quantity, ok := node.Status.Allocatable[gpuResourceName]
+	if !ok {
+		return 0
+	}
+	return quantity.Value()
+}
+
+// gpuNodeCapacityChangedPredicate passes the removal of GPU nodes and the updates that change their allocatable
+// GPUs or kernel, which the GPU node inventory reports
+var gpuNodeCapacityChangedPredicate = predicate.Funcs{
+	CreateFunc: func(event.CreateEvent) bool { return false },
+	UpdateFunc: func(e event.UpdateEvent) bool {
+		oldNode, ok := e.ObjectOld.(*corev1.Node)
+		if !ok {
+			return false
+		}
+		newNode, ok := e.ObjectNew.(*corev1.Node)
+		if !ok || !isGPUNode(newNode) {
+			return false
+		}
+		return allocatableGPUs(oldNode) != allocatableGPUs(newNode) ||
+			oldNode.Status.NodeInfo.KernelVersion != newNode.Status.NodeInfo.KernelVersion
+	},
+	DeleteFunc: func(e event.DeleteEvent) bool {
+		node, ok := e.Object.(*corev1.Node)
+		return ok && isGPUNode(node)
+	},
+	GenericFunc: func(event.GenericEvent) bool { return false },
+}
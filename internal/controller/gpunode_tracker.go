@@ -0,0 +1,98 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	"github.com/kyma-project/gpu-operator/internal/logging"
+)
+
+// GPUNodeTracker measures the time from a GPU node becoming Ready until the device plugin advertises
+// its GPUs. Only nodes seen without allocatable GPUs are measured, so a restart of the controller
+// does not record the already initialized nodes.
+type GPUNodeTracker struct {
+	client.Client
+
+	mu      sync.Mutex
+	pending map[string]bool
+}
+
+// Reconcile records the measurement once a pending node advertises allocatable GPUs
+func (t *GPUNodeTracker) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.pending == nil {
+		t.pending = map[string]bool{}
+	}
+
+	node := &corev1.Node{}
+	if err := t.Get(ctx, req.NamespacedName, node); err != nil {
+		if apierrors.IsNotFound(err) {
+			delete(t.pending, req.Name)
+			return ctrl.Result{}, nil
+		}
+		return ctrl.Result{}, err
+	}
+
+	allocatable := node.Status.Allocatable[gpuResourceName]
+	if allocatable.IsZero() {
+		t.pending[node.Name] = true
+		return ctrl.Result{}, nil
+	}
+	if !t.pending[node.Name] {
+		return ctrl.Result{}, nil
+	}
+
+	var readySince time.Time
+	for _, condition := range node.Status.Conditions {
+		if condition.Type == corev1.NodeReady && condition.Status == corev1.ConditionTrue {
+			readySince = condition.LastTransitionTime.Time
+		}
+	}
+	if readySince.IsZero() {
+		return ctrl.Result{}, nil
+	}
+	delete(t.pending, node.Name)
+
+	pool := node.Labels[gardenerPoolLabel]
+	elapsed := time.Since(readySince)
+	gpuNodeTimeToAllocatable.WithLabelValues(pool).Observe(elapsed.Seconds())
+	log.FromContext(ctx).WithName(logging.SubsystemHealth).Info("GPU node became allocatable",
+		"node", node.Name, "pool", pool, "sinceReady", elapsed.Round(time.Second).String())
+	return ctrl.Result{}, nil
+}
+
+// SetupWithManager watches the nodes with an NVIDIA PCI device
+func (t *GPUNodeTracker) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		Named("gpunode").
+		For(&corev1.Node{}, builder.WithPredicates(predicate.NewPredicateFuncs(func(obj client.Object) bool {
+			return obj.GetLabels()[nvidiaPCILabel] == "true"
+		}))).
+		Complete(t)
+}
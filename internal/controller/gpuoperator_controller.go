@@ -18,54 +18,125 @@ package controller
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
 	"time"
 
+	"github.com/go-logr/logr"
+	appsv1 "k8s.io/api/apps/v1"
 	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
-	"k8s.io/apimachinery/pkg/types"
-	"k8s.io/utils/ptr"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	operatorv1alpha1 "github.com/kyma-project/gpu-operator/api/v1alpha1"
+	"github.com/kyma-project/gpu-operator/internal/config"
+	"github.com/kyma-project/gpu-operator/internal/helm"
+	"github.com/kyma-project/gpu-operator/internal/images"
+	"github.com/kyma-project/gpu-operator/internal/logging"
+	"github.com/kyma-project/gpu-operator/internal/noise"
+	"github.com/kyma-project/gpu-operator/internal/statusz"
 )
 
 const (
-	finalizerName          = "operator.kyma-project.io/gpu-operator-finalizer"
-	conditionTypeReady     = "Ready"
-	conditionTypeInstalled = "Installed"
-	installJobName         = "gpu-operator-install"
-	uninstallJobName       = "gpu-operator-uninstall"
+	finalizerName            = "operator.kyma-project.io/gpu-operator-finalizer"
+	conditionTypeReady       = "Ready"
+	conditionTypeInstalled   = "Installed"
+	conditionTypeUninstalled = "Uninstalled"
+	conditionTypeDeleting    = "Deleting"
+	helmReleaseName          = "gpu-operator"
+
+	// failureStatusInterval is how often a repeated identical failure is written to the status
+	failureStatusInterval = time.Minute
 
 	// Gardener AI Conformance Guide for GPU Operator installation
 	// Reference: https://github.com/gardener/gardener-ai-conformance/blob/main/v1.33/NVIDIA-GPU-Operator.md
-	gardenerValuesURL = "https://raw.githubusercontent.com/gardenlinux/gardenlinux-nvidia-installer/refs/heads/main/helm/gpu-operator-values.yaml"
-	nvidiaHelmRepo    = "https://helm.ngc.nvidia.com/nvidia"
-	helmImage         = "alpine/helm:3.14.0"
+	nvidiaHelmRepo = "https://helm.ngc.nvidia.com/nvidia"
 )
 
 // GpuOperatorReconciler reconciles a GpuOperator object
 type GpuOperatorReconciler struct {
 	client.Client
 	Scheme *runtime.Scheme
+
+	// APIReader lists the GpuOperator CRs of all namespaces and shards, past the restricted cache; the cache
+	// is used when nil
+	APIReader client.Reader
+
+	// Config holds the live-reloaded controller configuration; defaults are used when nil
+	Config *config.Store
+
+	// Statusz records the computed state of every CR for the debug endpoint; nothing is recorded when nil
+	Statusz *statusz.Recorder
+
+	// Recorder records Events on the GpuOperator CRs; nothing is recorded when nil
+	Recorder record.EventRecorder
+
+	// ModuleVersion is reported in status.moduleVersion
+	ModuleVersion string
+
+	// remoteClients caches the clients of the clusters referenced by spec.targetClusterKubeconfigSecretRef
+	remoteClients *remoteClientCache
+	// remote is set on the copies of the reconciler that manage a remote cluster
+	remote bool
+
+	// failures deduplicates the status updates of repeated identical failures
+	failures *noise.Filter
+
+	// helmClient runs the Helm install engine against restConfig, the config of the target cluster
+	helmClient *helm.Client
+	restConfig *rest.Config
+
+	// controller and cache add the ClusterPolicy watch once the chart installed its CRD
+	controller         controller.Controller
+	cache              cache.Cache
+	clusterPolicyWatch *lazyWatch
 }
 
 // +kubebuilder:rbac:groups=operator.kyma-project.io,resources=gpuoperators,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=operator.kyma-project.io,resources=gpuoperators/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups=operator.kyma-project.io,resources=gpuoperators/finalizers,verbs=update
 // +kubebuilder:rbac:groups=batch,resources=jobs,verbs=get;list;watch;create;update;patch;delete
-// +kubebuilder:rbac:groups="",resources=namespaces,verbs=get;list;watch;create;update;patch
+// +kubebuilder:rbac:groups="",resources=namespaces,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups="",resources=serviceaccounts,verbs=get;list;watch;create;update;patch
 // +kubebuilder:rbac:groups="",resources=configmaps,verbs=get;list;watch;create;update;patch
-// +kubebuilder:rbac:groups=rbac.authorization.k8s.io,resources=clusterroles;clusterrolebindings,verbs=get;list;watch;create;update;patch
+// +kubebuilder:rbac:groups=rbac.authorization.k8s.io,resources=clusterroles;clusterrolebindings,verbs=get;list;watch;create;update;patch;delete;escalate;bind
+// +kubebuilder:rbac:groups="",resources=resourcequotas;limitranges,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups="",resources=secrets,verbs=get;list;watch;delete
+// +kubebuilder:rbac:groups=apps,resources=daemonsets;deployments,verbs=get;list;watch;delete
+// +kubebuilder:rbac:groups=nvidia.com,resources=clusterpolicies,verbs=get;list;watch;delete
+// +kubebuilder:rbac:groups=nfd.k8s-sigs.io,resources=nodefeaturerules,verbs=get;list;watch;create;update;patch;delete
 
 func (r *GpuOperatorReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	start := time.Now()
+	result, err := r.reconcile(ctx, req)
+	r.Statusz.RecordResult(req.String(), result.RequeueAfter, err)
+	outcome := "success"
+	if err != nil {
+		outcome = "error"
+	}
+	reconcileDuration.WithLabelValues(outcome).Observe(time.Since(start).Seconds())
+	return result, err
+}
+
+func (r *GpuOperatorReconciler) reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
 	logger := log.FromContext(ctx)
 
 	// Fetch the GpuOperator instance
@@ -73,29 +144,87 @@ func (r *GpuOperatorReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 	if err := r.Get(ctx, req.NamespacedName, gpuOperator); err != nil {
 		if apierrors.IsNotFound(err) {
 			logger.Info("GpuOperator resource not found. Ignoring since object must be deleted")
+			r.Statusz.Delete(req.String())
+			r.failures.Reset(req.String())
+			forgetState(req.Namespace, req.Name)
+			installerLeftoversRemoved.Delete(req.String())
 			return ctrl.Result{}, nil
 		}
 		logger.Error(err, "Failed to get GpuOperator")
 		return ctrl.Result{}, err
 	}
 
+	namespace := r.targetNamespace(gpuOperator)
+	baseLogger := logger.WithValues(logging.KeyNamespace, namespace)
+	r.recordDesiredState(req.String(), gpuOperator, namespace)
+
+	// Clear the Paused condition of a resumed CR before any step that returns without replacing the conditions
+	if err := r.clearPaused(ctx, gpuOperator); err != nil {
+		logger.Error(err, "Failed to update GpuOperator status")
+		return ctrl.Result{}, err
+	}
+
+	// Only the oldest GpuOperator CR per target cluster manages it, the others would race on the same releases
+	active, err := r.activeInstance(ctx, gpuOperator)
+	if err != nil {
+		logger.Error(err, "Failed to check for other GpuOperators")
+		return r.updateStatusError(ctx, gpuOperator, err)
+	}
+	if active != nil {
+		return r.reconcileDuplicateInstance(ctx, gpuOperator, active)
+	}
+
+	// Manage the GPU stack of the remote cluster if spec.targetClusterKubeconfigSecretRef is set
+	target, err := r.forTargetCluster(ctx, gpuOperator)
+	if err != nil && gpuOperator.GetDeletionTimestamp() != nil && apierrors.IsNotFound(err) &&
+		controllerutil.ContainsFinalizer(gpuOperator, finalizerName) {
+		// Without the kubeconfig the remote cluster cannot be cleaned up; do not block the deletion forever
+		logger.Error(err, "Target cluster kubeconfig Secret deleted, removing finalizer without uninstalling")
+		r.remoteClients.forget(req.NamespacedName)
+		controllerutil.RemoveFinalizer(gpuOperator, finalizerName)
+		return ctrl.Result{}, r.Update(ctx, gpuOperator)
+	}
+	if err != nil {
+		logger.Error(err, "Failed to connect to target cluster")
+		return r.updateStatusError(ctx, gpuOperator, err)
+	}
+	r = target
+
+	// Stop all changes to the cluster while paused, but keep reporting the state of the GPU stack
+	if paused(gpuOperator) {
+		return r.reconcilePaused(log.IntoContext(ctx, baseLogger), gpuOperator, namespace)
+	}
+
 	// Check if the GpuOperator instance is marked to be deleted
 	if gpuOperator.GetDeletionTimestamp() != nil {
+		r.recordPhase(req.String(), logging.PhaseUninstall)
+		ctx = log.IntoContext(ctx, baseLogger.WithValues(logging.KeyPhase, logging.PhaseUninstall))
 		if controllerutil.ContainsFinalizer(gpuOperator, finalizerName) {
 			// Run finalization logic
-			if err := r.finalizeGpuOperator(ctx, gpuOperator); err != nil {
+			done, err := r.finalizeGpuOperator(ctx, gpuOperator)
+			if err != nil {
 				return ctrl.Result{}, err
 			}
+			if !done {
+				return ctrl.Result{RequeueAfter: r.Config.Get().RequeueInterval.Duration}, nil
+			}
+
+			r.event(gpuOperator, corev1.EventTypeNormal, eventUninstallSucceeded, "Uninstalled the GPU operator from namespace %s", namespace)
 
 			// Remove finalizer
 			controllerutil.RemoveFinalizer(gpuOperator, finalizerName)
 			if err := r.Update(ctx, gpuOperator); err != nil {
 				return ctrl.Result{}, err
 			}
+			r.remoteClients.forget(req.NamespacedName)
+			r.failures.Reset(req.String())
 		}
 		return ctrl.Result{}, nil
 	}
 
+	logger = baseLogger.WithValues(logging.KeyPhase, logging.PhasePrepare)
+	ctx = log.IntoContext(ctx, logger)
+
 	// Add finalizer if not present
 	if !controllerutil.ContainsFinalizer(gpuOperator, finalizerName) {
 		controllerutil.AddFinalizer(gpuOperator, finalizerName)
@@ -104,72 +233,420 @@ func (r *GpuOperatorReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 		}
 	}
 
-	// Set status to Processing
-	if gpuOperator.Status.State != operatorv1alpha1.StateProcessing {
+	// The AMD GPU operator is installed by its own, shorter flow
+	if isAMD(gpuOperator) {
+		return r.reconcileAMD(ctx, req, gpuOperator, namespace, baseLogger)
+	}
+
+	// Pause health evaluation while the GPU nodes are gone, e.g. during shoot hibernation
+	hibernation, err := r.observeHibernation(ctx, gpuOperator)
+	if err != nil {
+		logger.Error(err, "Failed to check for hibernation")
+		return r.updateStatusError(ctx, gpuOperator, err)
+	}
+	if hibernation.hibernated {
+		// Record the hibernation right away, so the failures until the GPU nodes return are reported as such
+		meta.SetStatusCondition(&gpuOperator.Status.Conditions, *hibernationCondition(gpuOperator, hibernation))
+	}
+
+	// Set status to Processing for a new generation of the spec, unless only waiting for the GPU nodes to return.
+	// Reconciling an unchanged spec keeps the last state, so lifecycle-manager does not see it flapping.
+	installed := installedForGeneration(gpuOperator)
+	previousState := gpuOperator.Status.State
+	if startsProcessing(gpuOperator) && !hibernation.hibernated {
 		gpuOperator.Status.State = operatorv1alpha1.StateProcessing
-		if err := r.Status().Update(ctx, gpuOperator); err != nil {
+		gpuOperator.Status.ObservedGeneration = gpuOperator.Generation
+		gpuOperator.Status.ModuleVersion = r.ModuleVersion
+		startOperation(gpuOperator, nextOperation(gpuOperator),
+			fmt.Sprintf("Reconciling generation %d into namespace %s", gpuOperator.Generation, namespace))
+		recordOperationStart(req.String())
+		if err := r.updateStatus(ctx, gpuOperator); err != nil {
 			logger.Error(err, "Failed to update GpuOperator status to Processing")
 			return ctrl.Result{}, err
 		}
 	}
 
+	// Check the prerequisites before the first installation rather than waiting for the Helm timeout
+	if err := r.runPreflight(ctx, gpuOperator); err != nil {
+		logger.Error(err, "Preflight checks failed")
+		return r.updateStatusError(ctx, gpuOperator, err)
+	}
+
 	// Create namespace if it doesn't exist
-	namespace := gpuOperator.Spec.Namespace
-	if namespace == "" {
-		namespace = "gpu-operator"
+	if err := r.ensureNamespace(ctx, gpuOperator, namespace); err != nil {
+		logger.Error(err, "Failed to ensure namespace")
+		return r.updateStatusError(ctx, gpuOperator, err)
+	}
+
+	// Provision ResourceQuota and LimitRange defaults in the namespace if requested
+	if err := r.ensureNamespaceDefaults(ctx, gpuOperator, namespace); err != nil {
+		logger.Error(err, "Failed to ensure namespace defaults")
+		return r.updateStatusError(ctx, gpuOperator, err)
 	}
 
-	ns := &corev1.Namespace{
-		ObjectMeta: metav1.ObjectMeta{
-			Name: namespace,
-		},
+	// Exclude the GPU nodes outside spec.operands.nodeSelector before the operands are deployed
+	if err := r.reconcileOperandNodes(ctx, gpuOperator); err != nil {
+		logger.Error(err, "Failed to reconcile operand node selection")
+		return r.updateStatusError(ctx, gpuOperator, err)
 	}
-	if err := r.Get(ctx, types.NamespacedName{Name: namespace}, ns); err != nil {
-		if apierrors.IsNotFound(err) {
-			logger.Info("Creating namespace", "namespace", namespace)
-			if err := r.Create(ctx, ns); err != nil && !apierrors.IsAlreadyExists(err) {
-				logger.Error(err, "Failed to create namespace")
+
+	// Validate and provide the custom MIG configuration before the MIG manager is deployed
+	if err := r.reconcileMIGConfig(ctx, gpuOperator, namespace); err != nil {
+		logger.Error(err, "Failed to reconcile custom MIG config")
+		return r.updateStatusError(ctx, gpuOperator, err)
+	}
+
+	// Generate the time-slicing configuration before the device plugin is deployed
+	if err := r.reconcileTimeSlicing(ctx, gpuOperator, namespace); err != nil {
+		logger.Error(err, "Failed to reconcile time-slicing config")
+		return r.updateStatusError(ctx, gpuOperator, err)
+	}
+
+	// Provide the NGC image pull secret and licensing configuration before the driver is deployed
+	if err := r.reconcileNGCSecrets(ctx, gpuOperator, namespace); err != nil {
+		logger.Error(err, "Failed to reconcile NGC secrets")
+		return r.updateStatusError(ctx, gpuOperator, err)
+	}
+
+	// Provide the licensing configuration of the vGPU guest driver before the driver is deployed
+	if err := r.reconcileVGPU(ctx, gpuOperator, namespace); err != nil {
+		logger.Error(err, "Failed to reconcile vGPU licensing")
+		return r.updateStatusError(ctx, gpuOperator, err)
+	}
+
+	// Provide the image pull secrets and CA bundle of the private registry before anything is pulled from it
+	if err := r.reconcileRegistry(ctx, gpuOperator, namespace); err != nil {
+		logger.Error(err, "Failed to reconcile private registry")
+		return r.updateStatusError(ctx, gpuOperator, err)
+	}
+
+	// Fail early if DRA is requested on a cluster without Dynamic Resource Allocation
+	if err := r.checkDRASupport(ctx, gpuOperator); err != nil {
+		logger.Error(err, "Dynamic Resource Allocation not supported")
+		return r.updateStatusError(ctx, gpuOperator, err)
+	}
+
+	// Fail early if FIPS mode is requested for a component without a FIPS-validated image
+	if err := r.checkFIPSImages(gpuOperator); err != nil {
+		logger.Error(err, "FIPS-validated images missing")
+		return r.updateStatusError(ctx, gpuOperator, err)
+	}
+
+	// Delete the Jobs earlier versions of the module ran Helm in
+	if err := r.removeInstallerLeftovers(ctx, gpuOperator, namespace); err != nil {
+		logger.Error(err, "Failed to remove installer leftovers")
+		return r.updateStatusError(ctx, gpuOperator, err)
+	}
+
+	logger = baseLogger.WithValues(logging.KeyPhase, logging.PhaseInstall)
+	ctx = log.IntoContext(ctx, logger)
+	r.recordPhase(req.String(), logging.PhaseInstall)
+
+	if hibernation.wokeUp {
+		r.revalidateAfterWakeUp(ctx, gpuOperator)
+	}
+
+	// Start from a clean state if a reinstall was requested with the reinstall annotation
+	if err := r.reconcileReinstall(ctx, gpuOperator, namespace); err != nil {
+		logger.Error(err, "Failed to reinstall")
+		return r.updateStatusError(ctx, gpuOperator, err)
+	}
+
+	// Select a new chart version within the upgrade channel before installing
+	if err := r.reconcileChartUpgrade(ctx, gpuOperator); err != nil {
+		logger.Error(err, "Failed to reconcile chart upgrade")
+		return r.updateStatusError(ctx, gpuOperator, err)
+	}
+
+	// Hold back the upgrades that require approval if spec.upgradePolicy.requireApproval is set
+	waiting, err := r.reconcileUpgradeApproval(ctx, gpuOperator)
+	if err != nil {
+		logger.Error(err, "Failed to reconcile upgrade approval")
+		return r.updateStatusError(ctx, gpuOperator, err)
+	}
+	if waiting {
+		upgrade := gpuOperator.Status.PendingUpgrade.ID
+		logger.Info("Waiting for approval of the upgrade", "upgrade", upgrade)
+		r.reportLastOperation(ctx, gpuOperator, operatorv1alpha1.OperationProcessing,
+			fmt.Sprintf("Waiting for approval of upgrade %s with the %s annotation", upgrade, approveUpgradeAnnotation))
+		return ctrl.Result{RequeueAfter: r.Config.Get().RequeueInterval.Duration}, nil
+	}
+
+	// Refuse to downgrade the chart or the driver unless spec.allowDowngrade is set
+	if err := checkDowngrade(gpuOperator); err != nil {
+		logger.Error(err, "Downgrade blocked")
+		return r.updateStatusError(ctx, gpuOperator, err)
+	}
+
+	installedReason := "HelmInstallComplete"
+	installedMessage := "NVIDIA GPU Operator installed in-process with the Helm SDK and Garden Linux optimized values"
+	newlyInstalled := !installReported(gpuOperator)
+	if gpuOperator.Spec.InstallEngine == operatorv1alpha1.InstallEngineManifest {
+		// Apply the pre-rendered manifests directly, without Helm
+		if err := r.applyManifests(ctx, gpuOperator, namespace); err != nil {
+			logger.Error(err, "Failed to apply GPU operator manifests")
+			return r.updateStatusError(ctx, gpuOperator, err)
+		}
+		// The manifests are applied on every reconcile, so a forced reinstall only has to be recorded
+		if requested := forceReinstallRequested(gpuOperator); requested != "" {
+			if err := r.recordForceReinstall(ctx, gpuOperator, requested); err != nil {
+				logger.Error(err, "Failed to record forced reinstall")
 				return r.updateStatusError(ctx, gpuOperator, err)
 			}
-		} else {
-			logger.Error(err, "Failed to get namespace")
+		}
+		installedReason = "ManifestApplyComplete"
+		installedMessage = "NVIDIA GPU Operator installed from pre-rendered manifests with server-side apply"
+		logger = baseLogger.WithValues(logging.KeyPhase, logging.PhaseReady)
+	} else {
+		// Retry the installation if a previous Helm operation left the release locked in a pending status
+		if err := r.recoverStuckRelease(ctx, gpuOperator, namespace); err != nil {
+			logger.Error(err, "Failed to recover stuck Helm release")
+			return r.updateStatusError(ctx, gpuOperator, err)
+		}
+
+		// Do not run the first install into the remnants of a previous installation
+		cleaning, err := r.reconcileOrphanedResources(ctx, gpuOperator, namespace)
+		if err != nil {
+			logger.Error(err, "Orphaned resources block the installation")
+			return r.updateStatusError(ctx, gpuOperator, err)
+		}
+		if cleaning {
+			logger.Info("Waiting for orphaned resources of a previous installation to be deleted")
+			return ctrl.Result{RequeueAfter: r.Config.Get().RequeueInterval.Duration}, nil
+		}
+
+		// Wait out the backoff after a failed installation attempt
+		if wait := installRetryWait(gpuOperator); wait > 0 {
+			logger.Info("Waiting before retrying the failed installation",
+				"attempts", gpuOperator.Status.InstallRetry.Attempts, "retryIn", wait)
+			return ctrl.Result{RequeueAfter: wait}, nil
+		}
+
+		// Install or upgrade the releases from the controller, following the Gardener AI conformance guide:
+		// https://github.com/gardener/gardener-ai-conformance/blob/main/v1.33/NVIDIA-GPU-Operator.md
+		helmRevision, hash, err := r.installWithSDK(ctx, gpuOperator, namespace)
+		r.recordHelmAction(req.String(), "install", helmRevision, err)
+		var installFailed *installFailedError
+		if errors.As(err, &installFailed) && hibernation.hibernated {
+			// The operands cannot run without GPU nodes; the installation is retried on wake-up
+			logger.Info("Helm installation failed while no GPU nodes are ready, will reinstall on wake-up", "error", err.Error())
+			return ctrl.Result{RequeueAfter: hibernationPollInterval}, nil
+		}
+		if errors.As(err, &installFailed) {
+			logger.Error(err, "Helm installation failed")
+			return r.retryInstall(ctx, gpuOperator, err)
+		}
+		if err != nil {
+			logger.Error(err, "Failed to install GPU operator")
+			return r.updateStatusError(ctx, gpuOperator, err)
+		}
+		logger = baseLogger.WithValues(logging.KeyPhase, logging.PhaseReady, logging.KeyHelmRevision, helmRevision)
+		newlyInstalled = newlyInstalled || gpuOperator.Status.InstallHash != hash
+		gpuOperator.Status.InstallHash = hash
+		gpuOperator.Status.InstallRetry = nil
+
+		// Reinstall a release that was uninstalled or changed by hand since it was installed
+		drifted, err := r.reconcileDrift(ctx, gpuOperator, namespace)
+		if err != nil {
+			logger.Error(err, "Failed to check the Helm release for drift")
 			return r.updateStatusError(ctx, gpuOperator, err)
 		}
+		if drifted {
+			logger.Info("Helm release drifted, will reinstall it")
+			return ctrl.Result{RequeueAfter: r.Config.Get().RequeueInterval.Duration}, nil
+		}
+	}
+	ctx = log.IntoContext(ctx, logger)
+	if newlyInstalled {
+		r.event(gpuOperator, corev1.EventTypeNormal, eventInstallSucceeded, "%s", installedMessage)
+	}
+
+	// Configure the operands on the ClusterPolicy if the controller manages it
+	if err := r.reconcileManagedClusterPolicy(ctx, gpuOperator); err != nil {
+		logger.Error(err, "Failed to reconcile ClusterPolicy")
+		return r.updateStatusError(ctx, gpuOperator, err)
 	}
 
-	// Create ServiceAccount with necessary permissions
-	if err := r.ensureServiceAccount(ctx, namespace); err != nil {
-		logger.Error(err, "Failed to ensure ServiceAccount")
+	// Render GFD extra label rules once the chart (and with it NFD) is installed
+	if err := r.ensureGFDLabelRules(ctx, gpuOperator); err != nil {
+		logger.Error(err, "Failed to ensure GFD extra label rules")
 		return r.updateStatusError(ctx, gpuOperator, err)
 	}
 
-	// Create RBAC for the installer Job
-	if err := r.ensureRBAC(ctx, namespace); err != nil {
-		logger.Error(err, "Failed to ensure RBAC")
+	// Serve the worker pools with reserved GPUs from a device plugin that does not see them
+	if err := r.reconcileReservedGPUs(ctx, gpuOperator, namespace); err != nil {
+		logger.Error(err, "Failed to reconcile reserved GPUs")
 		return r.updateStatusError(ctx, gpuOperator, err)
 	}
 
-	// Create or update Helm installation Job following Gardener AI conformance guide
-	if err := r.createHelmInstallJob(ctx, gpuOperator, namespace); err != nil {
-		logger.Error(err, "Failed to create Helm installation job")
+	// Keep the Kueue ResourceFlavors in sync with the GPU models of the cluster
+	if err := r.reconcileKueueFlavors(ctx, gpuOperator, hibernation.hibernated); err != nil {
+		logger.Error(err, "Failed to reconcile Kueue ResourceFlavors")
 		return r.updateStatusError(ctx, gpuOperator, err)
 	}
 
-	// Check if the installation job completed successfully
-	jobReady, err := r.isJobCompleted(ctx, namespace, installJobName)
+	// Scrape DCGM Exporter and alert on GPU failures if spec.monitoring.enabled is set
+	if err := r.reconcileMonitoring(ctx, gpuOperator, namespace); err != nil {
+		logger.Error(err, "Failed to reconcile DCGM Exporter monitoring")
+		return r.updateStatusError(ctx, gpuOperator, err)
+	}
+
+	// Run the CUDA smoke test on every GPU worker pool on the configured schedule,
+	// and right away after a wake-up from hibernation
+	smokeTestFailures, err := r.reconcileSmokeTests(ctx, gpuOperator, namespace, hibernation.wokeUp)
+	if err != nil {
+		logger.Error(err, "Failed to reconcile scheduled smoke tests")
+		return r.updateStatusError(ctx, gpuOperator, err)
+	}
+
+	// Run the CUDA workload on the GPU nodes once the spec is installed
+	if !hibernation.hibernated {
+		if err := r.reconcileCUDAValidation(ctx, gpuOperator, namespace); err != nil {
+			logger.Error(err, "Failed to reconcile CUDA validation")
+			return r.updateStatusError(ctx, gpuOperator, err)
+		}
+	}
+
+	// Select the driver branch from the detected GPU models if none is configured
+	driverVersion, err := r.driverVersion(ctx, gpuOperator)
 	if err != nil {
-		logger.Error(err, "Failed to check job status")
+		logger.Error(err, "Failed to determine driver version")
 		return r.updateStatusError(ctx, gpuOperator, err)
 	}
-	if !jobReady {
-		logger.Info("Helm installation job still running, will requeue")
-		return ctrl.Result{RequeueAfter: 10 * time.Second}, nil
+	if recommendation := gpuOperator.Status.DriverRecommendation; recommendation != nil &&
+		gpuOperator.Status.InstalledVersion != driverVersion {
+		logger.Info("Selected driver branch for detected GPU models", "driverVersion", driverVersion,
+			"gpuModels", recommendation.GPUModels, "reason", recommendation.Reason)
+	}
+
+	// Report the versions the installation actually deployed rather than the ones the spec asks for
+	release, err := r.installedRelease(ctx, gpuOperator, namespace)
+	if err != nil {
+		logger.Error(err, "Failed to read the installed GPU operator release")
+		return r.updateStatusError(ctx, gpuOperator, err)
 	}
 
-	// Update status to Ready
+	// Surface the state the GPU operator reports on its ClusterPolicy
+	if err := r.ensureClusterPolicyWatch(ctx); err != nil {
+		logger.Error(err, "Failed to watch ClusterPolicies")
+	}
+	if gpuOperator.Status.ClusterPolicy, err = r.clusterPolicyStatus(ctx); err != nil {
+		logger.Error(err, "Failed to read ClusterPolicy state")
+		return r.updateStatusError(ctx, gpuOperator, err)
+	}
+	clusterPolicyReady := clusterPolicyCondition(gpuOperator)
+
+	// Report the rollout of the GPU operator and its operands
+	if gpuOperator.Status.Components, err = r.componentStatuses(ctx, namespace); err != nil {
+		logger.Error(err, "Failed to collect component rollout")
+		return r.updateStatusError(ctx, gpuOperator, err)
+	}
+
+	// Check the operands and GPU nodes against spec.readinessChecks; health evaluation is paused while hibernated
+	readiness := readinessResult{ready: true}
+	if !hibernation.hibernated {
+		if readiness, err = r.evaluateReadiness(ctx, gpuOperator, namespace, gpuOperator.Status.Components); err != nil {
+			logger.Error(err, "Failed to evaluate readiness checks")
+			return r.updateStatusError(ctx, gpuOperator, err)
+		}
+	}
+
+	// Report the operator validator results per node
+	nodeValidations, err := r.nodeValidations(ctx, namespace)
+	if err != nil {
+		logger.Error(err, "Failed to collect operator validator results")
+		return r.updateStatusError(ctx, gpuOperator, err)
+	}
+	gpuOperator.Status.Nodes = nodeValidations
+
+	// Report the rollout per worker pool
+	if gpuOperator.Status.Pools, err = r.poolStatuses(ctx, nodeValidations); err != nil {
+		logger.Error(err, "Failed to aggregate worker pool status")
+		return r.updateStatusError(ctx, gpuOperator, err)
+	}
+
+	// Report the driver upgrade progress per node
+	if gpuOperator.Status.DriverUpgrade, err = r.driverUpgradeStatus(ctx); err != nil {
+		logger.Error(err, "Failed to collect driver upgrade progress")
+		return r.updateStatusError(ctx, gpuOperator, err)
+	}
+
+	// Summarize the GPU fleet
+	if gpuOperator.Status.GPUNodes, err = r.gpuNodeInventory(ctx, nodeValidations); err != nil {
+		logger.Error(err, "Failed to collect GPU node inventory")
+		return r.updateStatusError(ctx, gpuOperator, err)
+	}
+	if gpuOperator.Status.GPUInventory, err = r.gpuInventory(ctx); err != nil {
+		logger.Error(err, "Failed to collect GPU inventory per model")
+		return r.updateStatusError(ctx, gpuOperator, err)
+	}
+
+	// Report the MIG rollout per node
+	if gpuOperator.Status.MIG, err = r.migStatus(ctx, gpuOperator); err != nil {
+		logger.Error(err, "Failed to collect MIG configuration state")
+		return r.updateStatusError(ctx, gpuOperator, err)
+	}
+
+	// Report the GPU demand the cluster cannot serve
+	if gpuOperator.Status.PendingGPUPods, err = r.pendingGPUPods(ctx); err != nil {
+		logger.Error(err, "Failed to count pods pending for GPUs")
+		return r.updateStatusError(ctx, gpuOperator, err)
+	}
+
+	// Publish the GPUs allocated per namespace for tenant dashboards
+	if gpuOperator.Status.GPUAllocation, err = r.gpuAllocation(ctx); err != nil {
+		logger.Error(err, "Failed to sum allocated GPUs")
+		return r.updateStatusError(ctx, gpuOperator, err)
+	}
+
+	// Report and remediate unhealthy GPUs if spec.healthCheck is enabled
+	if gpuOperator.Status.GPUHealth, err = r.reconcileGPUHealth(ctx, gpuOperator, namespace); err != nil {
+		logger.Error(err, "Failed to check GPU health")
+		return r.updateStatusError(ctx, gpuOperator, err)
+	}
+
+	// Summarize the versions the installation runs with and whether they are supported
+	gpuOperator.Status.Compatibility = r.compatibilityReport(ctx, driverVersion)
+
+	// Operands failing on a subset of the nodes do not block readiness, but degrade the installation
+	var degraded string
+	if !hibernation.hibernated {
+		degraded = degradation(gpuOperator.Status.Components, nodeValidations, gpuOperator.Status.CUDAValidation,
+			gpuOperator.Status.GPUHealth)
+	}
+
+	// Update status to Ready, or Warning if a GPU worker pool stopped passing its smoke test, an installation
+	// that was Ready no longer meets the readiness checks, or operands are unhealthy on some of the nodes
 	gpuOperator.Status.State = operatorv1alpha1.StateReady
+	switch {
+	case !readiness.ready && installed:
+		gpuOperator.Status.State = operatorv1alpha1.StateWarning
+		logger.Info("Readiness checks no longer met, will requeue", "reason", readiness.reason, "details", readiness.message)
+	case !readiness.ready:
+		gpuOperator.Status.State = operatorv1alpha1.StateProcessing
+		logger.Info("Readiness checks not met, will requeue", "reason", readiness.reason, "details", readiness.message)
+	case len(smokeTestFailures) > 0:
+		gpuOperator.Status.State = operatorv1alpha1.StateWarning
+		logger.Info("Scheduled smoke test failing", "pools", len(smokeTestFailures))
+	case degraded != "":
+		gpuOperator.Status.State = operatorv1alpha1.StateWarning
+		logger.Info("Installation degraded, will requeue", "details", degraded)
+	}
 	gpuOperator.Status.ObservedGeneration = gpuOperator.Generation
-	gpuOperator.Status.InstalledVersion = gpuOperator.Spec.DriverVersion
+	gpuOperator.Status.ModuleVersion = r.ModuleVersion
+	gpuOperator.Status.InstalledVersion = driverVersion
+	if release.driverVersion != "" {
+		gpuOperator.Status.InstalledVersion = release.driverVersion
+	}
+	gpuOperator.Status.InstalledChartVersion = release.chartVersion
+	if release.chartVersion != "" {
+		installedMessage += fmt.Sprintf(" (chart %s", release.chartVersion)
+		if release.appVersion != "" && release.appVersion != release.chartVersion {
+			installedMessage += ", app version " + release.appVersion
+		}
+		installedMessage += ")"
+	}
+	gpuOperator.Status.RuntimeClassName = runtimeClassName(gpuOperator)
 
 	// Set conditions
 	readyCondition := metav1.Condition{
@@ -178,273 +655,363 @@ func (r *GpuOperatorReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 		Reason:             "GpuOperatorReady",
 		Message:            "GPU Operator installed successfully following Gardener AI conformance guide",
 		ObservedGeneration: gpuOperator.Generation,
-		LastTransitionTime: metav1.Now(),
+	}
+	if !readiness.ready {
+		readyCondition.Status = metav1.ConditionFalse
+		readyCondition.Reason = readiness.reason
+		readyCondition.Message = readiness.message
+	} else {
+		for _, message := range []string{readiness.message, degraded} {
+			if message != "" {
+				readyCondition.Message += ". " + message
+			}
+		}
 	}
 	installedCondition := metav1.Condition{
 		Type:               conditionTypeInstalled,
 		Status:             metav1.ConditionTrue,
-		Reason:             "HelmInstallComplete",
-		Message:            "NVIDIA GPU Operator installed via Helm with Garden Linux optimized values",
+		Reason:             installedReason,
+		Message:            installedMessage,
 		ObservedGeneration: gpuOperator.Generation,
-		LastTransitionTime: metav1.Now(),
 	}
 
-	gpuOperator.Status.Conditions = []metav1.Condition{readyCondition, installedCondition}
+	conditions := []metav1.Condition{readyCondition, installedCondition}
+	conditions = append(conditions, releaseRecoveredConditions(gpuOperator)...)
+	conditions = append(conditions, preflightConditions(gpuOperator)...)
+	if clusterPolicyReady != nil {
+		conditions = append(conditions, *clusterPolicyReady)
+	}
+	if condition := smokeTestCondition(gpuOperator, smokeTestFailures); condition != nil && !hibernation.hibernated {
+		conditions = append(conditions, *condition)
+	}
+	if condition := hibernationCondition(gpuOperator, hibernation); condition != nil {
+		conditions = append(conditions, *condition)
+	}
+	if condition := gpuHealthCondition(gpuOperator); condition != nil {
+		conditions = append(conditions, *condition)
+	}
+	conditions = append(conditions, r.deprecatedVersionCondition(ctx, gpuOperator, driverVersion))
+	setConditions(gpuOperator, conditions...)
+	if readiness.ready || installed {
+		setLastOperation(gpuOperator, operatorv1alpha1.OperationSucceeded, installedMessage)
+		recordOperationEnd(req.String(), gpuOperator.Status.LastOperation.Operation, operatorv1alpha1.OperationSucceeded)
+	} else {
+		setLastOperation(gpuOperator, operatorv1alpha1.OperationProcessing, readiness.message)
+	}
 
-	if err := r.Status().Update(ctx, gpuOperator); err != nil {
-		logger.Error(err, "Failed to update GpuOperator status to Ready")
+	if err := r.updateStatus(ctx, gpuOperator); err != nil {
+		logger.Error(err, "Failed to update GpuOperator status", "state", gpuOperator.Status.State)
 		return ctrl.Result{}, err
 	}
+	r.failures.Reset(req.String())
+	r.recordStateEvent(gpuOperator, previousState, readyCondition.Message)
 
+	r.recordHealth(req.String(), gpuOperator, driverVersion, hibernation.hibernated, smokeTestFailures)
 	logger.Info("Successfully reconciled GpuOperator")
-	return ctrl.Result{}, nil
+	if hibernation.hibernated {
+		return ctrl.Result{RequeueAfter: hibernationPollInterval}, nil
+	}
+	// Changes in a remote cluster are not watched, so its status is refreshed periodically
+	if !readiness.ready || readiness.message != "" || degraded != "" || !validationsPassed(nodeValidations) ||
+		cudaValidationPending(gpuOperator.Status.CUDAValidation) || r.remote {
+		return ctrl.Result{RequeueAfter: r.Config.Get().RequeueInterval.Duration}, nil
+	}
+	// Refresh the GPU allocation snapshot periodically, and compare the release with the spec once per resync period
+	return ctrl.Result{RequeueAfter: min(gpuAllocationInterval, resyncPeriod(gpuOperator))}, nil
 }
 
-// ensureServiceAccount creates the ServiceAccount needed for Helm Jobs
-func (r *GpuOperatorReconciler) ensureServiceAccount(ctx context.Context, namespace string) error {
-	sa := &corev1.ServiceAccount{
-		ObjectMeta: metav1.ObjectMeta{
-			Name:      "gpu-operator",
-			Namespace: namespace,
-			Labels: map[string]string{
-				"app.kubernetes.io/name":       "gpu-operator",
-				"app.kubernetes.io/managed-by": "gpu-operator-module",
-			},
-		},
-	}
-
-	existing := &corev1.ServiceAccount{}
-	err := r.Get(ctx, types.NamespacedName{Name: sa.Name, Namespace: namespace}, existing)
-	if err != nil {
-		if apierrors.IsNotFound(err) {
-			return r.Create(ctx, sa)
+// targetNamespace returns the namespace the GPU operator is installed into. The defaulting webhook sets
+// spec.namespace, the fallback covers CRs admitted without webhooks.
+func (r *GpuOperatorReconciler) targetNamespace(gpuOperator *operatorv1alpha1.GpuOperator) string {
+	if gpuOperator.Spec.Namespace != "" {
+		return gpuOperator.Spec.Namespace
+	}
+	if isAMD(gpuOperator) {
+		return r.Config.Get().DefaultAMDNamespace
+	}
+	return r.Config.Get().DefaultNamespace
+}
+
+// helperImage resolves an auxiliary image: the spec.images override if set, otherwise the
+// operator-level image, rewritten to the mirror of the helper images
+func (r *GpuOperatorReconciler) helperImage(gpuOperator *operatorv1alpha1.GpuOperator, image string,
+	override func(*operatorv1alpha1.HelperImages) string) string {
+	if overrides := gpuOperator.Spec.Images; overrides != nil {
+		if ref := override(overrides); ref != "" {
+			image = ref
 		}
-		return err
 	}
-	return nil
+	return images.Mirror(r.imageMirror(gpuOperator), image)
 }
 
-// ensureRBAC creates ClusterRole and ClusterRoleBinding for Helm installer
-func (r *GpuOperatorReconciler) ensureRBAC(ctx context.Context, namespace string) error {
-	// For now, we're using the controller's own RBAC which has cluster-admin
-	// In production, you might want to create specific RBAC for the installer Job
-	return nil
+// imageMirror returns the mirror of the helper images: spec.images.mirror, otherwise the repository of
+// spec.registry, otherwise the operator-level mirror
+func (r *GpuOperatorReconciler) imageMirror(gpuOperator *operatorv1alpha1.GpuOperator) string {
+	if gpuOperator.Spec.Images != nil && gpuOperator.Spec.Images.Mirror != "" {
+		return gpuOperator.Spec.Images.Mirror
+	}
+	if gpuOperator.Spec.Registry != nil {
+		return gpuOperator.Spec.Registry.Repository
+	}
+	return r.Config.Get().ImageMirror
 }
 
-// createHelmInstallJob creates a Kubernetes Job that installs NVIDIA GPU Operator using Helm
-// following the Gardener AI conformance guide:
-// https://github.com/gardener/gardener-ai-conformance/blob/main/v1.33/NVIDIA-GPU-Operator.md
-func (r *GpuOperatorReconciler) createHelmInstallJob(ctx context.Context, gpuOperator *operatorv1alpha1.GpuOperator, namespace string) error {
+// finalizeGpuOperator uninstalls the Helm releases and reports whether finalization is done.
+// With spec.uninstall.drainGpuWorkloads or blockIfWorkloadsPresent it first evicts the GPU workloads
+// or waits until none are running.
+// If the releases cannot be uninstalled before spec.uninstall.timeout, the remaining resources are deleted
+// on a best-effort basis and whatever is left behind is recorded in a condition.
+func (r *GpuOperatorReconciler) finalizeGpuOperator(ctx context.Context, gpuOperator *operatorv1alpha1.GpuOperator) (bool, error) {
 	logger := log.FromContext(ctx)
+	logger.Info("Finalizing GpuOperator")
 
-	// Determine values URL - use Gardener Garden Linux optimized values
-	valuesURL := gardenerValuesURL
-	if gpuOperator.Spec.ValuesConfigMapName != "" {
-		logger.Info("Custom values ConfigMap specified, but using Gardener values as base",
-			"configMap", gpuOperator.Spec.ValuesConfigMapName)
-		// TODO: Support merging custom values with Gardener values
-	}
-
-	job := &batchv1.Job{
-		ObjectMeta: metav1.ObjectMeta{
-			Name:      installJobName,
-			Namespace: namespace,
-			Labels: map[string]string{
-				"app.kubernetes.io/name":       "gpu-operator-installer",
-				"app.kubernetes.io/managed-by": "gpu-operator-module",
-				"app.kubernetes.io/component":  "installer",
-			},
-			Annotations: map[string]string{
-				"gardener.ai/conformance-guide": "v1.33",
-				"gardener.ai/values-source":     gardenerValuesURL,
-			},
-		},
-		Spec: batchv1.JobSpec{
-			TTLSecondsAfterFinished: ptr.To[int32](300), // Clean up after 5 minutes
-			BackoffLimit:            ptr.To[int32](3),
-			Template: corev1.PodTemplateSpec{
-				Spec: corev1.PodSpec{
-					ServiceAccountName: "gpu-operator",
-					RestartPolicy:      corev1.RestartPolicyOnFailure,
-					Containers: []corev1.Container{
-						{
-							Name:    "helm-installer",
-							Image:   helmImage,
-							Command: []string{"/bin/sh", "-c"},
-							Args: []string{
-								fmt.Sprintf(`
-set -e
-echo "=================================================="
-echo "Installing NVIDIA GPU Operator"
-echo "Following Gardener AI Conformance Guide v1.33"
-echo "Reference: https://github.com/gardener/gardener-ai-conformance/blob/main/v1.33/NVIDIA-GPU-Operator.md"
-echo "=================================================="
-echo ""
-
-echo "Step 1: Add NVIDIA Helm repository..."
-helm repo add nvidia %s
-helm repo update
-
-echo ""
-echo "Step 2: Verify repository..."
-helm search repo nvidia/gpu-operator
-
-echo ""
-echo "Step 3: Install GPU Operator with Garden Linux optimized values..."
-echo "Using values from: %s"
-helm upgrade --install --create-namespace \
-  -n %s gpu-operator nvidia/gpu-operator \
-  --values %s \
-  --wait --timeout 10m
-
-echo ""
-echo "=================================================="
-echo "GPU Operator installation completed successfully"
-echo "=================================================="
-helm status gpu-operator -n %s
-`, nvidiaHelmRepo, valuesURL, namespace, valuesURL, namespace),
-							},
-						},
-					},
-				},
-			},
-		},
-	}
-
-	// Set owner reference so the job is cleaned up with the GpuOperator CR
-	if err := controllerutil.SetControllerReference(gpuOperator, job, r.Scheme); err != nil {
-		return fmt.Errorf("failed to set owner reference: %w", err)
-	}
-
-	// Check if job already exists
-	existingJob := &batchv1.Job{}
-	err := r.Get(ctx, types.NamespacedName{Name: installJobName, Namespace: namespace}, existingJob)
-	if err != nil {
-		if apierrors.IsNotFound(err) {
-			logger.Info("Creating Helm installation job following Gardener AI conformance guide",
-				"job", installJobName, "namespace", namespace)
-			if err := r.Create(ctx, job); err != nil {
-				return fmt.Errorf("failed to create job: %w", err)
-			}
-			return nil
+	namespace := r.targetNamespace(gpuOperator)
+
+	// Set status to Deleting
+	if gpuOperator.Status.State != operatorv1alpha1.StateDeleting {
+		gpuOperator.Status.State = operatorv1alpha1.StateDeleting
+		startOperation(gpuOperator, operatorv1alpha1.OperationUninstall, fmt.Sprintf("Uninstalling from namespace %s", namespace))
+		if err := r.updateStatus(ctx, gpuOperator); err != nil {
+			logger.Error(err, "Failed to update GpuOperator status to Deleting")
 		}
-		return fmt.Errorf("failed to get existing job: %w", err)
+		r.event(gpuOperator, corev1.EventTypeNormal, eventUninstallStarted, "Uninstalling the GPU operator from namespace %s", namespace)
 	}
 
-	// Job already exists - check if it needs to be recreated
-	logger.Info("Helm installation job already exists", "job", installJobName, "namespace", namespace)
-	return nil
-}
+	// The AMD GPU operator is always installed with Helm and has no workload protection
+	if isAMD(gpuOperator) {
+		return r.finalizeWithSDK(ctx, gpuOperator, namespace, amdReleaseName)
+	}
 
-// isJobCompleted checks if a Job has completed successfully
-func (r *GpuOperatorReconciler) isJobCompleted(ctx context.Context, namespace, jobName string) (bool, error) {
-	job := &batchv1.Job{}
-	err := r.Get(ctx, types.NamespacedName{Name: jobName, Namespace: namespace}, job)
-	if err != nil {
-		if apierrors.IsNotFound(err) {
-			return false, nil
+	// Evict the GPU workloads first, or keep the GPU stack while they still use it, if requested
+	if drained, err := r.drainGPUWorkloads(ctx, gpuOperator, namespace); err != nil || !drained {
+		if err == nil {
+			r.reportDeletionProgress(ctx, gpuOperator, "DrainingWorkloads",
+				"Evicting GPU workloads before uninstalling, see the GPUWorkloadsDrained condition")
 		}
 		return false, err
 	}
-
-	// Check if job completed successfully
-	for _, condition := range job.Status.Conditions {
-		if condition.Type == batchv1.JobComplete && condition.Status == corev1.ConditionTrue {
-			return true, nil
-		}
-		if condition.Type == batchv1.JobFailed && condition.Status == corev1.ConditionTrue {
-			return false, fmt.Errorf("helm installation job failed: %s", condition.Message)
+	if blocked, err := r.blockUninstallForWorkloads(ctx, gpuOperator, namespace); err != nil || blocked {
+		if err == nil {
+			r.reportDeletionProgress(ctx, gpuOperator, "WaitingForWorkloads",
+				"Uninstall blocked by running GPU workloads, see the UninstallBlocked condition")
 		}
+		return false, err
 	}
 
-	return false, nil
+	if gpuOperator.Spec.InstallEngine == operatorv1alpha1.InstallEngineManifest {
+		return r.finalizeManifests(ctx, gpuOperator, namespace)
+	}
+	return r.finalizeWithSDK(ctx, gpuOperator, namespace, draReleaseName, helmReleaseName)
 }
 
-func (r *GpuOperatorReconciler) finalizeGpuOperator(ctx context.Context, gpuOperator *operatorv1alpha1.GpuOperator) error {
+// finalizeManifests removes the objects applied by the Manifest install engine and reports whether
+// finalization is done. It follows the same deadline as the Helm uninstall.
+func (r *GpuOperatorReconciler) finalizeManifests(ctx context.Context, gpuOperator *operatorv1alpha1.GpuOperator, namespace string) (bool, error) {
 	logger := log.FromContext(ctx)
-	logger.Info("Finalizing GpuOperator")
 
-	// Set status to Deleting
-	gpuOperator.Status.State = operatorv1alpha1.StateDeleting
-	if err := r.Status().Update(ctx, gpuOperator); err != nil {
-		logger.Error(err, "Failed to update GpuOperator status to Deleting")
-	}
-
-	namespace := gpuOperator.Spec.Namespace
-	if namespace == "" {
-		namespace = "gpu-operator"
-	}
-
-	// Create uninstall job
-	logger.Info("Creating Helm uninstall job")
-	uninstallJob := &batchv1.Job{
-		ObjectMeta: metav1.ObjectMeta{
-			Name:      uninstallJobName,
-			Namespace: namespace,
-			Labels: map[string]string{
-				"app.kubernetes.io/name":       "gpu-operator-uninstaller",
-				"app.kubernetes.io/managed-by": "gpu-operator-module",
-				"app.kubernetes.io/component":  "uninstaller",
-			},
-		},
-		Spec: batchv1.JobSpec{
-			TTLSecondsAfterFinished: ptr.To[int32](60),
-			BackoffLimit:            ptr.To[int32](2),
-			Template: corev1.PodTemplateSpec{
-				Spec: corev1.PodSpec{
-					ServiceAccountName: "gpu-operator",
-					RestartPolicy:      corev1.RestartPolicyOnFailure,
-					Containers: []corev1.Container{
-						{
-							Name:    "helm-uninstaller",
-							Image:   helmImage,
-							Command: []string{"/bin/sh", "-c"},
-							Args: []string{
-								fmt.Sprintf(`
-set -e
-echo "Uninstalling NVIDIA GPU Operator"
-helm uninstall gpu-operator -n %s || true
-echo "GPU Operator uninstalled successfully"
-`, namespace),
-							},
-						},
-					},
-				},
-			},
-		},
-	}
-
-	if err := r.Create(ctx, uninstallJob); err != nil && !apierrors.IsAlreadyExists(err) {
-		logger.Error(err, "Failed to create uninstall job, continuing with cleanup")
+	remaining, err := r.removeManifests(ctx, gpuOperator, namespace)
+	if err == nil && len(remaining) == 0 {
+		r.finishFinalization(ctx, gpuOperator, namespace)
+		return true, nil
+	}
+
+	deadline := uninstallDeadline(gpuOperator)
+	if time.Now().Before(deadline) {
+		if err != nil {
+			logger.Error(err, "Failed to remove GPU operator manifests, will retry", "deadline", deadline)
+		} else {
+			logger.Info("Waiting for GPU operator objects to be deleted", "remaining", len(remaining), "deadline", deadline)
+		}
+		r.reportDeletionProgress(ctx, gpuOperator, "RemovingObjects",
+			fmt.Sprintf("Waiting for %d GPU operator objects to be deleted, forced cleanup at %s",
+				len(remaining), deadline.UTC().Format(time.RFC3339)))
+		return false, nil
+	}
+
+	logger.Info("GPU operator objects were not deleted before deadline, forcing cleanup", "deadline", deadline)
+	r.recordForcedUninstall(ctx, gpuOperator, "UninstallTimeout", append(remaining, r.forceCleanup(ctx, namespace, helmReleaseName)...))
+	r.finishFinalization(ctx, gpuOperator, namespace)
+	return true, nil
+}
+
+// finishFinalization removes the cluster-scoped leftovers that are independent of the install engine
+func (r *GpuOperatorReconciler) finishFinalization(ctx context.Context, gpuOperator *operatorv1alpha1.GpuOperator, namespace string) {
+	logger := log.FromContext(ctx)
+	if isAMD(gpuOperator) {
+		r.finishAMDFinalization(ctx, gpuOperator, namespace)
+		return
+	}
+	if err := r.deleteRemoteModuleObjects(ctx, namespace); err != nil {
+		logger.Error(err, "Failed to delete module objects in target cluster, continuing with cleanup")
+	}
+	if err := r.deleteGFDLabelRules(ctx); err != nil {
+		logger.Error(err, "Failed to delete GFD extra label rules, continuing with cleanup")
+	}
+	if err := r.restoreDevicePluginNodes(ctx, nil); err != nil {
+		logger.Error(err, "Failed to restore device plugin on nodes with reserved GPUs, continuing with cleanup")
+	}
+	if err := r.selectTimeSlicingConfigs(ctx, nil); err != nil {
+		logger.Error(err, "Failed to remove time-slicing config selections from nodes, continuing with cleanup")
+	}
+	if err := r.removeInstallerLeftovers(ctx, gpuOperator, namespace); err != nil {
+		logger.Error(err, "Failed to remove installer leftovers, continuing with cleanup")
+	}
+	if err := r.restoreOperandNodes(ctx); err != nil {
+		logger.Error(err, "Failed to restore operands on excluded GPU nodes, continuing with cleanup")
+	}
+	if err := r.selectMIGProfiles(ctx, nil); err != nil {
+		logger.Error(err, "Failed to remove MIG profile selections from nodes, continuing with cleanup")
+	}
+	if err := r.deleteKueueFlavors(ctx, nil); err != nil {
+		logger.Error(err, "Failed to delete Kueue ResourceFlavors, continuing with cleanup")
+	}
+	if err := r.deleteMonitoring(ctx, namespace); err != nil {
+		logger.Error(err, "Failed to delete DCGM Exporter monitoring, continuing with cleanup")
+	}
+	if err := r.markUnhealthyNodes(ctx, gpuOperator, nil, false); err != nil {
+		logger.Error(err, "Failed to restore nodes marked for unhealthy GPUs, continuing with cleanup")
+	}
+	if err := r.deleteNVIDIACRDs(ctx, gpuOperator); err != nil {
+		logger.Error(err, "Failed to delete NVIDIA CRDs, continuing with cleanup")
+	}
+	if err := r.cleanupInstallationNamespace(ctx, gpuOperator, namespace); err != nil {
+		logger.Error(err, "Failed to clean up installation namespace, continuing with cleanup")
 	}
 
 	logger.Info("Successfully finalized GpuOperator")
-	return nil
 }
 
 func (r *GpuOperatorReconciler) updateStatusError(ctx context.Context, gpuOperator *operatorv1alpha1.GpuOperator, err error) (ctrl.Result, error) {
-	gpuOperator.Status.State = operatorv1alpha1.StateError
 	errorCondition := metav1.Condition{
 		Type:               conditionTypeReady,
 		Status:             metav1.ConditionFalse,
 		Reason:             "ReconciliationFailed",
 		Message:            err.Error(),
 		ObservedGeneration: gpuOperator.Generation,
-		LastTransitionTime: metav1.Now(),
 	}
-	gpuOperator.Status.Conditions = []metav1.Condition{errorCondition}
+	var orphans *orphanedResourcesError
+	if errors.As(err, &orphans) {
+		errorCondition.Reason = conditionTypeOrphanedResources
+	}
+	var duplicate *duplicateInstanceError
+	if errors.As(err, &duplicate) {
+		errorCondition.Reason = "DuplicateInstance"
+	}
+	var preflight *preflightError
+	if errors.As(err, &preflight) {
+		errorCondition.Reason = preflightFailedReason
+	}
+	var downgrade *downgradeBlockedError
+	if errors.As(err, &downgrade) {
+		errorCondition.Reason = conditionTypeDowngradeBlocked
+	}
+
+	failuresCounter.WithLabelValues(failureEventReason(err, errorCondition.Reason)).Inc()
+	if last := gpuOperator.Status.LastOperation; last != nil {
+		recordOperationEnd(client.ObjectKeyFromObject(gpuOperator).String(), last.Operation, operatorv1alpha1.OperationFailed)
+	}
 
-	if statusErr := r.Status().Update(ctx, gpuOperator); statusErr != nil {
+	// Publish a failure that keeps repeating, e.g. during an outage, at most once per failureStatusInterval
+	occurrence := r.failures.Observe(client.ObjectKeyFromObject(gpuOperator).String(),
+		fmt.Sprintf("%d/%s/%s", gpuOperator.Generation, errorCondition.Reason, errorCondition.Message))
+	if !occurrence.Publish {
+		log.FromContext(ctx).V(1).Info("Failure repeated, skipping status update", "count", occurrence.Count)
+		return ctrl.Result{}, err
+	}
+	setLastOperation(gpuOperator, operatorv1alpha1.OperationFailed, errorCondition.Message)
+	errorCondition.Message = occurrence.Summary(errorCondition.Message)
+	r.event(gpuOperator, corev1.EventTypeWarning, failureEventReason(err, errorCondition.Reason), "%s", err.Error())
+
+	gpuOperator.Status.State = operatorv1alpha1.StateError
+	gpuOperator.Status.ObservedGeneration = gpuOperator.Generation
+	gpuOperator.Status.ModuleVersion = r.ModuleVersion
+	conditions := []metav1.Condition{errorCondition}
+	// Operands cannot run while the GPU nodes are gone, so failures are expected until
+	// the wake-up, which is only detected while the Hibernated condition is kept
+	if hibernated := meta.FindStatusCondition(gpuOperator.Status.Conditions, conditionTypeHibernated); hibernated != nil {
+		if hibernated.Status == metav1.ConditionTrue {
+			gpuOperator.Status.State = operatorv1alpha1.StateWarning
+		}
+		conditions = append(conditions, *hibernated)
+	}
+	if orphans != nil {
+		conditions = append(conditions, metav1.Condition{
+			Type:               conditionTypeOrphanedResources,
+			Status:             metav1.ConditionTrue,
+			Reason:             "PreviousInstallationFound",
+			Message:            strings.Join(orphans.resources, ", "),
+			ObservedGeneration: gpuOperator.Generation,
+		})
+	}
+	if downgrade != nil {
+		conditions = append(conditions, metav1.Condition{
+			Type:               conditionTypeDowngradeBlocked,
+			Status:             metav1.ConditionTrue,
+			Reason:             "VersionLowerThanInstalled",
+			Message:            downgrade.Error(),
+			ObservedGeneration: gpuOperator.Generation,
+		})
+	}
+	conditions = append(conditions, releaseRecoveredConditions(gpuOperator)...)
+	conditions = append(conditions, preflightConditions(gpuOperator)...)
+	setConditions(gpuOperator, conditions...)
+
+	if statusErr := r.updateStatus(ctx, gpuOperator); statusErr != nil {
 		log.FromContext(ctx).Error(statusErr, "Failed to update status")
 	}
 
 	return ctrl.Result{}, err
 }
 
+// gpuOperatorChangedPredicate passes the changes of the spec, labels and annotations of the CR, e.g. the reinstall
+// annotation. Status-only updates, including the ones the controller writes itself, do not trigger a reconcile.
+var gpuOperatorChangedPredicate = predicate.Or[client.Object](predicate.GenerationChangedPredicate{},
+	predicate.AnnotationChangedPredicate{}, predicate.LabelChangedPredicate{})
+
 func (r *GpuOperatorReconciler) SetupWithManager(mgr ctrl.Manager) error {
-	return ctrl.NewControllerManagedBy(mgr).
-		For(&operatorv1alpha1.GpuOperator{}).
-		Owns(&batchv1.Job{}).
-		Owns(&corev1.Namespace{}).
-		Complete(r)
+	r.remoteClients = newRemoteClientCache()
+	r.failures = noise.NewFilter(failureStatusInterval)
+	r.helmClient = helm.New(filepath.Join(os.TempDir(), "helm"), mgr.GetLogger().WithName(logging.SubsystemHelm))
+	r.restConfig = mgr.GetConfig()
+	c, err := ctrl.NewControllerManagedBy(mgr).
+		For(&operatorv1alpha1.GpuOperator{}, builder.WithPredicates(gpuOperatorChangedPredicate)).
+		// Replace the default namespace/name fields with the stable cr field, so that
+		// the namespace field always refers to the installation namespace
+		WithLogConstructor(func(req *reconcile.Request) logr.Logger {
+			logger := mgr.GetLogger().WithValues("controller", "gpuoperator")
+			if req != nil {
+				logger = logger.WithValues(logging.KeyCR, req.String())
+			}
+			return logger
+		}).
+		Owns(&batchv1.CronJob{}, builder.WithPredicates(predicate.GenerationChangedPredicate{})).
+		// Restore the installation namespace right away; it is cluster-scoped and cannot be owned by the CR
+		Watches(&corev1.Namespace{}, handler.EnqueueRequestsFromMapFunc(r.gpuOperatorForNamespace),
+			builder.WithPredicates(moduleNamespaceChangedPredicate)).
+		// Let a duplicate GpuOperator take over once the active one is deleted
+		Watches(&operatorv1alpha1.GpuOperator{}, handler.EnqueueRequestsFromMapFunc(r.gpuOperatorsForRemovedInstance),
+			builder.WithPredicates(instanceRemovedPredicate)).
+		// Validate GPU nodes as soon as they join, and keep the status current when their GPU labels or allocatable GPUs change
+		Watches(&corev1.Node{}, handler.EnqueueRequestsFromMapFunc(r.gpuOperatorsForNode),
+			builder.WithPredicates(predicate.Or[client.Object](gpuNodeJoinedPredicate, gpuNodeLabelsChangedPredicate,
+				gpuNodeCapacityChangedPredicate, amdGPUNodeChangedPredicate))).
+		// Install edits of the custom values right away
+		Watches(&corev1.ConfigMap{}, handler.EnqueueRequestsFromMapFunc(r.gpuOperatorsForValuesConfigMap),
+			builder.WithPredicates(valuesConfigMapPredicate)).
+		// Keep status.components and the readiness current as the operands roll out
+		Watches(&appsv1.DaemonSet{}, handler.EnqueueRequestsFromMapFunc(r.gpuOperatorsForComponent),
+			builder.WithPredicates(componentRolloutChangedPredicate)).
+		Watches(&appsv1.Deployment{}, handler.EnqueueRequestsFromMapFunc(r.gpuOperatorsForComponent),
+			builder.WithPredicates(componentRolloutChangedPredicate)).
+		// Keep status.pendingGpuPods current as GPU pods become unschedulable or get scheduled
+		Watches(&corev1.Pod{}, handler.EnqueueRequestsFromMapFunc(r.gpuOperatorsForPendingPod),
+			builder.WithPredicates(pendingForGPUsChangedPredicate)).
+		Build(r)
+	if err != nil {
+		return err
+	}
+	r.controller = c
+	r.cache = mgr.GetCache()
+	r.clusterPolicyWatch = &lazyWatch{}
+	return nil
 }
@@ -0,0 +1,83 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	operatorv1alpha1 "github.com/kyma-project/gpu-operator/api/v1alpha1"
+	"github.com/kyma-project/gpu-operator/internal/logging"
+)
+
+// conditionTypeReleaseRecovered records the last recovery of a stuck Helm release
+const conditionTypeReleaseRecovered = "ReleaseRecovered"
+
+// pendingReleaseStatuses are set by Helm while an operation runs. Until the release leaves them, every
+// other operation fails with "another operation (install/upgrade/rollback) is in progress".
+var pendingReleaseStatuses = []string{"pending-install", "pending-upgrade", "pending-rollback"}
+
+// recoverStuckRelease detects a Helm release left in a pending status by a Helm operation that no longer runs,
+// e.g. after the controller was restarted mid-apply. Helm operations only run within a reconcile, so a pending
+// status seen before the install is always stale. It deletes the pending revision, which is the lock Helm
+// checks, so the install retries the operation.
+func (r *GpuOperatorReconciler) recoverStuckRelease(ctx context.Context, gpuOperator *operatorv1alpha1.GpuOperator, namespace string) error {
+	revision, status, err := r.latestHelmRelease(ctx, namespace)
+	if err != nil || !slices.Contains(pendingReleaseStatuses, status) {
+		return err
+	}
+	logger := log.FromContext(ctx).WithName(logging.SubsystemHelm)
+
+	if err := r.DeleteAllOf(ctx, &corev1.Secret{}, client.InNamespace(namespace), client.MatchingLabels{
+		"owner": "helm", "name": helmReleaseName, "version": strconv.Itoa(revision),
+	}); err != nil {
+		return fmt.Errorf("failed to delete stuck Helm release revision %d: %w", revision, err)
+	}
+	logger.Info("Deleted stuck Helm release revision", "revision", revision, "status", status)
+
+	condition := metav1.Condition{
+		Type:   conditionTypeReleaseRecovered,
+		Status: metav1.ConditionTrue,
+		Reason: "StuckReleaseCleared",
+		Message: fmt.Sprintf("Helm release %s was stuck in %s at revision %d without a running Helm operation, "+
+			"deleted the revision and retried the installation", helmReleaseName, status, revision),
+		ObservedGeneration: gpuOperator.Generation,
+	}
+	meta.SetStatusCondition(&gpuOperator.Status.Conditions, condition)
+	r.event(gpuOperator, corev1.EventTypeWarning, condition.Reason, "%s", condition.Message)
+	if err := r.updateStatus(ctx, gpuOperator); err != nil {
+		return fmt.Errorf("failed to record Helm release recovery: %w", err)
+	}
+	return nil
+}
+
+// releaseRecoveredConditions returns the ReleaseRecovered condition of the CR, if any, to keep it when
+// the conditions are rebuilt
+func releaseRecoveredConditions(gpuOperator *operatorv1alpha1.GpuOperator) []metav1.Condition {
+	if condition := meta.FindStatusCondition(gpuOperator.Status.Conditions, conditionTypeReleaseRecovered); condition != nil {
+		return []metav1.Condition{*condition}
+	}
+	return nil
+}
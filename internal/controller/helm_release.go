@@ -0,0 +1,85 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	operatorv1alpha1 "github.com/kyma-project/gpu-operator/api/v1alpha1"
+)
+
+// latestHelmRelease returns the latest revision of the GPU operator Helm release and its status label,
+// e.g. deployed or pending-install, or 0 if none exists. Helm stores every release revision as a Secret
+// labeled with owner=helm, name=<release> and version=<revision>.
+func (r *GpuOperatorReconciler) latestHelmRelease(ctx context.Context, namespace string) (int, string, error) {
+	secrets := &corev1.SecretList{}
+	if err := r.List(ctx, secrets, client.InNamespace(namespace),
+		client.MatchingLabels{"owner": "helm", "name": helmReleaseName}); err != nil {
+		return 0, "", fmt.Errorf("failed to list Helm release secrets: %w", err)
+	}
+
+	revision, status := 0, ""
+	for _, secret := range secrets.Items {
+		version, err := strconv.Atoi(secret.Labels["version"])
+		if err != nil {
+			continue
+		}
+		if version > revision {
+			revision, status = version, secret.Labels["status"]
+		}
+	}
+	return revision, status, nil
+}
+
+// installedRelease describes what the installation actually deployed, which differs from the spec if the
+// chart defaults apply or custom values override the spec
+type installedRelease struct {
+	chartVersion  string
+	appVersion    string
+	driverVersion string
+}
+
+// installedRelease reads the chart version, app version and effective driver version of the deployed GPU operator
+// release. The Manifest install engine leaves no Helm release, its versions are read from the ClusterPolicy.
+func (r *GpuOperatorReconciler) installedRelease(ctx context.Context, gpuOperator *operatorv1alpha1.GpuOperator, namespace string) (installedRelease, error) {
+	if gpuOperator.Spec.InstallEngine == operatorv1alpha1.InstallEngineManifest {
+		clusterPolicy, err := r.releaseClusterPolicy(ctx)
+		if err != nil || clusterPolicy == nil {
+			return installedRelease{}, err
+		}
+		installed := installedRelease{
+			chartVersion: strings.TrimPrefix(clusterPolicy.GetLabels()[helmChartLabel], helmReleaseName+"-"),
+		}
+		installed.driverVersion, _, _ = unstructured.NestedString(clusterPolicy.Object, "spec", "driver", "version")
+		return installed, nil
+	}
+
+	deployed, _, err := r.helmClient.Latest(r.restConfig, namespace, helmReleaseName)
+	if err != nil || deployed == nil {
+		return installedRelease{}, err
+	}
+	installed := installedRelease{chartVersion: deployed.ChartVersion, appVersion: deployed.AppVersion}
+	installed.driverVersion, _, _ = unstructured.NestedString(deployed.Values, "driver", "version")
+	return installed, nil
+}
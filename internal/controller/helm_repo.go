@@ -0,0 +1,97 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	operatorv1alpha1 "github.com/kyma-project/gpu-operator/api/v1alpha1"
+	"github.com/kyma-project/gpu-operator/internal/helm"
+)
+
+const (
+	helmRepoUsernameKey = "username"
+	helmRepoPasswordKey = "password"
+	helmRepoTokenKey    = "token"
+)
+
+// helmRepoURL returns the URL of the NVIDIA or AMD Helm repository or of its mirror
+func helmRepoURL(gpuOperator *operatorv1alpha1.GpuOperator) string {
+	if gpuOperator.Spec.HelmRepo != nil {
+		return gpuOperator.Spec.HelmRepo.URL
+	}
+	if isAMD(gpuOperator) {
+		return amdHelmRepo
+	}
+	if registry := gpuOperator.Spec.Registry; registry != nil && registry.HelmRepoURL != "" {
+		return registry.HelmRepoURL
+	}
+	return nvidiaHelmRepo
+}
+
+// helmRepoCredentialsRef returns the Secret with the credentials of the Helm repository, nil for anonymous access
+func helmRepoCredentialsRef(gpuOperator *operatorv1alpha1.GpuOperator) *operatorv1alpha1.SecretReference {
+	if gpuOperator.Spec.HelmRepo == nil {
+		return nil
+	}
+	return gpuOperator.Spec.HelmRepo.CredentialsSecretRef
+}
+
+// helmRepoCredentials reads the credentials of the Helm repository from spec.helmRepo.credentialsSecretRef
+func (r *GpuOperatorReconciler) helmRepoCredentials(ctx context.Context, gpuOperator *operatorv1alpha1.GpuOperator) (helm.Credentials, error) {
+	ref := helmRepoCredentialsRef(gpuOperator)
+	if ref == nil {
+		return helm.Credentials{}, nil
+	}
+	secret := &corev1.Secret{}
+	if err := r.localReader().Get(ctx, types.NamespacedName{Name: ref.Name, Namespace: gpuOperator.Namespace}, secret); err != nil {
+		return helm.Credentials{}, fmt.Errorf("failed to get Helm repository credentials %s: %w", ref.Name, err)
+	}
+	if token := string(secret.Data[helmRepoTokenKey]); token != "" {
+		return helm.Credentials{Token: token}, nil
+	}
+	credentials := helm.Credentials{
+		Username: string(secret.Data[helmRepoUsernameKey]),
+		Password: string(secret.Data[helmRepoPasswordKey]),
+	}
+	if credentials.Username == "" || credentials.Password == "" {
+		return helm.Credentials{}, fmt.Errorf("secret %s must have the keys %s and %s, or the key %s",
+			ref.Name, helmRepoUsernameKey, helmRepoPasswordKey, helmRepoTokenKey)
+	}
+	return credentials, nil
+}
+
+// helmChart returns the reference of a chart in the Helm repository, with the CA bundle, proxy and credentials
+// the repository is accessed with
+func (r *GpuOperatorReconciler) helmChart(ctx context.Context, gpuOperator *operatorv1alpha1.GpuOperator, name, version string) (helm.Chart, error) {
+	credentials, err := r.helmRepoCredentials(ctx, gpuOperator)
+	if err != nil {
+		return helm.Chart{}, err
+	}
+	return helm.Chart{
+		RepoURL:     helmRepoURL(gpuOperator),
+		Name:        name,
+		Version:     version,
+		CABundle:    registryCABundle(gpuOperator),
+		Proxy:       helmProxy(gpuOperator),
+		Credentials: credentials,
+	}, nil
+}
@@ -0,0 +1,176 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"helm.sh/helm/v3/pkg/release"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	operatorv1alpha1 "github.com/kyma-project/gpu-operator/api/v1alpha1"
+	"github.com/kyma-project/gpu-operator/internal/logging"
+)
+
+// The controller runs Helm itself, so it needs the permissions of the objects the charts create: the GPU operator
+// and DRA driver charts, and the AMD GPU operator chart with the Kernel Module Management operator and their
+// webhooks. Helm stores the releases in Secrets.
+// +kubebuilder:rbac:groups="",resources=secrets,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=resource.k8s.io,resources=deviceclasses,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=admissionregistration.k8s.io,resources=validatingadmissionpolicies;validatingadmissionpolicybindings;validatingwebhookconfigurations;mutatingwebhookconfigurations,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=amd.com,resources=deviceconfigs,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=kmm.sigs.x-k8s.io,resources=modules;nodemodulesconfigs,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=cert-manager.io,resources=certificates;issuers,verbs=get;list;watch;create;update;patch;delete
+
+// installWithSDK installs or upgrades the GPU operator release, and the DRA driver release, with the Helm SDK.
+// Helm only runs if the install hash differs from status.installHash, a release is not deployed, or the
+// force-reinstall annotation has a new value, and the operands are not waited for. It returns the deployed revision of the GPU operator
+// release and the install hash of the releases.
+func (r *GpuOperatorReconciler) installWithSDK(ctx context.Context, gpuOperator *operatorv1alpha1.GpuOperator, namespace string) (int, string, error) {
+	logger := log.FromContext(ctx).WithName(logging.SubsystemHelm)
+
+	values, err := r.baseValues(ctx, gpuOperator)
+	if err != nil {
+		return 0, "", err
+	}
+	if err := setChartValues(values, r.installValues(gpuOperator)); err != nil {
+		return 0, "", err
+	}
+	chart, err := r.helmChart(ctx, gpuOperator, helmReleaseName, chartVersion(gpuOperator))
+	if err != nil {
+		return 0, "", err
+	}
+	releases := []releaseInstall{{name: helmReleaseName, chart: chart, values: values}}
+	if draEnabled(gpuOperator) {
+		draChart, err := r.helmChart(ctx, gpuOperator, draReleaseName, "")
+		if err != nil {
+			return 0, "", err
+		}
+		releases = append(releases, releaseInstall{name: draReleaseName, chart: draChart, values: map[string]interface{}{
+			"nvidiaDriverRoot":            draDriverRoot,
+			"gpuResourcesEnabledOverride": true,
+		}})
+	}
+	hash, err := installHash(releases...)
+	if err != nil {
+		return 0, "", err
+	}
+
+	forceReinstall := forceReinstallRequested(gpuOperator)
+	revision := 0
+	if forceReinstall == "" {
+		if revision, err = r.upToDateRevision(gpuOperator, namespace, hash, releases...); err != nil {
+			return 0, "", err
+		}
+	}
+	if revision == 0 {
+		if revision, err = r.upgradeReleases(ctx, namespace, forceReinstall != "", releases...); err != nil {
+			return 0, "", err
+		}
+	}
+	logger.V(1).Info("GPU operator release is up to date", "revision", revision, "installHash", hash)
+	if forceReinstall != "" {
+		logger.Info("Forced Helm upgrade of GPU operator", "forceReinstall", forceReinstall, "revision", revision)
+		if err := r.recordForceReinstall(ctx, gpuOperator, forceReinstall); err != nil {
+			return 0, "", err
+		}
+	}
+
+	if !draEnabled(gpuOperator) {
+		// A DRA driver installed before is removed when spec.dra is disabled
+		if err := r.helmClient.Uninstall(r.restConfig, namespace, draReleaseName); err != nil {
+			return 0, "", err
+		}
+	}
+	return revision, hash, nil
+}
+
+// upToDateRevision returns the deployed revision of the first release if the releases were installed with the
+// given install hash and all of them are still deployed, zero if Helm has to run
+func (r *GpuOperatorReconciler) upToDateRevision(gpuOperator *operatorv1alpha1.GpuOperator, namespace, hash string,
+	releases ...releaseInstall) (int, error) {
+	if gpuOperator.Status.InstallHash != hash {
+		return 0, nil
+	}
+	revision := 0
+	for i, rel := range releases {
+		deployed, _, err := r.helmClient.Latest(r.restConfig, namespace, rel.name)
+		if err != nil {
+			return 0, err
+		}
+		if deployed == nil || deployed.Status != release.StatusDeployed {
+			return 0, nil
+		}
+		if i == 0 {
+			revision = deployed.Revision
+		}
+	}
+	return revision, nil
+}
+
+// upgradeReleases installs or upgrades the releases in the given order and returns the deployed revision of the
+// first one. A failed Helm operation is returned as installFailedError.
+func (r *GpuOperatorReconciler) upgradeReleases(ctx context.Context, namespace string, force bool, releases ...releaseInstall) (int, error) {
+	revision := 0
+	for i, rel := range releases {
+		deployed, err := r.helmClient.Upgrade(ctx, r.restConfig, namespace, rel.name, rel.chart, rel.values, force)
+		if err != nil {
+			return 0, &installFailedError{release: rel.name, err: err}
+		}
+		if i == 0 {
+			revision = deployed.Revision
+		}
+	}
+	return revision, nil
+}
+
+// finalizeWithSDK uninstalls the releases in the given order with the Helm SDK and reports whether finalization
+// is done. The last release is the GPU operator release, whose remaining resources are deleted if it cannot be
+// uninstalled before spec.uninstall.timeout.
+func (r *GpuOperatorReconciler) finalizeWithSDK(ctx context.Context, gpuOperator *operatorv1alpha1.GpuOperator, namespace string,
+	releases ...string) (bool, error) {
+	logger := log.FromContext(ctx)
+
+	var err error
+	for _, release := range releases {
+		if err = r.helmClient.Uninstall(r.restConfig, namespace, release); err != nil {
+			break
+		}
+	}
+	r.recordHelmAction(client.ObjectKeyFromObject(gpuOperator).String(), "uninstall", 0, err)
+	if err == nil {
+		logger.WithName(logging.SubsystemHelm).Info("Uninstalled GPU operator releases", "releases", releases)
+		r.finishFinalization(ctx, gpuOperator, namespace)
+		return true, nil
+	}
+
+	deadline := uninstallDeadline(gpuOperator)
+	if time.Now().Before(deadline) {
+		logger.Error(err, "Failed to uninstall GPU operator releases, will retry", "deadline", deadline)
+		r.reportDeletionProgress(ctx, gpuOperator, "UninstallingReleases",
+			fmt.Sprintf("Uninstalling the GPU operator releases failed, retrying until %s: %v",
+				deadline.UTC().Format(time.RFC3339), err))
+		return false, nil
+	}
+	logger.Error(err, "Failed to uninstall GPU operator releases before deadline, forcing cleanup", "deadline", deadline)
+	r.recordForcedUninstall(ctx, gpuOperator, "UninstallFailed", r.forceCleanup(ctx, namespace, releases[len(releases)-1]))
+	r.finishFinalization(ctx, gpuOperator, namespace)
+	return true, nil
+}
@@ -0,0 +1,138 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	operatorv1alpha1 "github.com/kyma-project/gpu-operator/api/v1alpha1"
+	"github.com/kyma-project/gpu-operator/internal/logging"
+)
+
+const (
+	conditionTypeHibernated = "Hibernated"
+
+	// nvidiaPCILabel is published by Node Feature Discovery on nodes with an NVIDIA PCI device
+	nvidiaPCILabel = "feature.node.kubernetes.io/pci-" + nvidiaPCIVendor + ".present"
+
+	// hibernationPollInterval is how often a hibernated installation checks whether GPU nodes returned
+	hibernationPollInterval = time.Minute
+)
+
+// hibernationState is the result of comparing the GPU nodes with the last observed hibernation condition
+type hibernationState struct {
+	// hibernated is set while no GPU node is ready after the GPU operator was installed
+	hibernated bool
+	// wokeUp is set on the first reconcile that sees GPU nodes again after hibernation
+	wokeUp bool
+	// previous is the Hibernated condition of the last reconcile, if any
+	previous *metav1.Condition
+}
+
+// observeHibernation detects a Gardener shoot hibernation, or GPU worker pools scaled to zero, from the
+// GPU nodes disappearing after the GPU operator was installed, and their return on wake-up
+func (r *GpuOperatorReconciler) observeHibernation(ctx context.Context, gpuOperator *operatorv1alpha1.GpuOperator) (hibernationState, error) {
+	state := hibernationState{
+		previous: meta.FindStatusCondition(gpuOperator.Status.Conditions, conditionTypeHibernated),
+	}
+	wasHibernated := state.previous != nil && state.previous.Status == metav1.ConditionTrue
+
+	// Nothing to protect before the first successful installation
+	if gpuOperator.Status.InstalledVersion == "" && !wasHibernated {
+		return state, nil
+	}
+
+	nodes, err := r.gpuNodes(ctx)
+	if err != nil {
+		return state, err
+	}
+	ready := 0
+	for i := range nodes {
+		if isNodeReady(&nodes[i]) {
+			ready++
+		}
+	}
+
+	state.hibernated = ready == 0
+	state.wokeUp = wasHibernated && !state.hibernated
+	logger := log.FromContext(ctx).WithName(logging.SubsystemHealth)
+	switch {
+	case state.hibernated && !wasHibernated:
+		logger.Info("No GPU nodes ready, pausing health evaluation until they return")
+	case state.wokeUp:
+		logger.Info("GPU nodes returned after hibernation, revalidating installation", "readyNodes", ready)
+	}
+	return state, nil
+}
+
+// revalidateAfterWakeUp drops the backoff of an installation that failed while the GPU nodes were gone, so the
+// GPU operator is reinstalled right away. The scheduled smoke tests are triggered by reconcileSmokeTests.
+func (r *GpuOperatorReconciler) revalidateAfterWakeUp(ctx context.Context, gpuOperator *operatorv1alpha1.GpuOperator) {
+	if gpuOperator.Status.InstallRetry == nil {
+		return
+	}
+	gpuOperator.Status.InstallRetry = nil
+	log.FromContext(ctx).WithName(logging.SubsystemHelm).Info("Retrying the installation that failed during hibernation")
+}
+
+// gpuNodes returns the nodes with an NVIDIA PCI device as detected by Node Feature Discovery.
+// Unlike the GFD labels, the NFD label does not depend on a working driver.
+func (r *GpuOperatorReconciler) gpuNodes(ctx context.Context) ([]corev1.Node, error) {
+	nodes := &corev1.NodeList{}
+	if err := r.List(ctx, nodes, client.MatchingLabels{nvidiaPCILabel: "true"}); err != nil {
+		return nil, fmt.Errorf("failed to list GPU nodes: %w", err)
+	}
+	return nodes.Items, nil
+}
+
+// isNodeReady reports whether the node's Ready condition is true
+func isNodeReady(node *corev1.Node) bool {
+	for _, condition := range node.Status.Conditions {
+		if condition.Type == corev1.NodeReady {
+			return condition.Status == corev1.ConditionTrue
+		}
+	}
+	return false
+}
+
+// hibernationCondition returns the Hibernated condition to report, nil if the installation was never hibernated
+func hibernationCondition(gpuOperator *operatorv1alpha1.GpuOperator, state hibernationState) *metav1.Condition {
+	if !state.hibernated && state.previous == nil {
+		return nil
+	}
+	condition := &metav1.Condition{
+		Type:               conditionTypeHibernated,
+		Status:             metav1.ConditionTrue,
+		Reason:             "NoGPUNodes",
+		Message:            "No GPU nodes are ready; the shoot is hibernated or its GPU worker pools are scaled to zero. Health evaluation is paused",
+		ObservedGeneration: gpuOperator.Generation,
+	}
+	if !state.hibernated {
+		condition.Status = metav1.ConditionFalse
+		condition.Reason = "GPUNodesReady"
+		condition.Message = "GPU nodes are ready; the installation was revalidated after wake-up"
+	}
+	return condition
+}
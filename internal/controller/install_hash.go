@@ -0,0 +1,56 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+
+	operatorv1alpha1 "github.com/kyma-project/gpu-operator/api/v1alpha1"
+	"github.com/kyma-project/gpu-operator/internal/helm"
+)
+
+// releaseInstall is a Helm release the installation installs or upgrades
+type releaseInstall struct {
+	name   string
+	chart  helm.Chart
+	values map[string]interface{}
+}
+
+// installHash hashes the inputs of an installation: the name, chart and values of every release. The values
+// are hashed by their JSON encoding, so the order of their keys does not matter. The credentials, CA bundle and
+// proxy of the Helm repository only affect how the chart is downloaded and are left out.
+func installHash(releases ...releaseInstall) (string, error) {
+	sum := sha256.New()
+	for _, release := range releases {
+		valuesHash, err := helm.ValuesHash(release.values)
+		if err != nil {
+			return "", err
+		}
+		sum.Write([]byte(strings.Join([]string{release.name, release.chart.RepoURL, release.chart.Name,
+			release.chart.Version, valuesHash}, "\x00")))
+		sum.Write([]byte{0})
+	}
+	return hex.EncodeToString(sum.Sum(nil))[:16], nil
+}
+
+// resetInstallHash forgets the inputs of the last installation, so the next reconcile runs Helm again even if
+// the spec is unchanged
+func resetInstallHash(gpuOperator *operatorv1alpha1.GpuOperator) {
+	gpuOperator.Status.InstallHash = ""
+}
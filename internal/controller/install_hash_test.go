@@ -0,0 +1,133 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"testing"
+
+	"sigs.k8s.io/yaml"
+
+	"github.com/kyma-project/gpu-operator/internal/helm"
+)
+
+func TestInstallHash(t *testing.T) {
+	chart := helm.Chart{RepoURL: nvidiaHelmRepo, Name: "gpu-operator", Version: "v25.3.0"}
+	values := `
+driver:
+  version: "570"
+  usePrecompiled: true
+toolkit:
+  env:
+  - name: CONTAINERD_SOCKET
+    value: /run/containerd/containerd.sock
+`
+	base := []releaseInstall{{name: helmReleaseName, chart: chart, values: parseValues(t, values)}}
+
+	tests := []struct {
+		name     string
+		releases []releaseInstall
+		same     bool
+	}{
+		{
+			name:     "identical inputs",
+			releases: []releaseInstall{{name: helmReleaseName, chart: chart, values: parseValues(t, values)}},
+			same:     true,
+		},
+		{
+			name: "keys in another order",
+			releases: []releaseInstall{{name: helmReleaseName, chart: chart, values: parseValues(t, `
+toolkit:
+  env:
+  - value: /run/containerd/containerd.sock
+    name: CONTAINERD_SOCKET
+driver:
+  usePrecompiled: true
+  version: "570"
+`)}},
+			same: true,
+		},
+		{
+			name: "other repository credentials, CA bundle and proxy",
+			releases: []releaseInstall{{name: helmReleaseName, chart: helm.Chart{
+				RepoURL: chart.RepoURL, Name: chart.Name, Version: chart.Version,
+				CABundle:    "-----BEGIN CERTIFICATE-----",
+				Proxy:       helm.Proxy{HTTPSProxy: "http://proxy.example.com:3128"},
+				Credentials: helm.Credentials{Token: "token"},
+			}, values: parseValues(t, values)}},
+			same: true,
+		},
+		{
+			name: "other value",
+			releases: []releaseInstall{{name: helmReleaseName, chart: chart, values: parseValues(t, `
+driver:
+  version: "580"
+  usePrecompiled: true
+toolkit:
+  env:
+  - name: CONTAINERD_SOCKET
+    value: /run/containerd/containerd.sock
+`)}},
+		},
+		{
+			name: "other chart version",
+			releases: []releaseInstall{{name: helmReleaseName, chart: helm.Chart{
+				RepoURL: chart.RepoURL, Name: chart.Name, Version: "v25.10.0",
+			}, values: parseValues(t, values)}},
+		},
+		{
+			name: "other repository",
+			releases: []releaseInstall{{name: helmReleaseName, chart: helm.Chart{
+				RepoURL: "https://charts.example.internal/nvidia", Name: chart.Name, Version: chart.Version,
+			}, values: parseValues(t, values)}},
+		},
+		{
+			name: "additional release",
+			releases: []releaseInstall{
+				{name: helmReleaseName, chart: chart, values: parseValues(t, values)},
+				{name: draReleaseName, chart: helm.Chart{RepoURL: nvidiaHelmRepo, Name: "nvidia-dra-driver-gpu"}},
+			},
+		},
+	}
+	want, err := installHash(base...)
+	if err != nil {
+		t.Fatalf("installHash: %v", err)
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := installHash(tt.releases...)
+			if err != nil {
+				t.Fatalf("installHash: %v", err)
+			}
+			if again, _ := installHash(tt.releases...); again != got {
+				t.Errorf("installHash is not stable: %s, then %s", got, again)
+			}
+			if same := got == want; same != tt.same {
+				t.Errorf("installHash() = %s, base hash %s, want same = %t", got, want, tt.same)
+			}
+		})
+	}
+}
+
+// parseValues parses a Helm values file
+func parseValues(t *testing.T, data string) map[string]interface{} {
+	t.Helper()
+	values := map[string]interface{}{}
+	if err := yaml.Unmarshal([]byte(data), &values); err != nil {
+		t.Fatalf("invalid values: %v", err)
+	}
+	return values
+}
@@ -0,0 +1,147 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	operatorv1alpha1 "github.com/kyma-project/gpu-operator/api/v1alpha1"
+)
+
+const (
+	defaultInstallRetryInterval = 30 * time.Second
+	maxInstallRetryInterval     = 30 * time.Minute
+
+	// Names of the Jobs earlier versions of the module ran Helm in, and of their ServiceAccount
+	legacyInstallJobName              = "gpu-operator-install"
+	legacyUninstallJobName            = "gpu-operator-uninstall"
+	legacyInstallerServiceAccountName = "gpu-operator"
+)
+
+// installFailedError is returned when the Helm install or upgrade of a release failed. The installation is
+// retried with backoff, other errors of the install step are retried right away.
+type installFailedError struct {
+	release string
+	err     error
+}
+
+func (e *installFailedError) Error() string {
+	return fmt.Sprintf("helm installation of %s failed: %v", e.release, e.err)
+}
+
+func (e *installFailedError) Unwrap() error {
+	return e.err
+}
+
+// installRetryDelay returns the wait after the given number of failed attempts: spec.install.retryInterval,
+// doubled for every further attempt, at most 30m
+func installRetryDelay(gpuOperator *operatorv1alpha1.GpuOperator, attempts int32) time.Duration {
+	delay := defaultInstallRetryInterval
+	if install := gpuOperator.Spec.Install; install != nil && install.RetryInterval != nil {
+		delay = install.RetryInterval.Duration
+	}
+	for i := int32(1); i < attempts && delay < maxInstallRetryInterval; i++ {
+		delay *= 2
+	}
+	return min(delay, maxInstallRetryInterval)
+}
+
+// installRetryWait returns how long the next installation attempt has to wait, zero if it can start. The
+// backoff of failed attempts does not apply to a changed spec or a requested force-reinstall.
+func installRetryWait(gpuOperator *operatorv1alpha1.GpuOperator) time.Duration {
+	retry := gpuOperator.Status.InstallRetry
+	if retry == nil || retry.ObservedGeneration != gpuOperator.Generation || forceReinstallRequested(gpuOperator) != "" {
+		return 0
+	}
+	return max(time.Until(retry.NextRetryTime.Time), 0)
+}
+
+// retryInstall records a failed installation in status.installRetry and schedules the next attempt with
+// exponential backoff
+func (r *GpuOperatorReconciler) retryInstall(ctx context.Context, gpuOperator *operatorv1alpha1.GpuOperator, installErr error) (ctrl.Result, error) {
+	retry := gpuOperator.Status.InstallRetry
+	if retry == nil || retry.ObservedGeneration != gpuOperator.Generation {
+		retry = &operatorv1alpha1.InstallRetryStatus{ObservedGeneration: gpuOperator.Generation}
+	}
+	retry.Attempts++
+	delay := installRetryDelay(gpuOperator, retry.Attempts)
+	now := time.Now()
+	retry.LastFailureTime = metav1.NewTime(now)
+	retry.NextRetryTime = metav1.NewTime(now.Add(delay))
+	gpuOperator.Status.InstallRetry = retry
+
+	// The error is not returned, the requeue after the backoff replaces the rate-limited retry
+	_, _ = r.updateStatusError(ctx, gpuOperator, fmt.Errorf("installation attempt %d failed, retrying in %s: %w",
+		retry.Attempts, delay, installErr))
+	log.FromContext(ctx).Info("Scheduled retry of the failed installation", "attempts", retry.Attempts, "retryIn", delay)
+	return ctrl.Result{RequeueAfter: delay}, nil
+}
+
+// installerLeftoversRemoved holds the CRs whose installer leftovers were removed since the controller started
+var installerLeftoversRemoved sync.Map
+
+// removeInstallerLeftovers deletes what earlier versions of the module created in the installation namespace to
+// run Helm in Jobs: the installer and uninstaller Jobs and their ServiceAccount. A ServiceAccount adopted by a
+// Helm release is kept. It runs once per CR after the controller starts, and reads the objects by name through
+// the API reader, so no informers are started for them. Those versions only installed into the local cluster.
+func (r *GpuOperatorReconciler) removeInstallerLeftovers(ctx context.Context, gpuOperator *operatorv1alpha1.GpuOperator, namespace string) error {
+	cr := client.ObjectKeyFromObject(gpuOperator).String()
+	if _, removed := installerLeftoversRemoved.Load(cr); removed || r.remote {
+		return nil
+	}
+	var reader client.Reader = r.Client
+	if r.APIReader != nil {
+		reader = r.APIReader
+	}
+
+	leftovers := []client.Object{
+		&batchv1.Job{ObjectMeta: metav1.ObjectMeta{Name: legacyInstallJobName, Namespace: namespace}},
+		&batchv1.Job{ObjectMeta: metav1.ObjectMeta{Name: legacyUninstallJobName, Namespace: namespace}},
+		&corev1.ServiceAccount{ObjectMeta: metav1.ObjectMeta{Name: legacyInstallerServiceAccountName, Namespace: namespace}},
+	}
+	logger := log.FromContext(ctx)
+	for _, obj := range leftovers {
+		if err := reader.Get(ctx, client.ObjectKeyFromObject(obj), obj); err != nil {
+			if apierrors.IsNotFound(err) {
+				continue
+			}
+			return fmt.Errorf("failed to get installer leftover %s: %w", describeObject(obj), err)
+		}
+		if _, adopted := obj.GetAnnotations()["meta.helm.sh/release-name"]; adopted ||
+			obj.GetLabels()["app.kubernetes.io/managed-by"] != "gpu-operator-module" || obj.GetDeletionTimestamp() != nil {
+			continue
+		}
+		if err := r.Delete(ctx, obj, client.PropagationPolicy(metav1.DeletePropagationBackground)); err != nil &&
+			!apierrors.IsNotFound(err) {
+			return fmt.Errorf("failed to delete installer leftover %s: %w", describeObject(obj), err)
+		}
+		logger.Info("Deleted leftover of the installer jobs", "object", describeObject(obj))
+	}
+	installerLeftoversRemoved.Store(cr, struct{}{})
+	return nil
+}
@@ -0,0 +1,109 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	operatorv1alpha1 "github.com/kyma-project/gpu-operator/api/v1alpha1"
+)
+
+func TestRemoveInstallerLeftovers(t *testing.T) {
+	const namespace = "gpu-operator"
+	managedByModule := map[string]string{"app.kubernetes.io/managed-by": "gpu-operator-module"}
+	meta := func(name string, labels, annotations map[string]string) metav1.ObjectMeta {
+		return metav1.ObjectMeta{Name: name, Namespace: namespace, Labels: labels, Annotations: annotations}
+	}
+
+	tests := []struct {
+		name        string
+		objects     []client.Object
+		wantDeleted bool
+	}{
+		{
+			name:        "install Job",
+			objects:     []client.Object{&batchv1.Job{ObjectMeta: meta(legacyInstallJobName, managedByModule, nil)}},
+			wantDeleted: true,
+		},
+		{
+			name:        "uninstall Job",
+			objects:     []client.Object{&batchv1.Job{ObjectMeta: meta(legacyUninstallJobName, managedByModule, nil)}},
+			wantDeleted: true,
+		},
+		{
+			name:        "installer ServiceAccount",
+			objects:     []client.Object{&corev1.ServiceAccount{ObjectMeta: meta(legacyInstallerServiceAccountName, managedByModule, nil)}},
+			wantDeleted: true,
+		},
+		{
+			name: "ServiceAccount adopted by the release",
+			objects: []client.Object{&corev1.ServiceAccount{ObjectMeta: meta(legacyInstallerServiceAccountName, managedByModule,
+				map[string]string{"meta.helm.sh/release-name": helmReleaseName})}},
+		},
+		{
+			name:    "ServiceAccount of the chart",
+			objects: []client.Object{&corev1.ServiceAccount{ObjectMeta: meta(legacyInstallerServiceAccountName, nil, nil)}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gpuOperator := &operatorv1alpha1.GpuOperator{ObjectMeta: metav1.ObjectMeta{Name: "gpu-operator", Namespace: "default"}}
+			defer installerLeftoversRemoved.Delete(client.ObjectKeyFromObject(gpuOperator).String())
+			r := &GpuOperatorReconciler{Client: fake.NewClientBuilder().WithObjects(tt.objects...).Build()}
+
+			if err := r.removeInstallerLeftovers(context.Background(), gpuOperator, namespace); err != nil {
+				t.Fatalf("removeInstallerLeftovers: %v", err)
+			}
+			for _, obj := range tt.objects {
+				err := r.Get(context.Background(), client.ObjectKeyFromObject(obj), obj)
+				if deleted := apierrors.IsNotFound(err); deleted != tt.wantDeleted {
+					t.Errorf("%s deleted = %t, want %t (%v)", describeObject(obj), deleted, tt.wantDeleted, err)
+				}
+			}
+		})
+	}
+}
+
+func TestRemoveInstallerLeftoversOnce(t *testing.T) {
+	gpuOperator := &operatorv1alpha1.GpuOperator{ObjectMeta: metav1.ObjectMeta{Name: "gpu-operator", Namespace: "default"}}
+	defer installerLeftoversRemoved.Delete(client.ObjectKeyFromObject(gpuOperator).String())
+	r := &GpuOperatorReconciler{Client: fake.NewClientBuilder().Build()}
+	if err := r.removeInstallerLeftovers(context.Background(), gpuOperator, "gpu-operator"); err != nil {
+		t.Fatalf("removeInstallerLeftovers: %v", err)
+	}
+
+	// A later reconcile of the same CR does not look for leftovers again
+	job := &batchv1.Job{ObjectMeta: metav1.ObjectMeta{Name: legacyInstallJobName, Namespace: "gpu-operator",
+		Labels: map[string]string{"app.kubernetes.io/managed-by": "gpu-operator-module"}}}
+	if err := r.Create(context.Background(), job); err != nil {
+		t.Fatalf("Create: %v", err)
+	}
+	if err := r.removeInstallerLeftovers(context.Background(), gpuOperator, "gpu-operator"); err != nil {
+		t.Fatalf("removeInstallerLeftovers: %v", err)
+	}
+	if err := r.Get(context.Background(), client.ObjectKeyFromObject(job), job); err != nil {
+		t.Errorf("Job of the installer removed by the second run: %v", err)
+	}
+}
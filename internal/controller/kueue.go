@@ -0,0 +1,139 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	operatorv1alpha1 "github.com/kyma-project/gpu-operator/api/v1alpha1"
+)
+
+const (
+	kueueFlavorComponent = "kueue-resource-flavor"
+	kueueFlavorPrefix    = "gpu-"
+)
+
+var resourceFlavorGVK = schema.GroupVersionKind{
+	Group:   "kueue.x-k8s.io",
+	Version: "v1beta1",
+	Kind:    "ResourceFlavor",
+}
+
+// invalidFlavorNameChars matches the characters of a GPU product that are not allowed in an object name
+var invalidFlavorNameChars = regexp.MustCompile(`[^a-z0-9.-]+`)
+
+// +kubebuilder:rbac:groups=kueue.x-k8s.io,resources=resourceflavors,verbs=get;list;watch;create;update;patch;delete
+
+// reconcileKueueFlavors keeps one ResourceFlavor per GPU model reported by GFD in sync with the GPU nodes if
+// spec.kueue.resourceFlavors is set. With the single MIG strategy, GFD reports the MIG profile as part of the
+// product, so every MIG profile gets its own flavor. Flavors of models that left the cluster are deleted,
+// except while hibernated, when all GPU nodes are gone only temporarily.
+func (r *GpuOperatorReconciler) reconcileKueueFlavors(ctx context.Context, gpuOperator *operatorv1alpha1.GpuOperator, hibernated bool) error {
+	enabled := gpuOperator.Spec.Kueue != nil && gpuOperator.Spec.Kueue.ResourceFlavors
+	if !enabled {
+		return r.deleteKueueFlavors(ctx, nil)
+	}
+	logger := log.FromContext(ctx)
+	if _, err := r.RESTMapper().RESTMapping(resourceFlavorGVK.GroupKind(), resourceFlavorGVK.Version); err != nil {
+		if meta.IsNoMatchError(err) {
+			logger.Info("Kueue is not installed, not generating ResourceFlavors")
+			return nil
+		}
+		return fmt.Errorf("failed to check for Kueue: %w", err)
+	}
+
+	nodes, err := r.gpuNodes(ctx)
+	if err != nil {
+		return err
+	}
+	products := map[string]string{}
+	for _, node := range nodes {
+		if product := node.Labels[gpuProductLabel]; product != "" {
+			products[resourceFlavorName(product)] = product
+		}
+	}
+
+	for name, product := range products {
+		flavor := &unstructured.Unstructured{}
+		flavor.SetGroupVersionKind(resourceFlavorGVK)
+		flavor.SetName(name)
+		result, err := controllerutil.CreateOrUpdate(ctx, r.Client, flavor, func() error {
+			flavor.SetLabels(map[string]string{
+				"app.kubernetes.io/name":       "gpu-operator",
+				"app.kubernetes.io/managed-by": "gpu-operator-module",
+				"app.kubernetes.io/component":  kueueFlavorComponent,
+			})
+			return unstructured.SetNestedStringMap(flavor.Object, map[string]string{gpuProductLabel: product}, "spec", "nodeLabels")
+		})
+		if err != nil {
+			return fmt.Errorf("failed to reconcile ResourceFlavor %s: %w", name, err)
+		}
+		if result != controllerutil.OperationResultNone {
+			logger.Info("Reconciled Kueue ResourceFlavor", "resourceFlavor", name, "product", product, "operation", result)
+		}
+	}
+
+	if hibernated {
+		return nil
+	}
+	return r.deleteKueueFlavors(ctx, products)
+}
+
+// deleteKueueFlavors deletes the generated ResourceFlavors except the given ones. Kueue keeps a flavor
+// that is still referenced by a ClusterQueue until the reference is removed.
+func (r *GpuOperatorReconciler) deleteKueueFlavors(ctx context.Context, keep map[string]string) error {
+	flavors := &unstructured.UnstructuredList{}
+	flavors.SetGroupVersionKind(resourceFlavorGVK.GroupVersion().WithKind(resourceFlavorGVK.Kind + "List"))
+	if err := r.List(ctx, flavors, client.MatchingLabels{
+		"app.kubernetes.io/managed-by": "gpu-operator-module",
+		"app.kubernetes.io/component":  kueueFlavorComponent,
+	}); err != nil {
+		if meta.IsNoMatchError(err) {
+			return nil
+		}
+		return fmt.Errorf("failed to list ResourceFlavors: %w", err)
+	}
+	for i := range flavors.Items {
+		flavor := &flavors.Items[i]
+		if _, ok := keep[flavor.GetName()]; ok || flavor.GetDeletionTimestamp() != nil {
+			continue
+		}
+		if err := r.Delete(ctx, flavor); err != nil && !apierrors.IsNotFound(err) {
+			return fmt.Errorf("failed to delete ResourceFlavor %s: %w", flavor.GetName(), err)
+		}
+		log.FromContext(ctx).Info("Deleted Kueue ResourceFlavor of GPU model no longer in the cluster", "resourceFlavor", flavor.GetName())
+	}
+	return nil
+}
+
+// resourceFlavorName derives the ResourceFlavor name of a GPU product, e.g. gpu-nvidia-a100-sxm4-40gb-mig-1g.5gb
+// for NVIDIA-A100-SXM4-40GB-MIG-1g.5gb
+func resourceFlavorName(product string) string {
+	name := invalidFlavorNameChars.ReplaceAllString(strings.ToLower(product), "-")
+	return kueueFlavorPrefix + strings.Trim(name, "-.")
+}
@@ -0,0 +1,76 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	operatorv1alpha1 "github.com/kyma-project/gpu-operator/api/v1alpha1"
+)
+
+// nextOperation returns the operation a new generation of the spec starts: an upgrade once an installation
+// succeeded, the installation before
+func nextOperation(gpuOperator *operatorv1alpha1.GpuOperator) operatorv1alpha1.Operation {
+	last := gpuOperator.Status.LastOperation
+	if last == nil || last.Operation == operatorv1alpha1.OperationInstall && last.State != operatorv1alpha1.OperationSucceeded {
+		return operatorv1alpha1.OperationInstall
+	}
+	return operatorv1alpha1.OperationUpgrade
+}
+
+// startOperation records the start of an operation in status.lastOperation
+func startOperation(gpuOperator *operatorv1alpha1.GpuOperator, operation operatorv1alpha1.Operation, description string) {
+	gpuOperator.Status.LastOperation = &operatorv1alpha1.LastOperation{
+		Operation:      operation,
+		State:          operatorv1alpha1.OperationProcessing,
+		Description:    description,
+		LastUpdateTime: metav1.Now(),
+	}
+}
+
+// setLastOperation records the stage the current operation reached in status.lastOperation and reports
+// whether it changed. The time is only updated for a new stage, not when a stage is reconciled again.
+func setLastOperation(gpuOperator *operatorv1alpha1.GpuOperator, state operatorv1alpha1.OperationState, description string) bool {
+	last := gpuOperator.Status.LastOperation
+	if last == nil {
+		startOperation(gpuOperator, nextOperation(gpuOperator), description)
+		gpuOperator.Status.LastOperation.State = state
+		return true
+	}
+	if last.State == state && last.Description == description {
+		return false
+	}
+	last.State = state
+	last.Description = description
+	last.LastUpdateTime = metav1.Now()
+	return true
+}
+
+// reportLastOperation records the stage the current operation reached and writes it to the status right away,
+// for stages the reconcile waits in without updating the status otherwise
+func (r *GpuOperatorReconciler) reportLastOperation(ctx context.Context, gpuOperator *operatorv1alpha1.GpuOperator,
+	state operatorv1alpha1.OperationState, description string) {
+	if !setLastOperation(gpuOperator, state, description) {
+		return
+	}
+	if err := r.updateStatus(ctx, gpuOperator); err != nil {
+		log.FromContext(ctx).Error(err, "Failed to update the last operation", "description", description)
+	}
+}
@@ -0,0 +1,347 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	utilyaml "k8s.io/apimachinery/pkg/util/yaml"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	operatorv1alpha1 "github.com/kyma-project/gpu-operator/api/v1alpha1"
+)
+
+const (
+	// manifestInventoryName is the ConfigMap recording the objects applied by the Manifest engine,
+	// so objects dropped from the rendered manifests can be pruned
+	manifestInventoryName = "gpu-operator-manifest-inventory"
+	manifestInventoryKey  = "objects"
+	manifestFieldOwner    = "gpu-operator-module"
+
+	// renderedNamespace is the namespace the chart is rendered into at build time; it is
+	// rewritten to the installation namespace when the manifests are applied
+	renderedNamespace = "gpu-operator"
+)
+
+// applyOrder makes sure namespaces, CRDs and RBAC exist before the objects depending on them
+var applyOrder = map[string]int{
+	"Namespace":                0,
+	"CustomResourceDefinition": 1,
+	"ServiceAccount":           2,
+	"ClusterRole":              3,
+	"ClusterRoleBinding":       4,
+	"Role":                     3,
+	"RoleBinding":              4,
+	"ConfigMap":                5,
+	"Secret":                   5,
+	"Service":                  6,
+}
+
+// +kubebuilder:rbac:groups=apiextensions.k8s.io,resources=customresourcedefinitions,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=apps,resources=daemonsets;deployments,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups="",resources=services;serviceaccounts;configmaps,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=rbac.authorization.k8s.io,resources=roles;rolebindings;clusterroles;clusterrolebindings,verbs=get;list;watch;create;update;patch;delete;escalate;bind
+// +kubebuilder:rbac:groups=nvidia.com,resources=clusterpolicies;nvidiadrivers,verbs=get;list;watch;create;update;patch;delete
+
+// manifestObjectRef identifies an object applied by the Manifest engine
+type manifestObjectRef struct {
+	APIVersion string `json:"apiVersion"`
+	Kind       string `json:"kind"`
+	Namespace  string `json:"namespace,omitempty"`
+	Name       string `json:"name"`
+}
+
+func (ref manifestObjectRef) String() string {
+	if ref.Namespace == "" {
+		return ref.Kind + "/" + ref.Name
+	}
+	return ref.Kind + "/" + ref.Namespace + "/" + ref.Name
+}
+
+// applyManifests installs the GPU operator from the pre-rendered manifests with server-side apply
+// and prunes the objects applied previously that are no longer part of the manifests
+func (r *GpuOperatorReconciler) applyManifests(ctx context.Context, gpuOperator *operatorv1alpha1.GpuOperator, namespace string) error {
+	logger := log.FromContext(ctx)
+
+	objects, err := readManifests(r.Config.Get().ManifestsPath)
+	if err != nil {
+		return err
+	}
+
+	values := r.chartValues(gpuOperator)
+	applied := make([]manifestObjectRef, 0, len(objects))
+	for _, obj := range objects {
+		if err := r.prepareManifestObject(obj, namespace); err != nil {
+			return err
+		}
+		if err := applyChartValues(values, obj); err != nil {
+			return err
+		}
+		if err := applyRegistry(gpuOperator, obj); err != nil {
+			return err
+		}
+		if err := r.Patch(ctx, obj, client.Apply, client.FieldOwner(manifestFieldOwner), client.ForceOwnership); err != nil {
+			if meta.IsNoMatchError(err) {
+				// The CRD was applied earlier in this pass and is not served yet
+				return fmt.Errorf("waiting for the API of %s to become available: %w", obj.GetKind(), err)
+			}
+			return fmt.Errorf("failed to apply %s/%s: %w", obj.GetKind(), obj.GetName(), err)
+		}
+		applied = append(applied, manifestObjectRef{
+			APIVersion: obj.GetAPIVersion(),
+			Kind:       obj.GetKind(),
+			Namespace:  obj.GetNamespace(),
+			Name:       obj.GetName(),
+		})
+	}
+
+	previous, err := r.readManifestInventory(ctx, namespace)
+	if err != nil {
+		return err
+	}
+	current := make(map[manifestObjectRef]bool, len(applied))
+	for _, ref := range applied {
+		current[ref] = true
+	}
+	var stale []manifestObjectRef
+	for _, ref := range previous {
+		if !current[ref] {
+			stale = append(stale, ref)
+		}
+	}
+	if _, err := r.deleteManifestObjects(ctx, stale); err != nil {
+		return err
+	}
+
+	if err := r.writeManifestInventory(ctx, gpuOperator, namespace, applied); err != nil {
+		return err
+	}
+	logger.Info("Applied GPU operator manifests", "objects", len(applied), "pruned", len(stale))
+	return nil
+}
+
+// removeManifests deletes every object recorded in the inventory and returns the ones that still exist.
+// The CRDs are kept unless spec.uninstall.deleteCRDs is set.
+func (r *GpuOperatorReconciler) removeManifests(ctx context.Context, gpuOperator *operatorv1alpha1.GpuOperator, namespace string) ([]string, error) {
+	refs, err := r.readManifestInventory(ctx, namespace)
+	if err != nil {
+		return nil, err
+	}
+	if !deleteCRDs(gpuOperator) {
+		kept := refs[:0]
+		for _, ref := range refs {
+			if ref.Kind != crdGVK.Kind {
+				kept = append(kept, ref)
+			}
+		}
+		refs = kept
+	}
+	// Delete in reverse apply order so CRDs and RBAC go last
+	for i, j := 0, len(refs)-1; i < j; i, j = i+1, j-1 {
+		refs[i], refs[j] = refs[j], refs[i]
+	}
+	remaining, err := r.deleteManifestObjects(ctx, refs)
+	if err != nil {
+		return nil, err
+	}
+	if len(remaining) == 0 {
+		inventory := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: manifestInventoryName, Namespace: namespace}}
+		if err := r.Delete(ctx, inventory); err != nil && !apierrors.IsNotFound(err) {
+			return nil, fmt.Errorf("failed to delete manifest inventory: %w", err)
+		}
+	}
+	return remaining, nil
+}
+
+// deleteManifestObjects deletes the referenced objects and returns the ones that are still present
+func (r *GpuOperatorReconciler) deleteManifestObjects(ctx context.Context, refs []manifestObjectRef) ([]string, error) {
+	var remaining []string
+	for _, ref := range refs {
+		obj := &unstructured.Unstructured{}
+		obj.SetAPIVersion(ref.APIVersion)
+		obj.SetKind(ref.Kind)
+		obj.SetNamespace(ref.Namespace)
+		obj.SetName(ref.Name)
+		err := r.Delete(ctx, obj, client.PropagationPolicy(metav1.DeletePropagationBackground))
+		switch {
+		case err == nil:
+			remaining = append(remaining, ref.String())
+		case apierrors.IsNotFound(err) || meta.IsNoMatchError(err):
+		default:
+			return nil, fmt.Errorf("failed to delete %s: %w", ref, err)
+		}
+	}
+	return remaining, nil
+}
+
+// prepareManifestObject moves namespaced objects into the installation namespace and labels them
+func (r *GpuOperatorReconciler) prepareManifestObject(obj *unstructured.Unstructured, namespace string) error {
+	namespaced, err := r.IsObjectNamespaced(obj)
+	if err != nil && !meta.IsNoMatchError(err) {
+		return fmt.Errorf("failed to determine scope of %s: %w", obj.GetKind(), err)
+	}
+	// Kinds that are not known yet are CRs of CRDs applied in the same pass; the rendered
+	// namespace tells whether they are namespaced
+	if namespaced || (err != nil && obj.GetNamespace() != "") {
+		obj.SetNamespace(namespace)
+	}
+	if obj.GetKind() == "Namespace" && obj.GetName() == renderedNamespace {
+		obj.SetName(namespace)
+	}
+
+	if kind := obj.GetKind(); kind == "ClusterRoleBinding" || kind == "RoleBinding" {
+		subjects, _, _ := unstructured.NestedSlice(obj.Object, "subjects")
+		for _, subject := range subjects {
+			if s, ok := subject.(map[string]interface{}); ok && s["namespace"] == renderedNamespace {
+				s["namespace"] = namespace
+			}
+		}
+		if len(subjects) > 0 {
+			if err := unstructured.SetNestedSlice(obj.Object, subjects, "subjects"); err != nil {
+				return err
+			}
+		}
+	}
+
+	labels := obj.GetLabels()
+	if labels == nil {
+		labels = map[string]string{}
+	}
+	labels["app.kubernetes.io/managed-by"] = "gpu-operator-module"
+	obj.SetLabels(labels)
+	obj.SetManagedFields(nil)
+	obj.SetResourceVersion("")
+	return nil
+}
+
+// readManifests decodes all YAML documents of the files in the given directory (or the given file),
+// ordered so that dependencies are applied first
+func readManifests(path string) ([]*unstructured.Unstructured, error) {
+	files := []string{path}
+	if info, err := os.Stat(path); err != nil {
+		return nil, fmt.Errorf("rendered manifests not found at %s: %w", path, err)
+	} else if info.IsDir() {
+		entries, err := os.ReadDir(path)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read manifests directory %s: %w", path, err)
+		}
+		files = files[:0]
+		for _, entry := range entries {
+			if ext := filepath.Ext(entry.Name()); !entry.IsDir() && (ext == ".yaml" || ext == ".yml") {
+				files = append(files, filepath.Join(path, entry.Name()))
+			}
+		}
+	}
+
+	var objects []*unstructured.Unstructured
+	for _, file := range files {
+		data, err := os.ReadFile(file)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read manifest %s: %w", file, err)
+		}
+		decoder := utilyaml.NewYAMLOrJSONDecoder(bytes.NewReader(data), 4096)
+		for {
+			obj := &unstructured.Unstructured{}
+			if err := decoder.Decode(&obj.Object); err != nil {
+				if errors.Is(err, io.EOF) {
+					break
+				}
+				return nil, fmt.Errorf("failed to decode manifest %s: %w", file, err)
+			}
+			if len(obj.Object) == 0 {
+				continue
+			}
+			if obj.GetKind() == "" || obj.GetName() == "" {
+				return nil, fmt.Errorf("manifest %s contains an object without kind or name", file)
+			}
+			objects = append(objects, obj)
+		}
+	}
+	if len(objects) == 0 {
+		return nil, fmt.Errorf("no manifests found at %s", path)
+	}
+
+	sort.SliceStable(objects, func(i, j int) bool {
+		return applyRank(objects[i].GetKind()) < applyRank(objects[j].GetKind())
+	})
+	return objects, nil
+}
+
+func applyRank(kind string) int {
+	if rank, ok := applyOrder[kind]; ok {
+		return rank
+	}
+	return len(applyOrder)
+}
+
+func (r *GpuOperatorReconciler) readManifestInventory(ctx context.Context, namespace string) ([]manifestObjectRef, error) {
+	inventory := &corev1.ConfigMap{}
+	if err := r.Get(ctx, types.NamespacedName{Name: manifestInventoryName, Namespace: namespace}, inventory); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get manifest inventory: %w", err)
+	}
+	var refs []manifestObjectRef
+	if data := inventory.Data[manifestInventoryKey]; strings.TrimSpace(data) != "" {
+		if err := json.Unmarshal([]byte(data), &refs); err != nil {
+			return nil, fmt.Errorf("failed to decode manifest inventory: %w", err)
+		}
+	}
+	return refs, nil
+}
+
+func (r *GpuOperatorReconciler) writeManifestInventory(ctx context.Context, gpuOperator *operatorv1alpha1.GpuOperator,
+	namespace string, refs []manifestObjectRef) error {
+	data, err := json.Marshal(refs)
+	if err != nil {
+		return err
+	}
+	inventory := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: manifestInventoryName, Namespace: namespace}}
+	if _, err := controllerutil.CreateOrUpdate(ctx, r.Client, inventory, func() error {
+		inventory.Labels = map[string]string{
+			"app.kubernetes.io/name":       "gpu-operator",
+			"app.kubernetes.io/managed-by": "gpu-operator-module",
+			"app.kubernetes.io/component":  "manifest-inventory",
+		}
+		inventory.Annotations = map[string]string{
+			ownerAnnotation: ownerKey(gpuOperator),
+		}
+		inventory.Data = map[string]string{manifestInventoryKey: string(data)}
+		return nil
+	}); err != nil {
+		return fmt.Errorf("failed to write manifest inventory: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,128 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+
+	operatorv1alpha1 "github.com/kyma-project/gpu-operator/api/v1alpha1"
+)
+
+// gpuNodeTimeToAllocatable tracks how long new GPU nodes take until workloads can use their GPUs.
+// The median per pool is the main tuning signal for frequently replaced spot nodes.
+var gpuNodeTimeToAllocatable = prometheus.NewSummaryVec(prometheus.SummaryOpts{
+	Name:       "gpu_operator_node_ready_to_gpu_allocatable_seconds",
+	Help:       "Time from a GPU node becoming Ready until it advertises allocatable GPUs, per worker pool",
+	Objectives: map[float64]float64{0.5: 0.05, 0.9: 0.01},
+	MaxAge:     24 * time.Hour,
+}, []string{"pool"})
+
+// pendingGPUPodsGauge gives capacity planning the demand the GPU worker pools could not serve
+var pendingGPUPodsGauge = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+	Name: "gpu_operator_pending_gpu_pods",
+	Help: "Pods that cannot be scheduled because not enough nvidia.com/gpu is free, per namespace",
+}, []string{"namespace"})
+
+// allocatedGPUsGauge lets tenant dashboards show the GPU usage of their namespaces
+var allocatedGPUsGauge = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+	Name: "gpu_operator_allocated_gpus",
+	Help: "nvidia.com/gpu allocated to scheduled pods that have not terminated, per namespace",
+}, []string{"namespace"})
+
+// reconcileDuration complements the generic controller-runtime reconcile metrics with the outcome of the reconcile
+var reconcileDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+	Name:    "gpu_operator_reconcile_duration_seconds",
+	Help:    "Duration of the GpuOperator reconciles, per result: success or error",
+	Buckets: prometheus.ExponentialBuckets(0.05, 2, 12),
+}, []string{"result"})
+
+// operationDuration shows how long installations and upgrades take until they succeed or first fail
+var operationDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+	Name:    "gpu_operator_operation_duration_seconds",
+	Help:    "Duration of the GPU operator installations and upgrades, per operation and result",
+	Buckets: prometheus.ExponentialBuckets(15, 2, 10),
+}, []string{"operation", "result"})
+
+// stateGauge exposes status.state, 1 for the current state of the CR and 0 for the others
+var stateGauge = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+	Name: "gpu_operator_state",
+	Help: "State of the GpuOperator CR, 1 for the current state",
+}, []string{"namespace", "name", "state"})
+
+// failuresCounter counts the failed reconciles, also the ones the status does not report because they repeat
+var failuresCounter = prometheus.NewCounterVec(prometheus.CounterOpts{
+	Name: "gpu_operator_install_failures_total",
+	Help: "Failed reconciles of the GpuOperator CRs, per reason, e.g. InstallFailed or PreflightFailed",
+}, []string{"reason"})
+
+// gpuNodesGauge and allocatableGPUsGauge track the GPU capacity of the cluster
+var gpuNodesGauge = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+	Name: "gpu_operator_gpu_nodes",
+	Help: "GPU nodes in the cluster, per worker pool",
+}, []string{"pool"})
+
+var allocatableGPUsGauge = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+	Name: "gpu_operator_allocatable_gpus",
+	Help: "nvidia.com/gpu advertised by the GPU nodes, per worker pool",
+}, []string{"pool"})
+
+func init() {
+	metrics.Registry.MustRegister(gpuNodeTimeToAllocatable, pendingGPUPodsGauge, allocatedGPUsGauge,
+		reconcileDuration, operationDuration, stateGauge, failuresCounter, gpuNodesGauge, allocatableGPUsGauge)
+}
+
+// recordState sets the state gauge of the CR
+func recordState(gpuOperator *operatorv1alpha1.GpuOperator) {
+	for _, state := range []operatorv1alpha1.State{operatorv1alpha1.StateProcessing, operatorv1alpha1.StateReady,
+		operatorv1alpha1.StateWarning, operatorv1alpha1.StateError, operatorv1alpha1.StateDeleting} {
+		value := 0.0
+		if gpuOperator.Status.State == state {
+			value = 1
+		}
+		stateGauge.WithLabelValues(gpuOperator.Namespace, gpuOperator.Name, string(state)).Set(value)
+	}
+}
+
+// forgetState removes the state gauge of a deleted CR
+func forgetState(namespace, name string) {
+	stateGauge.DeletePartialMatch(prometheus.Labels{"namespace": namespace, "name": name})
+	operationStarts.Delete(namespace + "/" + name)
+}
+
+// operationStarts holds the start of the installation or upgrade in progress per CR. It is kept in memory only,
+// an operation in progress while the controller restarts is not measured.
+var operationStarts sync.Map
+
+// recordOperationStart records the start of an installation or upgrade of the CR
+func recordOperationStart(cr string) {
+	operationStarts.Store(cr, time.Now())
+}
+
+// recordOperationEnd observes the duration of the operation in progress of the CR, if any
+func recordOperationEnd(cr string, operation operatorv1alpha1.Operation, result operatorv1alpha1.OperationState) {
+	start, ok := operationStarts.LoadAndDelete(cr)
+	if !ok {
+		return
+	}
+	operationDuration.WithLabelValues(strings.ToLower(string(operation)), strings.ToLower(string(result))).
+		Observe(time.Since(start.(time.Time)).Seconds())
+}
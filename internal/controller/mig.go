@@ -0,0 +1,315 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/yaml"
+
+	operatorv1alpha1 "github.com/kyma-project/gpu-operator/api/v1alpha1"
+)
+
+const (
+	// migConfigMapName is the copy of the custom mig-parted configuration read by the MIG manager
+	migConfigMapName = "custom-mig-parted-config"
+	migConfigKey     = "config.yaml"
+
+	// migConfigLabel selects the mig-parted configuration applied to a node
+	migConfigLabel = "nvidia.com/mig.config"
+	// migDefaultConfig is the configuration the GPU operator applies to MIG capable nodes without a selection
+	migDefaultConfig = "all-disabled"
+	// migConfigStateLabel is set by the MIG manager to the state of applying the selected configuration
+	migConfigStateLabel = "nvidia.com/mig.config.state"
+	// migProfileLabel marks the nodes whose configuration the controller selects from spec.mig.profiles
+	migProfileLabel = "operator.kyma-project.io/mig-profile"
+)
+
+// migProfilePattern matches MIG device profiles such as 1g.10gb, 1g.10gb+me or 1c.3g.40gb
+var migProfilePattern = regexp.MustCompile(`^(\d+c\.)?\d+g\.\d+gb([+-][a-z]+)*$`)
+
+// migPartedConfig is the part of the mig-parted configuration file format that is validated
+type migPartedConfig struct {
+	Version    string                          `json:"version"`
+	MigConfigs map[string][]migPartedDeviceSet `json:"mig-configs"`
+}
+
+// migPartedDeviceSet configures MIG on a set of GPUs of a node
+type migPartedDeviceSet struct {
+	Devices    interface{}    `json:"devices"`
+	MigEnabled bool           `json:"mig-enabled"`
+	MigDevices map[string]int `json:"mig-devices,omitempty"`
+}
+
+// migValues returns the chart values of the MIG strategy and pointing the MIG manager to the custom configuration
+func migValues(gpuOperator *operatorv1alpha1.GpuOperator) []chartValue {
+	mig := gpuOperator.Spec.MIG
+	if mig == nil {
+		return nil
+	}
+	var values []chartValue
+	if mig.Strategy != "" {
+		values = append(values, chartValue{path: "mig.strategy", value: mig.Strategy})
+	}
+	if mig.ConfigMapRef != nil || len(mig.Profiles) > 0 {
+		values = append(values, chartValue{path: "migManager.config.name", value: migConfigMapName})
+	}
+	return values
+}
+
+// reconcileMIGConfig provides the custom mig-parted configuration to the MIG manager in the installation namespace:
+// the validated ConfigMap referenced by spec.mig.configMapRef, or the configuration generated from spec.mig.profiles,
+// which are selected on the matching nodes. The copy is removed when neither is set.
+func (r *GpuOperatorReconciler) reconcileMIGConfig(ctx context.Context, gpuOperator *operatorv1alpha1.GpuOperator, namespace string) error {
+	copied := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: migConfigMapName, Namespace: namespace}}
+	mig := gpuOperator.Spec.MIG
+	if mig == nil || mig.ConfigMapRef == nil && len(mig.Profiles) == 0 {
+		if err := r.selectMIGProfiles(ctx, nil); err != nil {
+			return err
+		}
+		if err := r.Delete(ctx, copied); err != nil && !apierrors.IsNotFound(err) {
+			return fmt.Errorf("failed to delete MIG config %s: %w", migConfigMapName, err)
+		}
+		return nil
+	}
+
+	var data, source string
+	if mig.ConfigMapRef != nil {
+		var err error
+		if data, err = r.migConfigMapData(ctx, gpuOperator, mig.ConfigMapRef); err != nil {
+			return err
+		}
+		source = "ConfigMap " + mig.ConfigMapRef.Name
+	} else {
+		rendered, err := yaml.Marshal(renderMIGProfiles(mig.Profiles))
+		if err != nil {
+			return fmt.Errorf("failed to render MIG profiles: %w", err)
+		}
+		data, source = string(rendered), "spec.mig.profiles"
+	}
+	config, err := parseMIGConfig(data)
+	if err != nil {
+		return fmt.Errorf("invalid MIG config in %s: %w", source, err)
+	}
+	// Update the selections of the profiles before all selections are checked against the configuration
+	if err := r.selectMIGProfiles(ctx, mig.Profiles); err != nil {
+		return err
+	}
+	if err := r.checkMIGConfigSelections(ctx, config); err != nil {
+		return fmt.Errorf("invalid MIG config in %s: %w", source, err)
+	}
+
+	if _, err := controllerutil.CreateOrUpdate(ctx, r.Client, copied, func() error {
+		copied.Labels = map[string]string{
+			"app.kubernetes.io/name":       "gpu-operator",
+			"app.kubernetes.io/managed-by": "gpu-operator-module",
+			"app.kubernetes.io/component":  "mig-config",
+		}
+		copied.Data = map[string]string{migConfigKey: data}
+		return nil
+	}); err != nil {
+		return fmt.Errorf("failed to reconcile MIG config %s: %w", migConfigMapName, err)
+	}
+	return nil
+}
+
+// migConfigMapData reads the mig-parted configuration from the ConfigMap referenced by spec.mig.configMapRef
+func (r *GpuOperatorReconciler) migConfigMapData(ctx context.Context, gpuOperator *operatorv1alpha1.GpuOperator,
+	ref *operatorv1alpha1.ConfigMapKeyReference) (string, error) {
+	key := ref.Key
+	if key == "" {
+		key = migConfigKey
+	}
+	source := &corev1.ConfigMap{}
+	if err := r.localReader().Get(ctx, types.NamespacedName{Name: ref.Name, Namespace: gpuOperator.Namespace}, source); err != nil {
+		return "", fmt.Errorf("failed to get MIG config ConfigMap %s: %w", ref.Name, err)
+	}
+	data, ok := source.Data[key]
+	if !ok {
+		return "", fmt.Errorf("MIG config ConfigMap %s has no key %s", ref.Name, key)
+	}
+	return data, nil
+}
+
+// renderMIGProfiles generates the mig-parted configuration of the profiles, which partition all GPUs of a node
+// the same way, next to the all-disabled default
+func renderMIGProfiles(profiles []operatorv1alpha1.MIGProfile) *migPartedConfig {
+	config := &migPartedConfig{
+		Version: "v1",
+		MigConfigs: map[string][]migPartedDeviceSet{
+			migDefaultConfig: {{Devices: "all", MigEnabled: false}},
+		},
+	}
+	for _, profile := range profiles {
+		deviceSet := migPartedDeviceSet{Devices: "all", MigEnabled: len(profile.Devices) > 0}
+		if len(profile.Devices) > 0 {
+			deviceSet.MigDevices = make(map[string]int, len(profile.Devices))
+			for device, count := range profile.Devices {
+				deviceSet.MigDevices[device] = int(count)
+			}
+		}
+		config.MigConfigs[profile.Name] = []migPartedDeviceSet{deviceSet}
+	}
+	return config
+}
+
+// selectMIGProfiles selects the configuration of the first matching profile on every GPU node with the
+// nvidia.com/mig.config label. Nodes that no longer match a profile get the all-disabled default back.
+func (r *GpuOperatorReconciler) selectMIGProfiles(ctx context.Context, profiles []operatorv1alpha1.MIGProfile) error {
+	nodes, err := r.gpuNodes(ctx)
+	if err != nil {
+		return err
+	}
+	for i := range nodes {
+		node := &nodes[i]
+		selected := ""
+		for _, profile := range profiles {
+			if labels.SelectorFromSet(profile.NodeSelector).Matches(labels.Set(node.Labels)) {
+				selected = profile.Name
+				break
+			}
+		}
+		managed := node.Labels[migProfileLabel]
+		if selected == managed && (selected == "" || node.Labels[migConfigLabel] == selected) {
+			continue
+		}
+		patch := client.MergeFrom(node.DeepCopy())
+		if node.Labels == nil {
+			node.Labels = map[string]string{}
+		}
+		if selected != "" {
+			node.Labels[migConfigLabel] = selected
+			node.Labels[migProfileLabel] = selected
+		} else {
+			node.Labels[migConfigLabel] = migDefaultConfig
+			delete(node.Labels, migProfileLabel)
+		}
+		if err := r.Patch(ctx, node, patch); err != nil {
+			return fmt.Errorf("failed to label node %s: %w", node.Name, err)
+		}
+	}
+	return nil
+}
+
+// migStatus reports the MIG configuration selected on the GPU nodes and the state of applying it, nil if
+// spec.mig is not set
+func (r *GpuOperatorReconciler) migStatus(ctx context.Context, gpuOperator *operatorv1alpha1.GpuOperator) (*operatorv1alpha1.MIGStatus, error) {
+	if gpuOperator.Spec.MIG == nil {
+		return nil, nil
+	}
+	nodes, err := r.gpuNodes(ctx)
+	if err != nil {
+		return nil, err
+	}
+	status := &operatorv1alpha1.MIGStatus{}
+	for i := range nodes {
+		config := nodes[i].Labels[migConfigLabel]
+		if config == "" {
+			continue
+		}
+		status.Nodes = append(status.Nodes, operatorv1alpha1.MIGNodeStatus{
+			Name:   nodes[i].Name,
+			Config: config,
+			State:  nodes[i].Labels[migConfigStateLabel],
+		})
+	}
+	sort.Slice(status.Nodes, func(i, j int) bool { return status.Nodes[i].Name < status.Nodes[j].Name })
+	return status, nil
+}
+
+// parseMIGConfig parses a mig-parted configuration and checks that every configuration is well-formed
+func parseMIGConfig(data string) (*migPartedConfig, error) {
+	config := &migPartedConfig{}
+	if err := yaml.Unmarshal([]byte(data), config); err != nil {
+		return nil, fmt.Errorf("failed to parse: %w", err)
+	}
+	if config.Version != "v1" {
+		return nil, fmt.Errorf("unsupported version %q, expected v1", config.Version)
+	}
+	if len(config.MigConfigs) == 0 {
+		return nil, fmt.Errorf("no mig-configs defined")
+	}
+
+	names := make([]string, 0, len(config.MigConfigs))
+	for name := range config.MigConfigs {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		deviceSets := config.MigConfigs[name]
+		if len(deviceSets) == 0 {
+			return nil, fmt.Errorf("mig-config %s has no device sets", name)
+		}
+		for i, deviceSet := range deviceSets {
+			if deviceSet.Devices == nil {
+				return nil, fmt.Errorf("mig-config %s[%d]: devices is required", name, i)
+			}
+			if !deviceSet.MigEnabled && len(deviceSet.MigDevices) > 0 {
+				return nil, fmt.Errorf("mig-config %s[%d]: mig-devices requires mig-enabled", name, i)
+			}
+			for profile, count := range deviceSet.MigDevices {
+				if !migProfilePattern.MatchString(profile) {
+					return nil, fmt.Errorf("mig-config %s[%d]: invalid MIG profile %q", name, i, profile)
+				}
+				if count < 1 {
+					return nil, fmt.Errorf("mig-config %s[%d]: count of MIG profile %s must be positive", name, i, profile)
+				}
+			}
+		}
+	}
+	return config, nil
+}
+
+// checkMIGConfigSelections checks that the default configuration and the configurations selected by
+// the nvidia.com/mig.config labels of the GPU nodes exist
+func (r *GpuOperatorReconciler) checkMIGConfigSelections(ctx context.Context, config *migPartedConfig) error {
+	if _, ok := config.MigConfigs[migDefaultConfig]; !ok {
+		return fmt.Errorf("the default mig-config %s is missing", migDefaultConfig)
+	}
+	nodes, err := r.gpuNodes(ctx)
+	if err != nil {
+		return err
+	}
+	missing := map[string][]string{}
+	for _, node := range nodes {
+		selected := node.Labels[migConfigLabel]
+		if _, ok := config.MigConfigs[selected]; selected != "" && !ok {
+			missing[selected] = append(missing[selected], node.Name)
+		}
+	}
+	if len(missing) == 0 {
+		return nil
+	}
+	var problems []string
+	for name, nodeNames := range missing {
+		sort.Strings(nodeNames)
+		problems = append(problems, fmt.Sprintf("%s (selected by %s)", name, strings.Join(nodeNames, ", ")))
+	}
+	sort.Strings(problems)
+	return fmt.Errorf("mig-configs not defined: %s", strings.Join(problems, "; "))
+}
@@ -0,0 +1,37 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	operatorv1alpha1 "github.com/kyma-project/gpu-operator/api/v1alpha1"
+)
+
+// startsProcessing reports whether the CR is reconciled for the first time or its spec changed since
+// the last reconcile
+func startsProcessing(gpuOperator *operatorv1alpha1.GpuOperator) bool {
+	return gpuOperator.Status.State == "" || gpuOperator.Status.ObservedGeneration != gpuOperator.Generation
+}
+
+// installedForGeneration reports whether the current spec was installed successfully before, so that
+// failing readiness checks mean a degraded installation rather than one still in progress
+func installedForGeneration(gpuOperator *operatorv1alpha1.GpuOperator) bool {
+	switch gpuOperator.Status.State {
+	case operatorv1alpha1.StateReady, operatorv1alpha1.StateWarning:
+		return gpuOperator.Status.ObservedGeneration == gpuOperator.Generation
+	}
+	return false
+}
@@ -0,0 +1,205 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	operatorv1alpha1 "github.com/kyma-project/gpu-operator/api/v1alpha1"
+)
+
+const (
+	monitoringComponent = "dcgm-exporter-monitoring"
+	// dcgmMonitoringName is the name of the ServiceMonitor and PrometheusRule of DCGM Exporter
+	dcgmMonitoringName = "nvidia-dcgm-exporter"
+	// dcgmExporterApp is the app label of the DCGM Exporter Service created by the GPU operator
+	dcgmExporterApp = "nvidia-dcgm-exporter"
+	// dcgmExporterPort is the name of the metrics port of the DCGM Exporter Service
+	dcgmExporterPort = "gpu-metrics"
+
+	// xidFallenOffBus is the XID error of a GPU that is no longer reachable on the PCIe bus
+	xidFallenOffBus = 79
+)
+
+var (
+	serviceMonitorGVK = schema.GroupVersionKind{Group: "monitoring.coreos.com", Version: "v1", Kind: "ServiceMonitor"}
+	prometheusRuleGVK = schema.GroupVersionKind{Group: "monitoring.coreos.com", Version: "v1", Kind: "PrometheusRule"}
+)
+
+// +kubebuilder:rbac:groups=monitoring.coreos.com,resources=servicemonitors;prometheusrules,verbs=get;list;watch;create;update;patch;delete
+
+// dcgmValues returns the chart values of spec.monitoring.dcgm. When the standalone host engine is enabled,
+// the GPU operator points DCGM Exporter to it on the host port of its node.
+func dcgmValues(gpuOperator *operatorv1alpha1.GpuOperator) []chartValue {
+	monitoring := gpuOperator.Spec.Monitoring
+	if monitoring == nil || monitoring.DCGM == nil {
+		return nil
+	}
+	dcgm := monitoring.DCGM
+	values := []chartValue{{path: "dcgm.enabled", value: dcgm.Standalone}}
+	if dcgm.Standalone && dcgm.HostPort != nil {
+		values = append(values, chartValue{path: "dcgm.hostPort", value: *dcgm.HostPort})
+	}
+	return values
+}
+
+// dcgmExporterEnabled reports whether the spec leaves DCGM Exporter enabled, which the chart does by default
+func dcgmExporterEnabled(gpuOperator *operatorv1alpha1.GpuOperator) bool {
+	values := gpuOperator.Spec.Values
+	return values == nil || values.DCGMExporter == nil || values.DCGMExporter.Enabled == nil || *values.DCGMExporter.Enabled
+}
+
+// reconcileMonitoring creates the ServiceMonitor and PrometheusRule of DCGM Exporter if spec.monitoring.enabled
+// is set, and deletes them otherwise
+func (r *GpuOperatorReconciler) reconcileMonitoring(ctx context.Context, gpuOperator *operatorv1alpha1.GpuOperator, namespace string) error {
+	monitoring := gpuOperator.Spec.Monitoring
+	if monitoring == nil || !monitoring.Enabled || !dcgmExporterEnabled(gpuOperator) {
+		return r.deleteMonitoring(ctx, namespace)
+	}
+	logger := log.FromContext(ctx)
+	if _, err := r.RESTMapper().RESTMapping(serviceMonitorGVK.GroupKind(), serviceMonitorGVK.Version); err != nil {
+		if meta.IsNoMatchError(err) {
+			logger.Info("Prometheus Operator CRDs are not installed, not generating the DCGM Exporter monitoring")
+			return nil
+		}
+		return fmt.Errorf("failed to check for the Prometheus Operator: %w", err)
+	}
+
+	labels := map[string]string{}
+	for key, value := range monitoring.Labels {
+		labels[key] = value
+	}
+	labels["app.kubernetes.io/name"] = "gpu-operator"
+	labels["app.kubernetes.io/managed-by"] = "gpu-operator-module"
+	labels["app.kubernetes.io/component"] = monitoringComponent
+
+	objects := []struct {
+		gvk  schema.GroupVersionKind
+		spec map[string]interface{}
+	}{
+		{serviceMonitorGVK, dcgmServiceMonitorSpec()},
+		{prometheusRuleGVK, dcgmPrometheusRuleSpec()},
+	}
+	for _, desired := range objects {
+		obj := &unstructured.Unstructured{}
+		obj.SetGroupVersionKind(desired.gvk)
+		obj.SetName(dcgmMonitoringName)
+		obj.SetNamespace(namespace)
+		result, err := controllerutil.CreateOrUpdate(ctx, r.Client, obj, func() error {
+			obj.SetLabels(labels)
+			obj.Object["spec"] = desired.spec
+			return r.setControllerReference(gpuOperator, obj)
+		})
+		if err != nil {
+			return fmt.Errorf("failed to reconcile %s %s: %w", desired.gvk.Kind, dcgmMonitoringName, err)
+		}
+		if result != controllerutil.OperationResultNone {
+			logger.Info("Reconciled DCGM Exporter monitoring", "kind", desired.gvk.Kind, "operation", result)
+		}
+	}
+	return nil
+}
+
+// deleteMonitoring deletes the ServiceMonitor and PrometheusRule of DCGM Exporter
+func (r *GpuOperatorReconciler) deleteMonitoring(ctx context.Context, namespace string) error {
+	for _, gvk := range []schema.GroupVersionKind{serviceMonitorGVK, prometheusRuleGVK} {
+		objects := &unstructured.UnstructuredList{}
+		objects.SetGroupVersionKind(gvk.GroupVersion().WithKind(gvk.Kind + "List"))
+		if err := r.List(ctx, objects, client.InNamespace(namespace), client.MatchingLabels{
+			"app.kubernetes.io/managed-by": "gpu-operator-module",
+			"app.kubernetes.io/component":  monitoringComponent,
+		}); err != nil {
+			if meta.IsNoMatchError(err) {
+				continue
+			}
+			return fmt.Errorf("failed to list %ss: %w", gvk.Kind, err)
+		}
+		for i := range objects.Items {
+			if err := r.Delete(ctx, &objects.Items[i]); err != nil && !apierrors.IsNotFound(err) {
+				return fmt.Errorf("failed to delete %s %s: %w", gvk.Kind, objects.Items[i].GetName(), err)
+			}
+			log.FromContext(ctx).Info("Deleted DCGM Exporter monitoring", "kind", gvk.Kind, "name", objects.Items[i].GetName())
+		}
+	}
+	return nil
+}
+
+// dcgmServiceMonitorSpec scrapes the DCGM Exporter Service the GPU operator creates in the installation namespace
+func dcgmServiceMonitorSpec() map[string]interface{} {
+	return map[string]interface{}{
+		"selector": map[string]interface{}{
+			"matchLabels": map[string]interface{}{"app": dcgmExporterApp},
+		},
+		"endpoints": []interface{}{
+			map[string]interface{}{
+				"port":     dcgmExporterPort,
+				"path":     "/metrics",
+				"interval": "30s",
+			},
+		},
+	}
+}
+
+// dcgmPrometheusRuleSpec returns the default GPU alerts on the DCGM Exporter metrics. The thermal slowdown bits
+// of the clock event reasons are 0x20 (software) and 0x40 (hardware); DCGM 3.3 renamed the metric.
+func dcgmPrometheusRuleSpec() map[string]interface{} {
+	alert := func(name, expr, duration, severity, summary, description string) interface{} {
+		return map[string]interface{}{
+			"alert":  name,
+			"expr":   expr,
+			"for":    duration,
+			"labels": map[string]interface{}{"severity": severity},
+			"annotations": map[string]interface{}{
+				"summary":     summary,
+				"description": description,
+			},
+		}
+	}
+	return map[string]interface{}{
+		"groups": []interface{}{
+			map[string]interface{}{
+				"name": "gpu-operator.dcgm",
+				"rules": []interface{}{
+					alert("GPUXidError",
+						fmt.Sprintf("DCGM_FI_DEV_XID_ERRORS > 0 unless DCGM_FI_DEV_XID_ERRORS == %d", xidFallenOffBus),
+						"0m", "warning",
+						"GPU reported an XID error",
+						"GPU {{ $labels.gpu }} on node {{ $labels.Hostname }} reported XID error {{ $value }}."),
+					alert("GPUThermalThrottling",
+						"floor(DCGM_FI_DEV_CLOCK_THROTTLE_REASONS / 32) % 4 > 0 or floor(DCGM_FI_DEV_CLOCKS_EVENT_REASONS / 32) % 4 > 0",
+						"5m", "warning",
+						"GPU is thermally throttled",
+						"GPU {{ $labels.gpu }} on node {{ $labels.Hostname }} slows down its clocks to limit its temperature."),
+					alert("GPUFallenOffBus",
+						fmt.Sprintf("DCGM_FI_DEV_XID_ERRORS == %d", xidFallenOffBus),
+						"0m", "critical",
+						"GPU has fallen off the bus",
+						"GPU {{ $labels.gpu }} on node {{ $labels.Hostname }} is no longer reachable (XID 79); the node needs to be replaced."),
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,169 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	operatorv1alpha1 "github.com/kyma-project/gpu-operator/api/v1alpha1"
+)
+
+// ownerAnnotation records the namespace/name of the CR that owns an object an owner reference cannot express,
+// such as a cluster-scoped namespace
+const ownerAnnotation = "operator.kyma-project.io/owner"
+
+// ownerKey returns the value of the owner annotation for the CR
+func ownerKey(gpuOperator *operatorv1alpha1.GpuOperator) string {
+	return gpuOperator.Namespace + "/" + gpuOperator.Name
+}
+
+// ensureNamespace creates the installation namespace, or adopts it if the module created it before.
+// A namespaced CR cannot own the cluster-scoped namespace, so the module labels the namespaces it creates
+// and records the owning CR in an annotation instead.
+func (r *GpuOperatorReconciler) ensureNamespace(ctx context.Context, gpuOperator *operatorv1alpha1.GpuOperator, namespace string) error {
+	ns := &corev1.Namespace{}
+	err := r.Get(ctx, types.NamespacedName{Name: namespace}, ns)
+	if apierrors.IsNotFound(err) {
+		ns = &corev1.Namespace{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: namespace,
+				// Marks the namespace for deletion on uninstall if spec.uninstall.deleteNamespace is set
+				Labels:      map[string]string{moduleNamespaceLabel: moduleNamespaceValue},
+				Annotations: map[string]string{ownerAnnotation: ownerKey(gpuOperator)},
+			},
+		}
+		log.FromContext(ctx).Info("Creating namespace")
+		if err = r.Create(ctx, ns); err == nil {
+			r.event(gpuOperator, corev1.EventTypeNormal, eventNamespaceCreated, "Created namespace %s", namespace)
+			return nil
+		}
+		if !apierrors.IsAlreadyExists(err) {
+			return fmt.Errorf("failed to create namespace %s: %w", namespace, err)
+		}
+		err = r.Get(ctx, types.NamespacedName{Name: namespace}, ns)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to get namespace %s: %w", namespace, err)
+	}
+	return r.adoptNamespace(ctx, gpuOperator, ns)
+}
+
+// adoptNamespace records the CR as owner of a namespace the module created, e.g. by a previous CR, and removes
+// the owner references to GpuOperator CRs that earlier versions set, which break the garbage collection of
+// the namespace. Namespaces the module did not create are left alone.
+func (r *GpuOperatorReconciler) adoptNamespace(ctx context.Context, gpuOperator *operatorv1alpha1.GpuOperator, ns *corev1.Namespace) error {
+	if ns.Labels[moduleNamespaceLabel] != moduleNamespaceValue {
+		return nil
+	}
+	references := make([]metav1.OwnerReference, 0, len(ns.OwnerReferences))
+	for _, reference := range ns.OwnerReferences {
+		if !isGpuOperatorReference(reference) {
+			references = append(references, reference)
+		}
+	}
+	owner := ownerKey(gpuOperator)
+	if ns.Annotations[ownerAnnotation] == owner && len(references) == len(ns.OwnerReferences) {
+		return nil
+	}
+
+	patch := client.MergeFrom(ns.DeepCopy())
+	if ns.Annotations == nil {
+		ns.Annotations = map[string]string{}
+	}
+	previous := ns.Annotations[ownerAnnotation]
+	ns.Annotations[ownerAnnotation] = owner
+	ns.OwnerReferences = references
+	if err := r.Patch(ctx, ns, patch); err != nil {
+		return fmt.Errorf("failed to adopt namespace %s: %w", ns.Name, err)
+	}
+	log.FromContext(ctx).Info("Adopted installation namespace", "namespace", ns.Name, "previousOwner", previous)
+	return nil
+}
+
+// isGpuOperatorReference reports whether an owner reference points to a GpuOperator CR
+func isGpuOperatorReference(reference metav1.OwnerReference) bool {
+	gv, err := schema.ParseGroupVersion(reference.APIVersion)
+	return err == nil && gv.Group == operatorv1alpha1.GroupVersion.Group && reference.Kind == "GpuOperator"
+}
+
+// ownsNamespace reports whether the namespace is a namespace the module created for the CR. Namespaces created
+// before the owner annotation was introduced count as owned by the CR that uses them.
+func ownsNamespace(gpuOperator *operatorv1alpha1.GpuOperator, ns *corev1.Namespace) bool {
+	if ns.Labels[moduleNamespaceLabel] != moduleNamespaceValue || ns.Name == gpuOperator.Namespace {
+		return false
+	}
+	owner, found := ns.Annotations[ownerAnnotation]
+	return !found || owner == ownerKey(gpuOperator)
+}
+
+// releaseNamespace removes the owner annotation of the CR from a namespace that is kept on uninstall,
+// so a later CR installing into it adopts it
+func (r *GpuOperatorReconciler) releaseNamespace(ctx context.Context, gpuOperator *operatorv1alpha1.GpuOperator, ns *corev1.Namespace) error {
+	if ns.Annotations[ownerAnnotation] != ownerKey(gpuOperator) {
+		return nil
+	}
+	patch := client.MergeFrom(ns.DeepCopy())
+	delete(ns.Annotations, ownerAnnotation)
+	if err := r.Patch(ctx, ns, patch); err != nil {
+		return fmt.Errorf("failed to release namespace %s: %w", ns.Name, err)
+	}
+	return nil
+}
+
+// moduleNamespaceChangedPredicate passes the namespaces created by the module that are deleted, start
+// terminating, or whose labels or owner annotation change
+var moduleNamespaceChangedPredicate = predicate.Funcs{
+	CreateFunc: func(event.CreateEvent) bool { return false },
+	UpdateFunc: func(e event.UpdateEvent) bool {
+		if !isModuleNamespace(e.ObjectOld) && !isModuleNamespace(e.ObjectNew) {
+			return false
+		}
+		return e.ObjectOld.GetDeletionTimestamp().IsZero() != e.ObjectNew.GetDeletionTimestamp().IsZero() ||
+			e.ObjectOld.GetAnnotations()[ownerAnnotation] != e.ObjectNew.GetAnnotations()[ownerAnnotation] ||
+			e.ObjectOld.GetLabels()[moduleNamespaceLabel] != e.ObjectNew.GetLabels()[moduleNamespaceLabel]
+	},
+	DeleteFunc:  func(e event.DeleteEvent) bool { return isModuleNamespace(e.Object) },
+	GenericFunc: func(event.GenericEvent) bool { return false },
+}
+
+func isModuleNamespace(obj client.Object) bool {
+	return obj.GetLabels()[moduleNamespaceLabel] == moduleNamespaceValue
+}
+
+// gpuOperatorForNamespace maps an event of a namespace created by the module to the CR recorded in its owner
+// annotation, so a deleted or relabeled installation namespace is restored right away
+func (r *GpuOperatorReconciler) gpuOperatorForNamespace(ctx context.Context, obj client.Object) []reconcile.Request {
+	namespace, name, found := strings.Cut(obj.GetAnnotations()[ownerAnnotation], "/")
+	if !found || namespace == "" || name == "" {
+		return nil
+	}
+	log.FromContext(ctx).V(1).Info("Installation namespace changed, reconciling", "namespace", obj.GetName())
+	return []reconcile.Request{{NamespacedName: types.NamespacedName{Namespace: namespace, Name: name}}}
+}
@@ -0,0 +1,232 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	operatorv1alpha1 "github.com/kyma-project/gpu-operator/api/v1alpha1"
+)
+
+const (
+	namespaceQuotaName      = "gpu-operator-quota"
+	namespaceLimitRangeName = "gpu-operator-limits"
+)
+
+// Defaults protect the control plane from runaway validator and test pods. The quota only counts pods with a
+// deadline, the CUDA validation and smoke test pods; the operand DaemonSets run one pod per GPU node and stay
+// outside of it. Without a default limit, the LimitRange leaves the operand containers unlimited: the driver
+// container compiles kernel modules and would be OOM-killed at a small default limit.
+var (
+	namespaceQuotaScopes       = []corev1.ResourceQuotaScope{corev1.ResourceQuotaScopeTerminating}
+	defaultQuotaRequestsCPU    = "10"
+	defaultQuotaRequestsMemory = "20Gi"
+	defaultContainerRequests   = operatorv1alpha1.Resources{CPU: "10m", Memory: "64Mi"}
+)
+
+// ensureNamespaceDefaults creates or updates the ResourceQuota and LimitRange in the installation namespace
+// when spec.namespaceDefaults is enabled, and removes them otherwise
+func (r *GpuOperatorReconciler) ensureNamespaceDefaults(ctx context.Context, gpuOperator *operatorv1alpha1.GpuOperator, namespace string) error {
+	defaults := gpuOperator.Spec.NamespaceDefaults
+	if defaults == nil || !defaults.Enabled {
+		return r.deleteNamespaceDefaults(ctx, namespace)
+	}
+
+	quotaHard, err := namespaceQuotaHard(defaults.Quota)
+	if err != nil {
+		return fmt.Errorf("invalid namespaceDefaults.quota: %w", err)
+	}
+	containerRequests, containerLimits, err := containerDefaults(defaults.ContainerDefaults)
+	if err != nil {
+		return fmt.Errorf("invalid namespaceDefaults.containerDefaults: %w", err)
+	}
+
+	quota := &corev1.ResourceQuota{
+		ObjectMeta: metav1.ObjectMeta{Name: namespaceQuotaName, Namespace: namespace},
+	}
+	if err := r.replaceUnscopedQuota(ctx, quota); err != nil {
+		return err
+	}
+	if _, err := controllerutil.CreateOrUpdate(ctx, r.Client, quota, func() error {
+		quota.Labels = namespaceDefaultsLabels()
+		quota.Spec.Hard = quotaHard
+		quota.Spec.Scopes = namespaceQuotaScopes
+		return nil
+	}); err != nil {
+		return fmt.Errorf("failed to reconcile ResourceQuota %s: %w", namespaceQuotaName, err)
+	}
+
+	limitRange := &corev1.LimitRange{
+		ObjectMeta: metav1.ObjectMeta{Name: namespaceLimitRangeName, Namespace: namespace},
+	}
+	if _, err := controllerutil.CreateOrUpdate(ctx, r.Client, limitRange, func() error {
+		limitRange.Labels = namespaceDefaultsLabels()
+		limitRange.Spec.Limits = []corev1.LimitRangeItem{
+			{
+				Type:           corev1.LimitTypeContainer,
+				DefaultRequest: containerRequests,
+				Default:        containerLimits,
+			},
+		}
+		return nil
+	}); err != nil {
+		return fmt.Errorf("failed to reconcile LimitRange %s: %w", namespaceLimitRangeName, err)
+	}
+
+	return nil
+}
+
+// replaceUnscopedQuota deletes a ResourceQuota created without the scopes of namespaceQuotaScopes, which cannot
+// be added to an existing ResourceQuota
+func (r *GpuOperatorReconciler) replaceUnscopedQuota(ctx context.Context, quota *corev1.ResourceQuota) error {
+	existing := &corev1.ResourceQuota{}
+	if err := r.Get(ctx, client.ObjectKeyFromObject(quota), existing); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil
+		}
+		return fmt.Errorf("failed to get ResourceQuota %s: %w", quota.Name, err)
+	}
+	if slices.Equal(existing.Spec.Scopes, namespaceQuotaScopes) {
+		return nil
+	}
+	if err := r.Delete(ctx, existing); err != nil && !apierrors.IsNotFound(err) {
+		return fmt.Errorf("failed to replace ResourceQuota %s: %w", quota.Name, err)
+	}
+	return nil
+}
+
+// deleteNamespaceDefaults removes the ResourceQuota and LimitRange created by the controller, if any
+func (r *GpuOperatorReconciler) deleteNamespaceDefaults(ctx context.Context, namespace string) error {
+	objects := []client.Object{
+		&corev1.ResourceQuota{ObjectMeta: metav1.ObjectMeta{Name: namespaceQuotaName, Namespace: namespace}},
+		&corev1.LimitRange{ObjectMeta: metav1.ObjectMeta{Name: namespaceLimitRangeName, Namespace: namespace}},
+	}
+	for _, obj := range objects {
+		if err := r.Delete(ctx, obj); err != nil && !apierrors.IsNotFound(err) {
+			return fmt.Errorf("failed to delete %s: %w", obj.GetName(), err)
+		}
+	}
+	return nil
+}
+
+func namespaceDefaultsLabels() map[string]string {
+	return map[string]string{
+		"app.kubernetes.io/name":       "gpu-operator",
+		"app.kubernetes.io/managed-by": "gpu-operator-module",
+		"app.kubernetes.io/component":  "namespace-defaults",
+	}
+}
+
+// namespaceQuotaHard builds the ResourceQuota hard limits from the spec, falling back to defaults. The pod
+// count is only limited if set.
+func namespaceQuotaHard(quota *operatorv1alpha1.NamespaceQuota) (corev1.ResourceList, error) {
+	requests := operatorv1alpha1.Resources{CPU: defaultQuotaRequestsCPU, Memory: defaultQuotaRequestsMemory}
+	var limits operatorv1alpha1.Resources
+	hard := corev1.ResourceList{}
+	if quota != nil {
+		if quota.Pods != nil {
+			hard[corev1.ResourcePods] = *resource.NewQuantity(int64(*quota.Pods), resource.DecimalSI)
+		}
+		requests = mergeResources(requests, quota.Requests)
+		limits = mergeResources(limits, quota.Limits)
+	}
+
+	entries := []struct {
+		name  corev1.ResourceName
+		value string
+	}{
+		{corev1.ResourceRequestsCPU, requests.CPU},
+		{corev1.ResourceRequestsMemory, requests.Memory},
+		{corev1.ResourceLimitsCPU, limits.CPU},
+		{corev1.ResourceLimitsMemory, limits.Memory},
+	}
+	for _, entry := range entries {
+		if entry.value == "" {
+			continue
+		}
+		quantity, err := resource.ParseQuantity(entry.value)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", entry.name, err)
+		}
+		hard[entry.name] = quantity
+	}
+	return hard, nil
+}
+
+// containerDefaults returns the LimitRange default requests and limits, falling back to the default requests.
+// Limits are only defaulted if set.
+func containerDefaults(requirements *operatorv1alpha1.ResourceRequirements) (corev1.ResourceList, corev1.ResourceList, error) {
+	requests := defaultContainerRequests
+	var limits operatorv1alpha1.Resources
+	if requirements != nil {
+		requests = mergeResources(requests, requirements.Requests)
+		limits = mergeResources(limits, requirements.Limits)
+	}
+
+	requestList, err := toResourceList(requests)
+	if err != nil {
+		return nil, nil, fmt.Errorf("requests: %w", err)
+	}
+	limitList, err := toResourceList(limits)
+	if err != nil {
+		return nil, nil, fmt.Errorf("limits: %w", err)
+	}
+	return requestList, limitList, nil
+}
+
+// mergeResources overlays the non-empty values of override onto base
+func mergeResources(base operatorv1alpha1.Resources, override *operatorv1alpha1.Resources) operatorv1alpha1.Resources {
+	if override == nil {
+		return base
+	}
+	if override.CPU != "" {
+		base.CPU = override.CPU
+	}
+	if override.Memory != "" {
+		base.Memory = override.Memory
+	}
+	return base
+}
+
+// toResourceList parses CPU and memory strings into a ResourceList, skipping empty values
+func toResourceList(resources operatorv1alpha1.Resources) (corev1.ResourceList, error) {
+	list := corev1.ResourceList{}
+	if resources.CPU != "" {
+		cpu, err := resource.ParseQuantity(resources.CPU)
+		if err != nil {
+			return nil, fmt.Errorf("cpu: %w", err)
+		}
+		list[corev1.ResourceCPU] = cpu
+	}
+	if resources.Memory != "" {
+		memory, err := resource.ParseQuantity(resources.Memory)
+		if err != nil {
+			return nil, fmt.Errorf("memory: %w", err)
+		}
+		list[corev1.ResourceMemory] = memory
+	}
+	return list, nil
+}
@@ -0,0 +1,137 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	operatorv1alpha1 "github.com/kyma-project/gpu-operator/api/v1alpha1"
+)
+
+const (
+	ngcComponent = "ngc"
+	// ngcRegistry serves the NGC enterprise images, authenticated with an API key as password
+	ngcRegistry     = "nvcr.io"
+	ngcRegistryUser = "$oauthtoken"
+
+	// ngcPullSecretName is the image pull secret of the driver in the installation namespace
+	ngcPullSecretName = "ngc-image-pull"
+	// ngcLicensingSecretName holds the licensing configuration of the driver in the installation namespace
+	ngcLicensingSecretName = "ngc-licensing-config"
+
+	// Keys of the Secret referenced by spec.ngcSecretRef
+	ngcAPIKeyKey      = "NGC_API_KEY"
+	griddConfKey      = "gridd.conf"
+	licensingTokenKey = "client_configuration_token.tok"
+)
+
+// +kubebuilder:rbac:groups="",resources=secrets,verbs=create;update;patch
+
+// ngcValues returns the chart values pointing the driver to the image pull secret and licensing configuration
+func ngcValues(gpuOperator *operatorv1alpha1.GpuOperator) []chartValue {
+	if gpuOperator.Spec.NGCSecretRef == nil {
+		return nil
+	}
+	return []chartValue{
+		{path: "driver.imagePullSecrets", value: []string{ngcPullSecretName}},
+		{path: "driver.licensingConfig.secretName", value: ngcLicensingSecretName},
+		{path: "driver.licensingConfig.nlsEnabled", value: true},
+	}
+}
+
+// reconcileNGCSecrets validates the Secret referenced by spec.ngcSecretRef and derives the image pull secret
+// and the licensing configuration of the driver from it in the installation namespace. Both are removed when
+// no Secret is referenced.
+func (r *GpuOperatorReconciler) reconcileNGCSecrets(ctx context.Context, gpuOperator *operatorv1alpha1.GpuOperator, namespace string) error {
+	pullSecret := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: ngcPullSecretName, Namespace: namespace}}
+	licensingSecret := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: ngcLicensingSecretName, Namespace: namespace}}
+	ref := gpuOperator.Spec.NGCSecretRef
+	if ref == nil {
+		for _, secret := range []*corev1.Secret{pullSecret, licensingSecret} {
+			if err := r.Delete(ctx, secret); err != nil && !apierrors.IsNotFound(err) {
+				return fmt.Errorf("failed to delete NGC secret %s: %w", secret.Name, err)
+			}
+		}
+		return nil
+	}
+
+	source := &corev1.Secret{}
+	if err := r.localReader().Get(ctx, types.NamespacedName{Name: ref.Name, Namespace: gpuOperator.Namespace}, source); err != nil {
+		return fmt.Errorf("failed to get NGC Secret %s: %w", ref.Name, err)
+	}
+	for _, key := range []string{ngcAPIKeyKey, griddConfKey, licensingTokenKey} {
+		if len(source.Data[key]) == 0 {
+			return fmt.Errorf("NGC Secret %s has no key %s", ref.Name, key)
+		}
+	}
+
+	dockerConfig, err := ngcDockerConfig(string(source.Data[ngcAPIKeyKey]))
+	if err != nil {
+		return err
+	}
+	if _, err := controllerutil.CreateOrUpdate(ctx, r.Client, pullSecret, func() error {
+		pullSecret.Labels = ngcLabels()
+		pullSecret.Type = corev1.SecretTypeDockerConfigJson
+		pullSecret.Data = map[string][]byte{corev1.DockerConfigJsonKey: dockerConfig}
+		return r.setControllerReference(gpuOperator, pullSecret)
+	}); err != nil {
+		return fmt.Errorf("failed to reconcile NGC image pull secret: %w", err)
+	}
+	if _, err := controllerutil.CreateOrUpdate(ctx, r.Client, licensingSecret, func() error {
+		licensingSecret.Labels = ngcLabels()
+		licensingSecret.Data = map[string][]byte{
+			griddConfKey:      source.Data[griddConfKey],
+			licensingTokenKey: source.Data[licensingTokenKey],
+		}
+		return r.setControllerReference(gpuOperator, licensingSecret)
+	}); err != nil {
+		return fmt.Errorf("failed to reconcile NGC licensing secret: %w", err)
+	}
+	return nil
+}
+
+// ngcDockerConfig returns the .dockerconfigjson authenticating to NGC with an API key
+func ngcDockerConfig(apiKey string) ([]byte, error) {
+	auth := base64.StdEncoding.EncodeToString([]byte(ngcRegistryUser + ":" + apiKey))
+	data, err := json.Marshal(map[string]interface{}{
+		"auths": map[string]interface{}{
+			ngcRegistry: map[string]string{"username": ngcRegistryUser, "password": apiKey, "auth": auth},
+		},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode NGC image pull secret: %w", err)
+	}
+	return data, nil
+}
+
+// ngcLabels returns the labels of the secrets derived from spec.ngcSecretRef
+func ngcLabels() map[string]string {
+	return map[string]string{
+		"app.kubernetes.io/name":       "gpu-operator",
+		"app.kubernetes.io/managed-by": "gpu-operator-module",
+		"app.kubernetes.io/component":  ngcComponent,
+	}
+}
@@ -0,0 +1,128 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	operatorv1alpha1 "github.com/kyma-project/gpu-operator/api/v1alpha1"
+)
+
+// validatorApp is the app label of the operator validator pods
+const validatorApp = "nvidia-operator-validator"
+
+// validatorSteps maps the init containers of the operator validator pods to the results they report
+var validatorSteps = []struct {
+	container string
+	name      string
+	result    func(*operatorv1alpha1.NodeValidations) *operatorv1alpha1.ValidationResult
+}{
+	{"driver-validation", "driver", func(v *operatorv1alpha1.NodeValidations) *operatorv1alpha1.ValidationResult { return &v.Driver }},
+	{"toolkit-validation", "toolkit", func(v *operatorv1alpha1.NodeValidations) *operatorv1alpha1.ValidationResult { return &v.Toolkit }},
+	{"cuda-validation", "cuda", func(v *operatorv1alpha1.NodeValidations) *operatorv1alpha1.ValidationResult { return &v.CUDA }},
+	{"plugin-validation", "plugin", func(v *operatorv1alpha1.NodeValidations) *operatorv1alpha1.ValidationResult { return &v.Plugin }},
+}
+
+// nodeValidations collects the results of the operator validator pods per node, sorted by node name
+func (r *GpuOperatorReconciler) nodeValidations(ctx context.Context, namespace string) ([]operatorv1alpha1.NodeStatus, error) {
+	pods := &corev1.PodList{}
+	if err := r.List(ctx, pods, client.InNamespace(namespace), client.MatchingLabels{"app": validatorApp}); err != nil {
+		return nil, fmt.Errorf("failed to list operator validator pods: %w", err)
+	}
+
+	var nodes []operatorv1alpha1.NodeStatus
+	for i := range pods.Items {
+		pod := &pods.Items[i]
+		if pod.Spec.NodeName == "" || pod.DeletionTimestamp != nil {
+			continue
+		}
+		nodes = append(nodes, operatorv1alpha1.NodeStatus{
+			Name:        pod.Spec.NodeName,
+			Validations: validatorPodResults(pod),
+		})
+	}
+	sort.Slice(nodes, func(i, j int) bool { return nodes[i].Name < nodes[j].Name })
+	return nodes, nil
+}
+
+// validatorPodResults maps the init container states of an operator validator pod to validation results.
+// Validations the validator does not run, such as the toolkit validation of VM passthrough nodes, stay empty.
+func validatorPodResults(pod *corev1.Pod) operatorv1alpha1.NodeValidations {
+	statuses := map[string]corev1.ContainerStatus{}
+	for _, status := range pod.Status.InitContainerStatuses {
+		statuses[status.Name] = status
+	}
+
+	validations := operatorv1alpha1.NodeValidations{}
+	for _, step := range validatorSteps {
+		status, ok := statuses[step.container]
+		if !ok {
+			continue
+		}
+		result, message := containerValidationResult(status)
+		*step.result(&validations) = result
+		if result == operatorv1alpha1.ValidationFailed && validations.Message == "" {
+			validations.Message = step.name + " validation " + message
+		}
+	}
+	return validations
+}
+
+// containerValidationResult derives the result of a validation from the state of its init container.
+// A container restarting after an error is failed, the validator retries until the node is fixed.
+func containerValidationResult(status corev1.ContainerStatus) (operatorv1alpha1.ValidationResult, string) {
+	terminated := status.State.Terminated
+	if terminated == nil && status.State.Running == nil {
+		terminated = status.LastTerminationState.Terminated
+	}
+	switch {
+	case terminated == nil:
+		return operatorv1alpha1.ValidationPending, ""
+	case terminated.ExitCode == 0:
+		if status.State.Terminated != nil {
+			return operatorv1alpha1.ValidationPassed, ""
+		}
+		return operatorv1alpha1.ValidationPending, ""
+	default:
+		message := fmt.Sprintf("exited with code %d", terminated.ExitCode)
+		if terminated.Reason != "" {
+			message += " (" + terminated.Reason + ")"
+		}
+		if status.RestartCount > 0 {
+			message += fmt.Sprintf(" after %d restarts", status.RestartCount)
+		}
+		return operatorv1alpha1.ValidationFailed, message
+	}
+}
+
+// validationsPassed reports whether every validation run on the nodes passed
+func validationsPassed(nodes []operatorv1alpha1.NodeStatus) bool {
+	for _, node := range nodes {
+		v := node.Validations
+		for _, result := range []operatorv1alpha1.ValidationResult{v.Driver, v.Toolkit, v.CUDA, v.Plugin} {
+			if result != "" && result != operatorv1alpha1.ValidationPassed {
+				return false
+			}
+		}
+	}
+	return true
+}
@@ -0,0 +1,138 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	operatorv1alpha1 "github.com/kyma-project/gpu-operator/api/v1alpha1"
+	"github.com/kyma-project/gpu-operator/internal/logging"
+)
+
+// isGPUNode reports whether the node carries an NVIDIA GPU according to NFD or GFD, or is of a GPU machine type.
+// The machine type identifies GPU nodes as soon as they join, before NFD has labeled them.
+func isGPUNode(node *corev1.Node) bool {
+	if node.Labels[nvidiaPCILabel] == "true" || node.Labels[gpuProductLabel] != "" {
+		return true
+	}
+	instanceType := node.Labels[corev1.LabelInstanceTypeStable]
+	return instanceType != "" && instanceTypeFamily(instanceType) != "unknown"
+}
+
+// gpuNodeJoinedPredicate passes the GPU nodes that join the cluster, the nodes that are recognized as GPU
+// nodes later, and the GPU nodes that become Ready
+var gpuNodeJoinedPredicate = predicate.Funcs{
+	CreateFunc: func(e event.CreateEvent) bool {
+		node, ok := e.Object.(*corev1.Node)
+		return ok && isGPUNode(node)
+	},
+	UpdateFunc: func(e event.UpdateEvent) bool {
+		oldNode, ok := e.ObjectOld.(*corev1.Node)
+		if !ok {
+			return false
+		}
+		newNode, ok := e.ObjectNew.(*corev1.Node)
+		if !ok || !isGPUNode(newNode) {
+			return false
+		}
+		return !isGPUNode(oldNode) || !isNodeReady(oldNode) && isNodeReady(newNode)
+	},
+	DeleteFunc:  func(event.DeleteEvent) bool { return false },
+	GenericFunc: func(event.GenericEvent) bool { return false },
+}
+
+// gfdTimestampLabel is refreshed by GFD on every labeling pass and carries no information
+const gfdTimestampLabel = "nvidia.com/gfd.timestamp"
+
+// gpuNodeLabelsChangedPredicate passes the updates of GPU nodes that change GFD, NFD or driver labels,
+// which feed the status aggregation. The labels the controller sets itself are ignored.
+var gpuNodeLabelsChangedPredicate = predicate.Funcs{
+	CreateFunc: func(event.CreateEvent) bool { return false },
+	UpdateFunc: func(e event.UpdateEvent) bool {
+		if node, ok := e.ObjectNew.(*corev1.Node); !ok || !isGPUNode(node) {
+			return false
+		}
+		return !gpuLabelsEqual(e.ObjectOld.GetLabels(), e.ObjectNew.GetLabels())
+	},
+	DeleteFunc:  func(event.DeleteEvent) bool { return false },
+	GenericFunc: func(event.GenericEvent) bool { return false },
+}
+
+// isGPULabel reports whether a node label is set by GFD, the GPU operator or NFD for the NVIDIA PCI device
+func isGPULabel(key string) bool {
+	switch key {
+	case gfdTimestampLabel, deployDevicePluginLabel, devicePluginConfigLabel, deployOperandsLabel:
+		return false
+	}
+	return strings.HasPrefix(key, "nvidia.com/") || key == nvidiaPCILabel
+}
+
+// gpuLabelsEqual reports whether two label sets agree on all GPU labels
+func gpuLabelsEqual(a, b map[string]string) bool {
+	for key, value := range a {
+		if isGPULabel(key) {
+			if other, ok := b[key]; !ok || other != value {
+				return false
+			}
+		}
+	}
+	for key := range b {
+		if _, ok := a[key]; !ok && isGPULabel(key) {
+			return false
+		}
+	}
+	return true
+}
+
+// gpuOperatorsForNode enqueues every GpuOperator CR handled by this instance, so a new or relabeled GPU node
+// is validated and reflected in the status without waiting for the next requeue
+func (r *GpuOperatorReconciler) gpuOperatorsForNode(ctx context.Context, obj client.Object) []reconcile.Request {
+	requests := r.gpuOperatorRequests(ctx)
+	if len(requests) > 0 {
+		log.FromContext(ctx).WithName(logging.SubsystemHealth).Info("GPU node changed, reconciling",
+			"node", obj.GetName(), "pool", obj.GetLabels()[gardenerPoolLabel])
+	}
+	return requests
+}
+
+// gpuOperatorRequests returns the requests of all GpuOperator CRs handled by this instance that are not being deleted
+func (r *GpuOperatorReconciler) gpuOperatorRequests(ctx context.Context) []reconcile.Request {
+	gpuOperators := &operatorv1alpha1.GpuOperatorList{}
+	if err := r.List(ctx, gpuOperators); err != nil {
+		log.FromContext(ctx).Error(err, "Failed to list GpuOperators")
+		return nil
+	}
+	requests := make([]reconcile.Request, 0, len(gpuOperators.Items))
+	for _, gpuOperator := range gpuOperators.Items {
+		if gpuOperator.DeletionTimestamp != nil {
+			continue
+		}
+		requests = append(requests, reconcile.Request{
+			NamespacedName: types.NamespacedName{Name: gpuOperator.Name, Namespace: gpuOperator.Namespace},
+		})
+	}
+	return requests
+}
@@ -0,0 +1,134 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	operatorv1alpha1 "github.com/kyma-project/gpu-operator/api/v1alpha1"
+)
+
+const (
+	// deployOperandsLabel controls whether the GPU operator deploys any operand on a node
+	deployOperandsLabel = "nvidia.com/gpu.deploy.operands"
+	// operandsExcludedLabel marks the GPU nodes the controller excluded from the operands by spec.operands.nodeSelector
+	operandsExcludedLabel = "operator.kyma-project.io/operands-excluded"
+)
+
+// operandTolerations returns the tolerations of the operand DaemonSets, nil to keep the chart defaults.
+// The GPU taint tolerated by the chart defaults is kept, as the list replaces the defaults.
+func operandTolerations(gpuOperator *operatorv1alpha1.GpuOperator) []corev1.Toleration {
+	tolerations := spotTolerations(gpuOperator)
+	var extra []corev1.Toleration
+	if operands := gpuOperator.Spec.Operands; operands != nil {
+		extra = operands.Tolerations
+	}
+	if tolerations == nil && len(extra) == 0 {
+		return nil
+	}
+	if tolerations == nil {
+		tolerations = []corev1.Toleration{
+			{Key: string(gpuResourceName), Operator: corev1.TolerationOpExists, Effect: corev1.TaintEffectNoSchedule},
+		}
+	}
+	return append(tolerations, extra...)
+}
+
+// operandTolerationValues returns the chart values of the operand tolerations. The Node Feature Discovery worker
+// tolerates spec.operands.tolerations as well, as the operands are only deployed on the nodes it labeled.
+func operandTolerationValues(gpuOperator *operatorv1alpha1.GpuOperator) []chartValue {
+	tolerations := operandTolerations(gpuOperator)
+	if tolerations == nil {
+		return nil
+	}
+	values := []chartValue{{path: "daemonsets.tolerations", value: tolerations}}
+	if operands := gpuOperator.Spec.Operands; operands != nil && len(operands.Tolerations) > 0 {
+		nfdTolerations := append([]corev1.Toleration{
+			{Key: "node-role.kubernetes.io/control-plane", Operator: corev1.TolerationOpEqual, Effect: corev1.TaintEffectNoSchedule},
+			{Key: string(gpuResourceName), Operator: corev1.TolerationOpExists, Effect: corev1.TaintEffectNoSchedule},
+		}, operands.Tolerations...)
+		values = append(values, chartValue{path: "node-feature-discovery.worker.tolerations", value: nfdTolerations, chartOnly: true})
+	}
+	return values
+}
+
+// reconcileOperandNodes excludes the GPU nodes that do not match spec.operands.nodeSelector from the operands.
+// The chart has no node selector for the operands, so the nodes get the label that disables all operands of the
+// GPU operator. Excluded nodes that match again, or all of them without a node selector, get the operands back.
+func (r *GpuOperatorReconciler) reconcileOperandNodes(ctx context.Context, gpuOperator *operatorv1alpha1.GpuOperator) error {
+	var selector labels.Selector
+	if operands := gpuOperator.Spec.Operands; operands != nil && len(operands.NodeSelector) > 0 {
+		selector = labels.SelectorFromSet(operands.NodeSelector)
+	}
+	nodes, err := r.gpuNodes(ctx)
+	if err != nil {
+		return err
+	}
+	for i := range nodes {
+		excluded := selector != nil && !selector.Matches(labels.Set(nodes[i].Labels))
+		if err := r.setOperandsExcluded(ctx, &nodes[i], excluded); err != nil {
+			return err
+		}
+	}
+	if selector != nil {
+		return nil
+	}
+	// Nodes that lost the NFD label are no GPU nodes anymore, but may still be excluded
+	return r.restoreOperandNodes(ctx)
+}
+
+// restoreOperandNodes hands all nodes excluded from the operands back to the GPU operator
+func (r *GpuOperatorReconciler) restoreOperandNodes(ctx context.Context) error {
+	nodes := &corev1.NodeList{}
+	if err := r.List(ctx, nodes, client.HasLabels{operandsExcludedLabel}); err != nil {
+		return fmt.Errorf("failed to list nodes excluded from the operands: %w", err)
+	}
+	for i := range nodes.Items {
+		if err := r.setOperandsExcluded(ctx, &nodes.Items[i], false); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// setOperandsExcluded disables the operands of the GPU operator on the node, or enables them again
+func (r *GpuOperatorReconciler) setOperandsExcluded(ctx context.Context, node *corev1.Node, excluded bool) error {
+	_, marked := node.Labels[operandsExcludedLabel]
+	if excluded && marked && node.Labels[deployOperandsLabel] == "false" || !excluded && !marked {
+		return nil
+	}
+	patch := client.MergeFrom(node.DeepCopy())
+	if excluded {
+		if node.Labels == nil {
+			node.Labels = map[string]string{}
+		}
+		node.Labels[operandsExcludedLabel] = "true"
+		node.Labels[deployOperandsLabel] = "false"
+	} else {
+		delete(node.Labels, operandsExcludedLabel)
+		delete(node.Labels, deployOperandsLabel)
+	}
+	if err := r.Patch(ctx, node, patch); err != nil {
+		return fmt.Errorf("failed to label node %s: %w", node.Name, err)
+	}
+	return nil
+}
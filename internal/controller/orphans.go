@@ -0,0 +1,114 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	batchv1 "k8s.io/api/batch/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	operatorv1alpha1 "github.com/kyma-project/gpu-operator/api/v1alpha1"
+)
+
+// conditionTypeOrphanedResources lists the remnants of a previous installation that block the install
+const conditionTypeOrphanedResources = "OrphanedResourcesDetected"
+
+// orphanedResourcesError reports the remnants of a previous installation found before the first Helm install
+type orphanedResourcesError struct {
+	resources []string
+}
+
+func (e *orphanedResourcesError) Error() string {
+	return fmt.Sprintf("found resources of a previous installation, delete them or set spec.install.cleanupOrphanedResources: %s",
+		strings.Join(e.resources, ", "))
+}
+
+// reconcileOrphanedResources checks the namespace for remnants of a previous installation before the CR
+// runs its first Helm install, so the install does not collide with half-removed objects.
+// The remnants are deleted with spec.install.cleanupOrphanedResources. It reports whether the install
+// has to wait for remnants being deleted.
+func (r *GpuOperatorReconciler) reconcileOrphanedResources(ctx context.Context, gpuOperator *operatorv1alpha1.GpuOperator, namespace string) (bool, error) {
+	if gpuOperator.Status.InstalledVersion != "" {
+		return false, nil
+	}
+	orphans, err := r.orphanedResources(ctx, gpuOperator, namespace)
+	if err != nil || len(orphans) == 0 {
+		return false, err
+	}
+	terminating := 0
+	for _, obj := range orphans {
+		if obj.GetDeletionTimestamp() != nil {
+			terminating++
+		}
+	}
+	if terminating == len(orphans) {
+		return true, nil
+	}
+	if gpuOperator.Spec.Install == nil || !gpuOperator.Spec.Install.CleanupOrphanedResources {
+		names := make([]string, 0, len(orphans))
+		for _, obj := range orphans {
+			names = append(names, describeObject(obj))
+		}
+		return false, &orphanedResourcesError{resources: names}
+	}
+
+	logger := log.FromContext(ctx)
+	for _, obj := range orphans {
+		if obj.GetDeletionTimestamp() != nil {
+			continue
+		}
+		if err := r.Delete(ctx, obj, client.PropagationPolicy(metav1.DeletePropagationBackground)); err != nil &&
+			!apierrors.IsNotFound(err) {
+			return false, fmt.Errorf("failed to delete orphaned resource %s: %w", describeObject(obj), err)
+		}
+		logger.Info("Deleted orphaned resource of a previous installation", "resource", describeObject(obj))
+	}
+	return true, nil
+}
+
+// orphanedResources returns the Helm release secrets, ClusterPolicies and operand workloads of the GPU
+// operator release created before the CR if the release is not deployed, i.e. left behind by a failed or
+// half-removed previous installation
+func (r *GpuOperatorReconciler) orphanedResources(ctx context.Context, gpuOperator *operatorv1alpha1.GpuOperator, namespace string) ([]client.Object, error) {
+	_, status, err := r.latestHelmRelease(ctx, namespace)
+	if err != nil {
+		return nil, err
+	}
+	if status == "deployed" {
+		return nil, nil
+	}
+	objects, err := r.listReleaseResources(ctx, namespace, helmReleaseName)
+	if err != nil {
+		return nil, err
+	}
+	orphans := make([]client.Object, 0, len(objects))
+	for _, obj := range objects {
+		// The Jobs of the module are not part of the release, and objects created after the CR stem from its own,
+		// interrupted installs
+		created := obj.GetCreationTimestamp()
+		if _, ok := obj.(*batchv1.Job); !ok && created.Before(&gpuOperator.CreationTimestamp) {
+			orphans = append(orphans, obj)
+		}
+	}
+	return orphans, nil
+}
@@ -0,0 +1,164 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	operatorv1alpha1 "github.com/kyma-project/gpu-operator/api/v1alpha1"
+)
+
+const (
+	// suspendAnnotation set to true pauses the CR like spec.paused, without changing the spec
+	suspendAnnotation = "operator.kyma-project.io/suspend"
+
+	conditionTypePaused = "Paused"
+	eventPaused         = "Paused"
+	eventResumed        = "Resumed"
+)
+
+// paused reports whether spec.paused or the suspend annotation stops the changes of the controller
+func paused(gpuOperator *operatorv1alpha1.GpuOperator) bool {
+	return gpuOperator.Spec.Paused || gpuOperator.GetAnnotations()[suspendAnnotation] == "true"
+}
+
+// reconcilePaused keeps the status of a paused CR current without changing anything in the cluster: no Helm
+// operations run, and no node labels or operand resources are created, updated or deleted. A deleted CR keeps
+// its finalizer until it is resumed. Failures to read the state are logged and leave the previous status in place.
+func (r *GpuOperatorReconciler) reconcilePaused(ctx context.Context, gpuOperator *operatorv1alpha1.GpuOperator, namespace string) (ctrl.Result, error) {
+	logger := log.FromContext(ctx)
+
+	if isAMD(gpuOperator) {
+		r.observeAMDStatus(ctx, gpuOperator, namespace)
+	} else {
+		r.observeStatus(ctx, gpuOperator, namespace)
+	}
+
+	reason, message := "PausedBySpec", "Reconciliation is paused with spec.paused, spec changes are not applied"
+	if !gpuOperator.Spec.Paused {
+		reason, message = "Suspended", "Reconciliation is suspended with the "+suspendAnnotation+" annotation, spec changes are not applied"
+	}
+	if gpuOperator.DeletionTimestamp != nil {
+		message += ", the GPU operator is uninstalled once resumed"
+	} else {
+		gpuOperator.Status.State = operatorv1alpha1.StateWarning
+	}
+	if !meta.IsStatusConditionTrue(gpuOperator.Status.Conditions, conditionTypePaused) {
+		logger.Info("Reconciliation paused", "reason", reason)
+		r.event(gpuOperator, corev1.EventTypeNormal, eventPaused, "%s", message)
+	}
+	meta.SetStatusCondition(&gpuOperator.Status.Conditions, metav1.Condition{
+		Type:               conditionTypePaused,
+		Status:             metav1.ConditionTrue,
+		Reason:             reason,
+		Message:            message,
+		ObservedGeneration: gpuOperator.Generation,
+	})
+	if err := r.updateStatus(ctx, gpuOperator); err != nil {
+		logger.Error(err, "Failed to update GpuOperator status", "state", gpuOperator.Status.State)
+		return ctrl.Result{}, err
+	}
+	return ctrl.Result{RequeueAfter: r.Config.Get().RequeueInterval.Duration}, nil
+}
+
+// clearPaused removes the Paused condition once spec.paused and the suspend annotation are gone. The status is
+// written right away, since the reconcile may then return early, e.g. on a failed preflight or during the retry
+// backoff, without replacing the conditions.
+func (r *GpuOperatorReconciler) clearPaused(ctx context.Context, gpuOperator *operatorv1alpha1.GpuOperator) error {
+	if paused(gpuOperator) || meta.FindStatusCondition(gpuOperator.Status.Conditions, conditionTypePaused) == nil {
+		return nil
+	}
+	meta.RemoveStatusCondition(&gpuOperator.Status.Conditions, conditionTypePaused)
+	if err := r.updateStatus(ctx, gpuOperator); err != nil {
+		return err
+	}
+	log.FromContext(ctx).Info("Reconciliation resumed")
+	r.event(gpuOperator, corev1.EventTypeNormal, eventResumed, "Reconciliation resumed, spec changes are applied again")
+	return nil
+}
+
+// observeStatus collects the state of the NVIDIA GPU stack into the status. Failures are logged and leave the
+// previous status in place.
+func (r *GpuOperatorReconciler) observeStatus(ctx context.Context, gpuOperator *operatorv1alpha1.GpuOperator, namespace string) {
+	logger := log.FromContext(ctx)
+
+	if release, err := r.installedRelease(ctx, gpuOperator, namespace); err != nil {
+		logger.Error(err, "Failed to read the installed GPU operator release")
+	} else if release.chartVersion != "" {
+		gpuOperator.Status.InstalledChartVersion = release.chartVersion
+		if release.driverVersion != "" {
+			gpuOperator.Status.InstalledVersion = release.driverVersion
+		}
+	}
+	if clusterPolicy, err := r.clusterPolicyStatus(ctx); err != nil {
+		logger.Error(err, "Failed to read ClusterPolicy state")
+	} else {
+		gpuOperator.Status.ClusterPolicy = clusterPolicy
+	}
+	if components, err := r.componentStatuses(ctx, namespace); err != nil {
+		logger.Error(err, "Failed to collect component rollout status")
+	} else {
+		gpuOperator.Status.Components = components
+	}
+	nodeValidations, err := r.nodeValidations(ctx, namespace)
+	if err != nil {
+		logger.Error(err, "Failed to collect operator validator results")
+	} else {
+		gpuOperator.Status.Nodes = nodeValidations
+		if pools, err := r.poolStatuses(ctx, nodeValidations); err != nil {
+			logger.Error(err, "Failed to aggregate worker pool status")
+		} else {
+			gpuOperator.Status.Pools = pools
+		}
+		if inventory, err := r.gpuNodeInventory(ctx, nodeValidations); err != nil {
+			logger.Error(err, "Failed to collect GPU node inventory")
+		} else {
+			gpuOperator.Status.GPUNodes = inventory
+		}
+	}
+	if inventory, err := r.gpuInventory(ctx); err != nil {
+		logger.Error(err, "Failed to collect GPU inventory per model")
+	} else {
+		gpuOperator.Status.GPUInventory = inventory
+	}
+	if driverUpgrade, err := r.driverUpgradeStatus(ctx); err != nil {
+		logger.Error(err, "Failed to collect driver upgrade progress")
+	} else {
+		gpuOperator.Status.DriverUpgrade = driverUpgrade
+	}
+	if mig, err := r.migStatus(ctx, gpuOperator); err != nil {
+		logger.Error(err, "Failed to collect MIG configuration state")
+	} else {
+		gpuOperator.Status.MIG = mig
+	}
+	if pending, err := r.pendingGPUPods(ctx); err != nil {
+		logger.Error(err, "Failed to count pods pending for GPUs")
+	} else {
+		gpuOperator.Status.PendingGPUPods = pending
+	}
+	if allocation, err := r.gpuAllocation(ctx); err != nil {
+		logger.Error(err, "Failed to sum allocated GPUs")
+	} else {
+		gpuOperator.Status.GPUAllocation = allocation
+	}
+}
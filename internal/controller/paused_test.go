@@ -0,0 +1,115 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	operatorv1alpha1 "github.com/kyma-project/gpu-operator/api/v1alpha1"
+	"github.com/kyma-project/gpu-operator/internal/config"
+	"github.com/kyma-project/gpu-operator/internal/noise"
+)
+
+// TestResumeBeforeEarlyReturn resumes a CR whose reconcile then returns early without replacing the
+// conditions: it is a duplicate whose repeated failure is not published again
+func TestResumeBeforeEarlyReturn(t *testing.T) {
+	scheme := runtime.NewScheme()
+	if err := operatorv1alpha1.AddToScheme(scheme); err != nil {
+		t.Fatalf("AddToScheme: %v", err)
+	}
+	created := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	pausedCondition := metav1.Condition{
+		Type:               conditionTypePaused,
+		Status:             metav1.ConditionTrue,
+		Reason:             "Suspended",
+		LastTransitionTime: metav1.NewTime(created),
+	}
+
+	tests := []struct {
+		name        string
+		spec        operatorv1alpha1.GpuOperatorSpec
+		annotations map[string]string
+		wantPaused  bool
+	}{
+		{
+			name: "spec.paused unset",
+		},
+		{
+			name:        "suspend annotation removed",
+			annotations: map[string]string{"example.com/other": "true"},
+		},
+		{
+			name:        "suspend annotation false",
+			annotations: map[string]string{suspendAnnotation: "false"},
+		},
+		{
+			name:       "still paused with spec.paused",
+			spec:       operatorv1alpha1.GpuOperatorSpec{Paused: true},
+			wantPaused: true,
+		},
+		{
+			name:        "still suspended",
+			annotations: map[string]string{suspendAnnotation: "true"},
+			wantPaused:  true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			active := &operatorv1alpha1.GpuOperator{ObjectMeta: metav1.ObjectMeta{
+				Name: "gpu-operator", Namespace: "team-a", CreationTimestamp: metav1.NewTime(created.Add(-time.Hour)),
+			}}
+			resumed := &operatorv1alpha1.GpuOperator{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "gpu-operator", Namespace: "team-b", Annotations: tt.annotations, CreationTimestamp: metav1.NewTime(created),
+				},
+				Spec:   tt.spec,
+				Status: operatorv1alpha1.GpuOperatorStatus{Conditions: []metav1.Condition{pausedCondition}},
+			}
+			r := &GpuOperatorReconciler{
+				Client: fake.NewClientBuilder().WithScheme(scheme).WithObjects(active, resumed).
+					WithStatusSubresource(&operatorv1alpha1.GpuOperator{}).Build(),
+				Config:   config.NewStore(config.Default()),
+				failures: noise.NewFilter(failureStatusInterval),
+			}
+			// The duplicate failure was published before, so the reconcile returns without a status update
+			duplicate := &duplicateInstanceError{active: client.ObjectKeyFromObject(active)}
+			r.failures.Observe(client.ObjectKeyFromObject(resumed).String(),
+				fmt.Sprintf("%d/%s/%s", resumed.Generation, "DuplicateInstance", duplicate.Error()))
+
+			if _, err := r.Reconcile(context.Background(), ctrl.Request{NamespacedName: client.ObjectKeyFromObject(resumed)}); err != nil {
+				t.Fatalf("Reconcile: %v", err)
+			}
+			got := &operatorv1alpha1.GpuOperator{}
+			if err := r.Get(context.Background(), client.ObjectKeyFromObject(resumed), got); err != nil {
+				t.Fatalf("Get: %v", err)
+			}
+			if paused := meta.FindStatusCondition(got.Status.Conditions, conditionTypePaused) != nil; paused != tt.wantPaused {
+				t.Errorf("Paused condition present = %t, want %t", paused, tt.wantPaused)
+			}
+		})
+	}
+}
@@ -0,0 +1,103 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	operatorv1alpha1 "github.com/kyma-project/gpu-operator/api/v1alpha1"
+)
+
+// maxPendingNamespaces limits the namespaces listed in status.pendingGpuPods
+const maxPendingNamespaces = 5
+
+// pendingGPUPods counts the pods the scheduler could not place because no node has enough free nvidia.com/gpu,
+// and updates the pending pod metric
+func (r *GpuOperatorReconciler) pendingGPUPods(ctx context.Context) (*operatorv1alpha1.PendingGPUPods, error) {
+	pods := &corev1.PodList{}
+	if err := r.List(ctx, pods); err != nil {
+		return nil, fmt.Errorf("failed to list pods: %w", err)
+	}
+	perNamespace := map[string]int32{}
+	pending := &operatorv1alpha1.PendingGPUPods{}
+	for i := range pods.Items {
+		if isPendingForGPUs(&pods.Items[i]) {
+			perNamespace[pods.Items[i].Namespace]++
+			pending.Count++
+		}
+	}
+
+	pendingGPUPodsGauge.Reset()
+	for namespace, count := range perNamespace {
+		pendingGPUPodsGauge.WithLabelValues(namespace).Set(float64(count))
+		pending.TopNamespaces = append(pending.TopNamespaces, operatorv1alpha1.NamespacePodCount{Namespace: namespace, Count: count})
+	}
+	sort.Slice(pending.TopNamespaces, func(i, j int) bool {
+		a, b := pending.TopNamespaces[i], pending.TopNamespaces[j]
+		return a.Count > b.Count || a.Count == b.Count && a.Namespace < b.Namespace
+	})
+	if len(pending.TopNamespaces) > maxPendingNamespaces {
+		pending.TopNamespaces = pending.TopNamespaces[:maxPendingNamespaces]
+	}
+	return pending, nil
+}
+
+// pendingForGPUsChangedPredicate passes the pods that start or stop pending for lack of GPUs
+var pendingForGPUsChangedPredicate = predicate.Funcs{
+	CreateFunc: func(event.CreateEvent) bool { return false },
+	UpdateFunc: func(e event.UpdateEvent) bool {
+		oldPod, ok := e.ObjectOld.(*corev1.Pod)
+		if !ok {
+			return false
+		}
+		newPod, ok := e.ObjectNew.(*corev1.Pod)
+		return ok && isPendingForGPUs(oldPod) != isPendingForGPUs(newPod)
+	},
+	DeleteFunc: func(e event.DeleteEvent) bool {
+		pod, ok := e.Object.(*corev1.Pod)
+		return ok && isPendingForGPUs(pod)
+	},
+	GenericFunc: func(event.GenericEvent) bool { return false },
+}
+
+// gpuOperatorsForPendingPod enqueues every GpuOperator CR handled by this instance to refresh status.pendingGpuPods
+func (r *GpuOperatorReconciler) gpuOperatorsForPendingPod(ctx context.Context, _ client.Object) []reconcile.Request {
+	return r.gpuOperatorRequests(ctx)
+}
+
+// isPendingForGPUs reports whether the pod requests GPUs and was found unschedulable for lack of them
+func isPendingForGPUs(pod *corev1.Pod) bool {
+	if pod.Status.Phase != corev1.PodPending || !podRequestsGPU(pod) {
+		return false
+	}
+	for _, condition := range pod.Status.Conditions {
+		if condition.Type == corev1.PodScheduled && condition.Status == corev1.ConditionFalse &&
+			condition.Reason == corev1.PodReasonUnschedulable {
+			return strings.Contains(condition.Message, "Insufficient "+string(gpuResourceName))
+		}
+	}
+	return false
+}
@@ -0,0 +1,122 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"sort"
+
+	operatorv1alpha1 "github.com/kyma-project/gpu-operator/api/v1alpha1"
+)
+
+const (
+	// driverVersionLabel is set by GFD to the full version of the running driver
+	driverVersionLabel = "nvidia.com/cuda.driver-version.full"
+	// driverUpgradeStateLabel is set by the upgrade controller of the GPU operator while it upgrades the driver of a node
+	driverUpgradeStateLabel = "nvidia.com/gpu-driver-upgrade-state"
+
+	driverUpgradeDone   = "upgrade-done"
+	driverUpgradeFailed = "upgrade-failed"
+)
+
+// driverUpgradeSteps are the states of the driver upgrade of a node, in the order the upgrade controller walks them
+var driverUpgradeSteps = []string{
+	"upgrade-required",
+	"cordon-required",
+	"wait-for-jobs-required",
+	"pod-deletion-required",
+	"drain-required",
+	"pod-restart-required",
+	"validation-required",
+	"uncordon-required",
+}
+
+// poolStatuses groups the GPU nodes by worker pool, sorted by pool name. Nodes without a worker pool label
+// are not reported.
+func (r *GpuOperatorReconciler) poolStatuses(ctx context.Context, nodeValidations []operatorv1alpha1.NodeStatus) ([]operatorv1alpha1.PoolStatus, error) {
+	nodes, err := r.gpuNodes(ctx)
+	if err != nil {
+		return nil, err
+	}
+	validations := make(map[string]operatorv1alpha1.NodeStatus, len(nodeValidations))
+	for _, node := range nodeValidations {
+		validations[node.Name] = node
+	}
+
+	pools := map[string]*operatorv1alpha1.PoolStatus{}
+	versions := map[string]map[string]bool{}
+	steps := map[string]int{}
+	failed := map[string]bool{}
+	for i := range nodes {
+		node := &nodes[i]
+		name := node.Labels[gardenerPoolLabel]
+		if name == "" {
+			continue
+		}
+		pool, ok := pools[name]
+		if !ok {
+			pool = &operatorv1alpha1.PoolStatus{Name: name}
+			pools[name] = pool
+			versions[name] = map[string]bool{}
+			steps[name] = len(driverUpgradeSteps)
+		}
+		pool.Nodes++
+		if isNodeReady(node) && validationsPassed([]operatorv1alpha1.NodeStatus{validations[node.Name]}) {
+			pool.ReadyNodes++
+		}
+		if version := node.Labels[driverVersionLabel]; version != "" {
+			versions[name][version] = true
+		}
+		switch state := node.Labels[driverUpgradeStateLabel]; state {
+		case "", driverUpgradeDone:
+		case driverUpgradeFailed:
+			pool.UpgradingNodes++
+			failed[name] = true
+		default:
+			pool.UpgradingNodes++
+			steps[name] = min(steps[name], driverUpgradeStepIndex(state))
+		}
+	}
+
+	statuses := make([]operatorv1alpha1.PoolStatus, 0, len(pools))
+	for name, pool := range pools {
+		pool.ReadyPercent = pool.ReadyNodes * 100 / pool.Nodes
+		for version := range versions[name] {
+			pool.DriverVersions = append(pool.DriverVersions, version)
+		}
+		sort.Strings(pool.DriverVersions)
+		if failed[name] {
+			pool.UpgradeStep = driverUpgradeFailed
+		} else if step := steps[name]; step < len(driverUpgradeSteps) {
+			pool.UpgradeStep = driverUpgradeSteps[step]
+		}
+		statuses = append(statuses, *pool)
+	}
+	sort.Slice(statuses, func(i, j int) bool { return statuses[i].Name < statuses[j].Name })
+	return statuses, nil
+}
+
+// driverUpgradeStepIndex returns the position of a driver upgrade state in driverUpgradeSteps. An unknown state
+// of a newer GPU operator is treated as the start of the upgrade.
+func driverUpgradeStepIndex(state string) int {
+	for i, step := range driverUpgradeSteps {
+		if step == state {
+			return i
+		}
+	}
+	return 0
+}
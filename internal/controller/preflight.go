@@ -0,0 +1,205 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	operatorv1alpha1 "github.com/kyma-project/gpu-operator/api/v1alpha1"
+)
+
+// Condition types of the preflight checks run before the first installation
+const (
+	conditionTypePreflightGPUNodes         = "PreflightGPUNodes"
+	conditionTypePreflightKernel           = "PreflightKernel"
+	conditionTypePreflightContainerRuntime = "PreflightContainerRuntime"
+	conditionTypePreflightChartRepository  = "PreflightChartRepository"
+
+	preflightFailedReason = "PreflightFailed"
+	preflightPassedReason = "PreflightPassed"
+
+	// containerdRuntimePrefix starts the container runtime version nodes running containerd report
+	containerdRuntimePrefix = "containerd://"
+)
+
+// preflightConditionTypes are the condition types of the preflight checks, in the order they are reported
+var preflightConditionTypes = []string{
+	conditionTypePreflightGPUNodes,
+	conditionTypePreflightKernel,
+	conditionTypePreflightContainerRuntime,
+	conditionTypePreflightChartRepository,
+}
+
+// preflightError reports the prerequisites the cluster does not meet
+type preflightError struct {
+	failed []metav1.Condition
+}
+
+func (e *preflightError) Error() string {
+	messages := make([]string, 0, len(e.failed))
+	for _, condition := range e.failed {
+		messages = append(messages, condition.Message)
+	}
+	return "preflight checks failed: " + strings.Join(messages, "; ")
+}
+
+// runPreflight checks the prerequisites of the GPU operator before the first installation, so a cluster that
+// cannot run it fails right away instead of after the Helm timeout. The results are set as conditions.
+func (r *GpuOperatorReconciler) runPreflight(ctx context.Context, gpuOperator *operatorv1alpha1.GpuOperator) error {
+	if gpuOperator.Status.InstalledVersion != "" ||
+		gpuOperator.Spec.Install != nil && gpuOperator.Spec.Install.SkipPreflight {
+		return nil
+	}
+
+	// NFD is installed with the chart, so the GPU nodes cannot be recognized by its label yet
+	nodes := &corev1.NodeList{}
+	if err := r.List(ctx, nodes); err != nil {
+		return fmt.Errorf("failed to list nodes: %w", err)
+	}
+	var gpuNodes []*corev1.Node
+	for i := range nodes.Items {
+		if isGPUNode(&nodes.Items[i]) || hasGPUTaint(&nodes.Items[i]) {
+			gpuNodes = append(gpuNodes, &nodes.Items[i])
+		}
+	}
+
+	checks := []metav1.Condition{
+		gpuNodesCheck(gpuNodes),
+		kernelCheck(gpuNodes, driverMode(gpuOperator)),
+		containerRuntimeCheck(gpuNodes),
+		r.chartRepositoryCheck(ctx, gpuOperator),
+	}
+	var failed []metav1.Condition
+	for _, check := range checks {
+		check.ObservedGeneration = gpuOperator.Generation
+		meta.SetStatusCondition(&gpuOperator.Status.Conditions, check)
+		if check.Status != metav1.ConditionTrue {
+			failed = append(failed, check)
+		}
+	}
+	if len(failed) > 0 {
+		return &preflightError{failed: failed}
+	}
+	return nil
+}
+
+// hasGPUTaint reports whether the node is tainted for GPU workloads, as the nodes of GPU worker pools are
+func hasGPUTaint(node *corev1.Node) bool {
+	for _, taint := range node.Spec.Taints {
+		if taint.Key == string(gpuResourceName) {
+			return true
+		}
+	}
+	return false
+}
+
+// preflightCheck returns the condition of a preflight check, passed if the message is empty
+func preflightCheck(conditionType, failure, success string) metav1.Condition {
+	if failure != "" {
+		return metav1.Condition{Type: conditionType, Status: metav1.ConditionFalse, Reason: preflightFailedReason, Message: failure}
+	}
+	return metav1.Condition{Type: conditionType, Status: metav1.ConditionTrue, Reason: preflightPassedReason, Message: success}
+}
+
+// gpuNodesCheck requires at least one node with an NVIDIA PCI device, of a GPU machine type or of a GPU worker pool
+func gpuNodesCheck(gpuNodes []*corev1.Node) metav1.Condition {
+	if len(gpuNodes) == 0 {
+		return preflightCheck(conditionTypePreflightGPUNodes, fmt.Sprintf(
+			"no GPU node found: no node has the label %s=true, a GPU machine type or the taint %s",
+			nvidiaPCILabel, gpuResourceName), "")
+	}
+	return preflightCheck(conditionTypePreflightGPUNodes, "", fmt.Sprintf("GPU nodes found: %d", len(gpuNodes)))
+}
+
+// kernelCheck requires the GPU nodes to run Garden Linux, the OS the driver images and values are built for.
+// A preinstalled driver is built for the kernel of the node image by its vendor.
+func kernelCheck(gpuNodes []*corev1.Node, mode operatorv1alpha1.DriverMode) metav1.Condition {
+	if len(gpuNodes) == 0 {
+		return preflightCheck(conditionTypePreflightKernel, "no GPU node to check the kernel of", "")
+	}
+	if mode == operatorv1alpha1.DriverModePreinstalled {
+		return preflightCheck(conditionTypePreflightKernel, "", "The driver is preinstalled on the GPU nodes, the kernel is not checked")
+	}
+	var kernels, unsupported []string
+	for _, node := range gpuNodes {
+		info := node.Status.NodeInfo
+		if !strings.HasPrefix(info.OSImage, gardenLinuxOSImage) || info.KernelVersion == "" {
+			unsupported = append(unsupported, fmt.Sprintf("%s (%s)", node.Name, info.OSImage))
+			continue
+		}
+		kernels = append(kernels, info.KernelVersion)
+	}
+	if len(unsupported) > 0 {
+		return preflightCheck(conditionTypePreflightKernel,
+			"GPU nodes do not run a Garden Linux kernel: "+strings.Join(unsupported, ", "), "")
+	}
+	return preflightCheck(conditionTypePreflightKernel, "",
+		"GPU nodes run Garden Linux kernel "+strings.Join(uniqueSorted(kernels), ", "))
+}
+
+// containerRuntimeCheck requires the GPU nodes to run containerd, the runtime the container toolkit configures
+func containerRuntimeCheck(gpuNodes []*corev1.Node) metav1.Condition {
+	if len(gpuNodes) == 0 {
+		return preflightCheck(conditionTypePreflightContainerRuntime, "no GPU node to check the container runtime of", "")
+	}
+	var unsupported []string
+	for _, node := range gpuNodes {
+		if runtime := node.Status.NodeInfo.ContainerRuntimeVersion; !strings.HasPrefix(runtime, containerdRuntimePrefix) {
+			unsupported = append(unsupported, fmt.Sprintf("%s (%s)", node.Name, runtime))
+		}
+	}
+	if len(unsupported) > 0 {
+		return preflightCheck(conditionTypePreflightContainerRuntime,
+			"GPU nodes do not run containerd: "+strings.Join(unsupported, ", "), "")
+	}
+	return preflightCheck(conditionTypePreflightContainerRuntime, "", "GPU nodes run containerd")
+}
+
+// chartRepositoryCheck requires the Helm repository to serve the chart in the requested version. The Manifest
+// install engine does not download the chart.
+func (r *GpuOperatorReconciler) chartRepositoryCheck(ctx context.Context, gpuOperator *operatorv1alpha1.GpuOperator) metav1.Condition {
+	if gpuOperator.Spec.InstallEngine == operatorv1alpha1.InstallEngineManifest {
+		return preflightCheck(conditionTypePreflightChartRepository, "", "The Manifest install engine needs no chart repository")
+	}
+	chart, err := r.helmChart(ctx, gpuOperator, helmReleaseName, chartVersion(gpuOperator))
+	if err == nil {
+		err = r.helmClient.FindChart(ctx, chart)
+	}
+	if err != nil {
+		return preflightCheck(conditionTypePreflightChartRepository,
+			fmt.Sprintf("chart %s not available from %s: %v", chart.Name, chart.RepoURL, err), "")
+	}
+	return preflightCheck(conditionTypePreflightChartRepository, "",
+		fmt.Sprintf("Chart %s is available from %s", chart.Name, chart.RepoURL))
+}
+
+// preflightConditions returns the preflight conditions of the CR, to keep them when the conditions are rebuilt
+func preflightConditions(gpuOperator *operatorv1alpha1.GpuOperator) []metav1.Condition {
+	var conditions []metav1.Condition
+	for _, conditionType := range preflightConditionTypes {
+		if condition := meta.FindStatusCondition(gpuOperator.Status.Conditions, conditionType); condition != nil {
+			conditions = append(conditions, *condition)
+		}
+	}
+	return conditions
+}
@@ -0,0 +1,64 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+
+	operatorv1alpha1 "github.com/kyma-project/gpu-operator/api/v1alpha1"
+	"github.com/kyma-project/gpu-operator/internal/helm"
+)
+
+// proxyEnv returns the proxy environment of spec.proxy, in upper and lower case as tools differ in which one
+// they read
+func proxyEnv(gpuOperator *operatorv1alpha1.GpuOperator) []corev1.EnvVar {
+	proxy := gpuOperator.Spec.Proxy
+	if proxy == nil {
+		return nil
+	}
+	var env []corev1.EnvVar
+	add := func(name, value string) {
+		if value != "" {
+			env = append(env, corev1.EnvVar{Name: name, Value: value}, corev1.EnvVar{Name: strings.ToLower(name), Value: value})
+		}
+	}
+	add("HTTP_PROXY", proxy.HTTPProxy)
+	add("HTTPS_PROXY", proxy.HTTPSProxy)
+	add("NO_PROXY", proxy.NoProxy)
+	return env
+}
+
+// proxyValues propagates spec.proxy to the driver containers, which download packages and kernel headers
+// when the driver is compiled on the node. Image pulls use the proxy configuration of the container runtime.
+func proxyValues(gpuOperator *operatorv1alpha1.GpuOperator) []chartValue {
+	env := proxyEnv(gpuOperator)
+	if len(env) == 0 {
+		return nil
+	}
+	return []chartValue{{path: "driver.env", value: env}}
+}
+
+// helmProxy returns the proxy of the chart and values downloads of the Helm install engine
+func helmProxy(gpuOperator *operatorv1alpha1.GpuOperator) helm.Proxy {
+	proxy := gpuOperator.Spec.Proxy
+	if proxy == nil {
+		return helm.Proxy{}
+	}
+	return helm.Proxy{HTTPProxy: proxy.HTTPProxy, HTTPSProxy: proxy.HTTPSProxy, NoProxy: proxy.NoProxy}
+}
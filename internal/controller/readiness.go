@@ -0,0 +1,263 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	appsv1 "k8s.io/api/apps/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	operatorv1alpha1 "github.com/kyma-project/gpu-operator/api/v1alpha1"
+)
+
+// operandApps maps the operand names of spec.readinessChecks to the app label of their DaemonSets
+var operandApps = []struct {
+	name     string
+	app      string
+	required bool
+}{
+	{"driver", "nvidia-driver-daemonset", true},
+	{"container-toolkit", "nvidia-container-toolkit-daemonset", true},
+	{"device-plugin", "nvidia-device-plugin-daemonset", true},
+	{"gpu-feature-discovery", "gpu-feature-discovery", false},
+	{"dcgm", "nvidia-dcgm", false},
+	{"dcgm-exporter", "nvidia-dcgm-exporter", false},
+	{"mig-manager", "nvidia-mig-manager", false},
+	{"validator", "nvidia-operator-validator", true},
+}
+
+// readinessResult is the outcome of evaluating spec.readinessChecks
+type readinessResult struct {
+	ready bool
+	// reason and message describe the first unmet criterion, or the not ready optional operands
+	reason  string
+	message string
+}
+
+// evaluateReadiness checks the installed GPU stack against spec.readinessChecks, and requires GPUs that workloads can
+// be scheduled on unless spec.skipCapacityCheck is set
+func (r *GpuOperatorReconciler) evaluateReadiness(ctx context.Context, gpuOperator *operatorv1alpha1.GpuOperator, namespace string,
+	components []operatorv1alpha1.ComponentStatus) (readinessResult, error) {
+	result, err := r.operandReadiness(ctx, gpuOperator, namespace, components)
+	if err != nil || !result.ready || gpuOperator.Spec.SkipCapacityCheck {
+		return result, err
+	}
+	capacity, err := r.capacityReadiness(ctx, gpuOperator)
+	if err != nil || !capacity.ready {
+		return capacity, err
+	}
+	return result, nil
+}
+
+// capacityReadiness requires at least one GPU node to advertise allocatable nvidia.com/gpu, as a completed
+// installation does not mean the device plugin registered the GPUs yet. It is skipped with DRA, which publishes
+// the GPUs as ResourceSlices, and if all GPU nodes are used for VM passthrough.
+func (r *GpuOperatorReconciler) capacityReadiness(ctx context.Context, gpuOperator *operatorv1alpha1.GpuOperator) (readinessResult, error) {
+	if draEnabled(gpuOperator) {
+		return readinessResult{ready: true}, nil
+	}
+	nodes, err := r.gpuNodes(ctx)
+	if err != nil {
+		return readinessResult{}, err
+	}
+	containerNodes := 0
+	for i := range nodes {
+		if !servesContainers(gpuOperator, &nodes[i]) {
+			continue
+		}
+		if allocatableGPUs(&nodes[i]) > 0 {
+			return readinessResult{ready: true}, nil
+		}
+		containerNodes++
+	}
+	if len(nodes) > 0 && containerNodes == 0 {
+		return readinessResult{ready: true}, nil
+	}
+	return readinessResult{
+		reason:  "NoAllocatableGPUs",
+		message: fmt.Sprintf("No GPU node advertises allocatable %s yet", gpuResourceName),
+	}, nil
+}
+
+// operandReadiness checks the operands and GPU nodes against spec.readinessChecks. Without readiness
+// checks the installation is ready once all its components are rolled out.
+func (r *GpuOperatorReconciler) operandReadiness(ctx context.Context, gpuOperator *operatorv1alpha1.GpuOperator, namespace string,
+	components []operatorv1alpha1.ComponentStatus) (readinessResult, error) {
+	checks := gpuOperator.Spec.ReadinessChecks
+	if checks == nil {
+		return componentsRolledOut(components), nil
+	}
+
+	daemonSets := &appsv1.DaemonSetList{}
+	if err := r.List(ctx, daemonSets, client.InNamespace(namespace)); err != nil {
+		return readinessResult{}, fmt.Errorf("failed to list operand daemonsets: %w", err)
+	}
+	byApp := map[string]*appsv1.DaemonSet{}
+	for i := range daemonSets.Items {
+		if app := daemonSets.Items[i].Labels["app"]; app != "" {
+			byApp[app] = &daemonSets.Items[i]
+		}
+	}
+
+	required := map[string]bool{}
+	for _, operand := range operandApps {
+		required[operand.name] = operand.required
+	}
+	if draEnabled(gpuOperator) {
+		// The DRA driver replaces the device plugin
+		required["device-plugin"] = false
+	}
+	if driverMode(gpuOperator) == operatorv1alpha1.DriverModePreinstalled {
+		// The driver of the node image is validated by the operator validator
+		required["driver"] = false
+	}
+	for _, operand := range checks.Operands {
+		required[operand.Name] = operand.Required
+	}
+
+	var blocking, reported []string
+	for _, operand := range operandApps {
+		daemonSet, deployed := byApp[operand.app]
+		var problem string
+		switch {
+		case !deployed && required[operand.name]:
+			problem = operand.name + " (not deployed)"
+		case !deployed:
+			continue
+		case !isDaemonSetReady(daemonSet):
+			problem = fmt.Sprintf("%s (%d/%d ready)", operand.name, daemonSet.Status.NumberReady, daemonSet.Status.DesiredNumberScheduled)
+		default:
+			continue
+		}
+		if required[operand.name] {
+			blocking = append(blocking, problem)
+		} else {
+			reported = append(reported, problem)
+		}
+	}
+	if len(blocking) > 0 {
+		return readinessResult{
+			reason:  "OperandsNotReady",
+			message: "Required operands not ready: " + strings.Join(blocking, ", "),
+		}, nil
+	}
+
+	minPercent := int32(100)
+	if checks.MinHealthyNodesPercent != nil {
+		minPercent = *checks.MinHealthyNodesPercent
+	}
+	nodes, err := r.gpuNodes(ctx)
+	if err != nil {
+		return readinessResult{}, err
+	}
+	healthy, total := 0, 0
+	for i := range nodes {
+		// VM passthrough nodes advertise their GPUs under model-specific resource names
+		if !servesContainers(gpuOperator, &nodes[i]) {
+			continue
+		}
+		total++
+		// With DRA the GPUs are published as ResourceSlices instead of allocatable resources
+		if isNodeReady(&nodes[i]) && (draEnabled(gpuOperator) || !nodes[i].Status.Allocatable.Name(gpuResourceName, "").IsZero()) {
+			healthy++
+		}
+	}
+	if total > 0 && int32(healthy*100/total) < minPercent {
+		return readinessResult{
+			reason: "InsufficientHealthyNodes",
+			message: fmt.Sprintf("%d of %d GPU nodes are ready and advertise allocatable %s, %d%% required",
+				healthy, total, gpuResourceName, minPercent),
+		}, nil
+	}
+
+	result := readinessResult{ready: true}
+	if len(reported) > 0 {
+		result.message = "Optional operands not ready: " + strings.Join(reported, ", ")
+	}
+	return result, nil
+}
+
+// isDaemonSetReady reports whether the DaemonSet is rolled out and ready on all nodes it targets
+func isDaemonSetReady(daemonSet *appsv1.DaemonSet) bool {
+	status := daemonSet.Status
+	return status.ObservedGeneration >= daemonSet.Generation &&
+		status.UpdatedNumberScheduled == status.DesiredNumberScheduled &&
+		status.NumberReady == status.DesiredNumberScheduled
+}
+
+// maxDegradedNodes limits the nodes named in the degradation message
+const maxDegradedNodes = 3
+
+// degradation describes the operands that are unhealthy on a subset of their nodes, the GPU nodes failing the
+// operator validator or the CUDA validation and the nodes with unhealthy GPUs, empty if there are none. Components that are rolling out a new
+// revision are not degraded.
+func degradation(components []operatorv1alpha1.ComponentStatus, nodes []operatorv1alpha1.NodeStatus,
+	cudaValidation *operatorv1alpha1.CUDAValidationStatus, health *operatorv1alpha1.GPUHealthStatus) string {
+	var problems []string
+	for _, component := range components {
+		if component.Kind == "DaemonSet" && component.UpdatedPods == component.DesiredPods &&
+			component.ReadyPods > 0 && component.ReadyPods < component.DesiredPods {
+			problems = append(problems, fmt.Sprintf("%s unhealthy on %d of %d nodes", component.Name,
+				component.DesiredPods-component.ReadyPods, component.DesiredPods))
+		}
+	}
+	var failing []string
+	for _, node := range nodes {
+		if node.Validations.Message != "" {
+			failing = append(failing, node.Name)
+		}
+	}
+	if len(failing) > 0 {
+		problems = append(problems, fmt.Sprintf("validation failing on %d of %d GPU nodes (%s)",
+			len(failing), len(nodes), namedNodes(failing)))
+	}
+	if cudaValidation != nil {
+		failing = nil
+		for _, node := range cudaValidation.Nodes {
+			if node.Result == operatorv1alpha1.ValidationFailed {
+				failing = append(failing, node.Name)
+			}
+		}
+		if len(failing) > 0 {
+			problems = append(problems, fmt.Sprintf("CUDA validation failing on %d of %d GPU nodes (%s)",
+				len(failing), len(cudaValidation.Nodes), namedNodes(failing)))
+		}
+	}
+	if health != nil && len(health.Nodes) > 0 {
+		failing = nil
+		for _, node := range health.Nodes {
+			failing = append(failing, node.Name)
+		}
+		problems = append(problems, fmt.Sprintf("%d unhealthy GPUs on %d nodes (%s)",
+			health.UnhealthyGPUs, len(health.Nodes), namedNodes(failing)))
+	}
+	if len(problems) == 0 {
+		return ""
+	}
+	return "Degraded: " + strings.Join(problems, "; ")
+}
+
+// namedNodes lists the nodes for the degradation message, at most maxDegradedNodes of them
+func namedNodes(nodes []string) string {
+	if len(nodes) > maxDegradedNodes {
+		nodes = append(nodes[:maxDegradedNodes:maxDegradedNodes], "...")
+	}
+	return strings.Join(nodes, ", ")
+}
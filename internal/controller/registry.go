@@ -0,0 +1,252 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	operatorv1alpha1 "github.com/kyma-project/gpu-operator/api/v1alpha1"
+	"github.com/kyma-project/gpu-operator/internal/images"
+)
+
+const (
+	registryComponent = "registry"
+)
+
+// operandRepositories are the default image repositories of the operand sections of the chart. The driver
+// repository is the one of the Garden Linux values.
+var operandRepositories = []struct {
+	section    string
+	repository string
+	// chartOnly sections have no ClusterPolicy counterpart
+	chartOnly bool
+}{
+	{"operator", "nvcr.io/nvidia", true},
+	{"driver", gardenLinuxDriverRepository, false},
+	{"toolkit", "nvcr.io/nvidia/k8s", false},
+	{"devicePlugin", "nvcr.io/nvidia", false},
+	{"dcgm", "nvcr.io/nvidia/cloud-native", false},
+	{"dcgmExporter", "nvcr.io/nvidia/k8s", false},
+	{"gfd", "nvcr.io/nvidia", false},
+	{"migManager", "nvcr.io/nvidia/cloud-native", false},
+	{"nodeStatusExporter", "nvcr.io/nvidia/cloud-native", false},
+	{"gdrcopy", "nvcr.io/nvidia/cloud-native", false},
+	{"validator", "nvcr.io/nvidia/cloud-native", false},
+	{"vgpuDeviceManager", "nvcr.io/nvidia/cloud-native", false},
+	{"vfioManager", "nvcr.io/nvidia", false},
+	{"sandboxDevicePlugin", "nvcr.io/nvidia", false},
+	{"kataManager", "nvcr.io/nvidia/cloud-native", false},
+	{"ccManager", "nvcr.io/nvidia/cloud-native", false},
+}
+
+// nfdRepository is the default image repository of the Node Feature Discovery subchart
+const nfdRepository = "registry.k8s.io/nfd/node-feature-discovery"
+
+// registryValues rewrites the image references of the chart values to spec.registry.repository and adds the
+// image pull secrets of the mirror. The repositories and pinned images set by the spec are mirrored, all
+// other operands are pointed at the mirror of their default repository.
+func registryValues(gpuOperator *operatorv1alpha1.GpuOperator, values []chartValue) []chartValue {
+	registry := gpuOperator.Spec.Registry
+	if registry == nil {
+		return values
+	}
+	set := map[string]int{}
+	for i, v := range values {
+		set[v.path] = i
+	}
+	mirror := func(path string) bool {
+		i, ok := set[path]
+		if !ok {
+			return false
+		}
+		if ref, isString := values[i].value.(string); isString && ref != "" {
+			values[i].value = images.Mirror(registry.Repository, ref)
+			return true
+		}
+		// An empty repository belongs to a pinned image
+		return true
+	}
+
+	pullSecrets := registryPullSecretNames(gpuOperator)
+	for _, operand := range operandRepositories {
+		imageMirrored := mirror(operand.section + ".image")
+		if !mirror(operand.section+".repository") && !imageMirrored {
+			values = append(values, chartValue{path: operand.section + ".repository",
+				value: images.Mirror(registry.Repository, operand.repository), chartOnly: operand.chartOnly})
+		}
+		if len(pullSecrets) == 0 {
+			continue
+		}
+		path := operand.section + ".imagePullSecrets"
+		if i, ok := set[path]; ok {
+			// Keep the pull secrets set by other settings, e.g. the NGC secret of the driver
+			existing, _ := values[i].value.([]string)
+			values[i].value = append(append([]string{}, existing...), pullSecrets...)
+			continue
+		}
+		values = append(values, chartValue{path: path, value: pullSecrets, chartOnly: operand.chartOnly})
+	}
+
+	if !mirror("node-feature-discovery.image.repository") {
+		values = append(values, chartValue{path: "node-feature-discovery.image.repository",
+			value: images.Mirror(registry.Repository, nfdRepository), chartOnly: true})
+	}
+	if len(pullSecrets) > 0 {
+		nfdSecrets := make([]map[string]string, 0, len(pullSecrets))
+		for _, name := range pullSecrets {
+			nfdSecrets = append(nfdSecrets, map[string]string{"name": name})
+		}
+		values = append(values, chartValue{path: "node-feature-discovery.imagePullSecrets", value: nfdSecrets, chartOnly: true})
+	}
+	return values
+}
+
+// applyRegistry rewrites the images of a rendered Deployment or DaemonSet, the operator and NFD, to
+// spec.registry.repository and adds the image pull secrets of the mirror. The operands are covered by the
+// chart values set on the ClusterPolicy.
+func applyRegistry(gpuOperator *operatorv1alpha1.GpuOperator, obj *unstructured.Unstructured) error {
+	registry := gpuOperator.Spec.Registry
+	if registry == nil || obj.GetKind() != "Deployment" && obj.GetKind() != "DaemonSet" {
+		return nil
+	}
+	for _, field := range []string{"initContainers", "containers"} {
+		fields := []string{"spec", "template", "spec", field}
+		containers, found, err := unstructured.NestedSlice(obj.Object, fields...)
+		if err != nil || !found {
+			continue
+		}
+		for _, c := range containers {
+			if container, ok := c.(map[string]interface{}); ok {
+				if image, ok := container["image"].(string); ok && image != "" {
+					container["image"] = images.Mirror(registry.Repository, image)
+				}
+			}
+		}
+		if err := unstructured.SetNestedSlice(obj.Object, containers, fields...); err != nil {
+			return fmt.Errorf("failed to set images of %s/%s: %w", obj.GetKind(), obj.GetName(), err)
+		}
+	}
+	names := registryPullSecretNames(gpuOperator)
+	if len(names) == 0 {
+		return nil
+	}
+	pullSecrets := make([]interface{}, 0, len(names))
+	for _, name := range names {
+		pullSecrets = append(pullSecrets, map[string]interface{}{"name": name})
+	}
+	if err := unstructured.SetNestedSlice(obj.Object, pullSecrets, "spec", "template", "spec", "imagePullSecrets"); err != nil {
+		return fmt.Errorf("failed to set image pull secrets of %s/%s: %w", obj.GetKind(), obj.GetName(), err)
+	}
+	return nil
+}
+
+// registryPullSecretNames returns the names of the image pull secrets of spec.registry
+func registryPullSecretNames(gpuOperator *operatorv1alpha1.GpuOperator) []string {
+	if gpuOperator.Spec.Registry == nil {
+		return nil
+	}
+	names := make([]string, 0, len(gpuOperator.Spec.Registry.ImagePullSecrets))
+	for _, ref := range gpuOperator.Spec.Registry.ImagePullSecrets {
+		names = append(names, ref.Name)
+	}
+	return names
+}
+
+// registryPullSecrets returns the image pull secrets of the helper pods launched by the controller
+func registryPullSecrets(gpuOperator *operatorv1alpha1.GpuOperator) []corev1.LocalObjectReference {
+	var refs []corev1.LocalObjectReference
+	for _, name := range registryPullSecretNames(gpuOperator) {
+		refs = append(refs, corev1.LocalObjectReference{Name: name})
+	}
+	return refs
+}
+
+// registryCABundle returns the CA certificates the Helm repository is verified with, empty for the system CAs
+func registryCABundle(gpuOperator *operatorv1alpha1.GpuOperator) string {
+	if gpuOperator.Spec.Registry == nil {
+		return ""
+	}
+	return strings.TrimSpace(gpuOperator.Spec.Registry.CABundle)
+}
+
+// reconcileRegistry copies the image pull secrets of spec.registry into the installation namespace. Copies that
+// are no longer referenced are removed. Secrets already in the installation namespace of the same cluster are
+// used as they are.
+func (r *GpuOperatorReconciler) reconcileRegistry(ctx context.Context, gpuOperator *operatorv1alpha1.GpuOperator, namespace string) error {
+	copied := map[string]bool{}
+	sameNamespace := !r.remote && namespace == gpuOperator.Namespace
+	for _, ref := range registryPullSecrets(gpuOperator) {
+		source := &corev1.Secret{}
+		if err := r.localReader().Get(ctx, types.NamespacedName{Name: ref.Name, Namespace: gpuOperator.Namespace}, source); err != nil {
+			return fmt.Errorf("failed to get registry image pull secret %s: %w", ref.Name, err)
+		}
+		if source.Type != corev1.SecretTypeDockerConfigJson {
+			return fmt.Errorf("registry image pull secret %s must be of type %s", ref.Name, corev1.SecretTypeDockerConfigJson)
+		}
+		if sameNamespace {
+			continue
+		}
+		secret := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: ref.Name, Namespace: namespace}}
+		if _, err := controllerutil.CreateOrUpdate(ctx, r.Client, secret, func() error {
+			if secret.CreationTimestamp.IsZero() || secret.Labels["app.kubernetes.io/component"] == registryComponent {
+				secret.Labels = registryLabels()
+				secret.Type = source.Type
+				secret.Data = source.Data
+				return r.setControllerReference(gpuOperator, secret)
+			}
+			return fmt.Errorf("secret %s already exists in namespace %s and is not managed by the controller", ref.Name, namespace)
+		}); err != nil {
+			return fmt.Errorf("failed to copy registry image pull secret %s: %w", ref.Name, err)
+		}
+		copied[ref.Name] = true
+	}
+
+	secrets := &corev1.SecretList{}
+	if err := r.List(ctx, secrets, client.InNamespace(namespace),
+		client.MatchingLabels{"app.kubernetes.io/component": registryComponent}); err != nil {
+		return fmt.Errorf("failed to list registry image pull secrets: %w", err)
+	}
+	for i := range secrets.Items {
+		if copied[secrets.Items[i].Name] {
+			continue
+		}
+		if err := r.Delete(ctx, &secrets.Items[i]); err != nil && !apierrors.IsNotFound(err) {
+			return fmt.Errorf("failed to delete registry image pull secret %s: %w", secrets.Items[i].Name, err)
+		}
+	}
+
+	return nil
+}
+
+// registryLabels returns the labels of the objects derived from spec.registry
+func registryLabels() map[string]string {
+	return map[string]string{
+		"app.kubernetes.io/name":       "gpu-operator",
+		"app.kubernetes.io/managed-by": "gpu-operator-module",
+		"app.kubernetes.io/component":  registryComponent,
+	}
+}
@@ -0,0 +1,92 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	operatorv1alpha1 "github.com/kyma-project/gpu-operator/api/v1alpha1"
+	"github.com/kyma-project/gpu-operator/internal/logging"
+)
+
+// reinstallAnnotation requests a clean reinstall of the GPU operator; every new value, e.g. a timestamp,
+// triggers one reinstall
+const reinstallAnnotation = "operator.kyma-project.io/reinstall"
+
+// reconcileReinstall runs a reinstall requested with the reinstall annotation. The Helm release state is
+// deleted and status.installHash cleared, so the next Helm operation installs the release from scratch and
+// adopts the existing resources.
+// The Manifest install engine re-applies the manifests on every reconcile and needs no cleanup.
+func (r *GpuOperatorReconciler) reconcileReinstall(ctx context.Context, gpuOperator *operatorv1alpha1.GpuOperator, namespace string) error {
+	requested := gpuOperator.GetAnnotations()[reinstallAnnotation]
+	if requested == "" || requested == gpuOperator.Status.ObservedReinstall {
+		return nil
+	}
+	logger := log.FromContext(ctx).WithName(logging.SubsystemHelm)
+
+	if gpuOperator.Spec.InstallEngine != operatorv1alpha1.InstallEngineManifest {
+		secrets := &corev1.SecretList{}
+		if err := r.List(ctx, secrets, client.InNamespace(namespace),
+			client.MatchingLabels{"owner": "helm", "name": helmReleaseName}); err != nil {
+			return fmt.Errorf("failed to list Helm release secrets: %w", err)
+		}
+		for i := range secrets.Items {
+			if err := r.Delete(ctx, &secrets.Items[i]); err != nil && !apierrors.IsNotFound(err) {
+				return fmt.Errorf("failed to delete Helm release secret %s: %w", secrets.Items[i].Name, err)
+			}
+		}
+		logger.Info("Deleted Helm release state for requested reinstall", "revisions", len(secrets.Items))
+	}
+
+	resetInstallHash(gpuOperator)
+	gpuOperator.Status.ObservedReinstall = requested
+	if err := r.updateStatus(ctx, gpuOperator); err != nil {
+		return fmt.Errorf("failed to record reinstall: %w", err)
+	}
+	logger.Info("Reinstalling GPU operator", "reinstall", requested)
+	return nil
+}
+
+// forceReinstallAnnotation requests another Helm upgrade of the GPU operator release with the current chart
+// and values, even if the spec is unchanged; every new value, e.g. a timestamp, triggers one upgrade. The
+// upgrade is forced in installWithSDK, the Manifest install engine re-applies the manifests on every reconcile.
+const forceReinstallAnnotation = "operator.kyma-project.io/force-reinstall"
+
+// forceReinstallRequested returns the value of the force-reinstall annotation that was not handled yet,
+// empty if there is none
+func forceReinstallRequested(gpuOperator *operatorv1alpha1.GpuOperator) string {
+	requested := gpuOperator.GetAnnotations()[forceReinstallAnnotation]
+	if requested == gpuOperator.Status.ObservedForceReinstall {
+		return ""
+	}
+	return requested
+}
+
+// recordForceReinstall records the handled value of the force-reinstall annotation
+func (r *GpuOperatorReconciler) recordForceReinstall(ctx context.Context, gpuOperator *operatorv1alpha1.GpuOperator, requested string) error {
+	gpuOperator.Status.ObservedForceReinstall = requested
+	if err := r.updateStatus(ctx, gpuOperator); err != nil {
+		return fmt.Errorf("failed to record forced reinstall: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,245 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/clientcmd"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	operatorv1alpha1 "github.com/kyma-project/gpu-operator/api/v1alpha1"
+)
+
+// remoteClientCache keeps the clients of the remote clusters per GpuOperator CR, so a client is only
+// built again when the kubeconfig Secret changes
+type remoteClientCache struct {
+	mu      sync.Mutex
+	clients map[types.NamespacedName]remoteClient
+}
+
+type remoteClient struct {
+	secretVersion string
+	client        client.Client
+	restConfig    *rest.Config
+}
+
+func newRemoteClientCache() *remoteClientCache {
+	return &remoteClientCache{clients: map[types.NamespacedName]remoteClient{}}
+}
+
+// forget drops the client of a CR
+func (c *remoteClientCache) forget(key types.NamespacedName) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.clients, key)
+}
+
+// forTargetCluster returns the reconciler to use for the cluster the CR installs the GPU stack into.
+// With spec.targetClusterKubeconfigSecretRef it is a copy of r whose client reads and writes everything
+// but the GpuOperator CRs in the remote cluster.
+func (r *GpuOperatorReconciler) forTargetCluster(ctx context.Context, gpuOperator *operatorv1alpha1.GpuOperator) (*GpuOperatorReconciler, error) {
+	ref := gpuOperator.Spec.TargetClusterKubeconfigSecretRef
+	if ref == nil {
+		return r, nil
+	}
+	remote, err := r.remoteClient(ctx, gpuOperator, ref)
+	if err != nil {
+		return nil, err
+	}
+	target := *r
+	target.Client = &targetClusterClient{Client: remote.client, local: r.Client}
+	target.restConfig = remote.restConfig
+	target.remote = true
+	return &target, nil
+}
+
+// remoteClient returns the cached client and REST config of the remote cluster, or builds one from the kubeconfig Secret
+func (r *GpuOperatorReconciler) remoteClient(ctx context.Context, gpuOperator *operatorv1alpha1.GpuOperator,
+	ref *operatorv1alpha1.KubeconfigSecretReference) (remoteClient, error) {
+	secret := &corev1.Secret{}
+	if err := r.Get(ctx, types.NamespacedName{Name: ref.Name, Namespace: gpuOperator.Namespace}, secret); err != nil {
+		return remoteClient{}, fmt.Errorf("failed to get target cluster kubeconfig Secret %s: %w", ref.Name, err)
+	}
+	key := client.ObjectKeyFromObject(gpuOperator)
+	r.remoteClients.mu.Lock()
+	defer r.remoteClients.mu.Unlock()
+	if cached, ok := r.remoteClients.clients[key]; ok && cached.secretVersion == secret.ResourceVersion {
+		return cached, nil
+	}
+
+	secretKey := ref.Key
+	if secretKey == "" {
+		secretKey = "kubeconfig"
+	}
+	data, ok := secret.Data[secretKey]
+	if !ok {
+		return remoteClient{}, fmt.Errorf("target cluster kubeconfig Secret %s has no key %s", ref.Name, secretKey)
+	}
+	kubeconfig, err := clientcmd.Load(data)
+	if err != nil {
+		return remoteClient{}, fmt.Errorf("failed to parse target cluster kubeconfig: %w", err)
+	}
+	if err := validateKubeconfig(kubeconfig); err != nil {
+		return remoteClient{}, fmt.Errorf("invalid target cluster kubeconfig: %w", err)
+	}
+	restConfig, err := clientcmd.NewDefaultClientConfig(*kubeconfig, &clientcmd.ConfigOverrides{}).ClientConfig()
+	if err != nil {
+		return remoteClient{}, fmt.Errorf("failed to load target cluster kubeconfig: %w", err)
+	}
+	remote, err := client.New(restConfig, client.Options{Scheme: r.Scheme})
+	if err != nil {
+		return remoteClient{}, fmt.Errorf("failed to create target cluster client: %w", err)
+	}
+	cached := remoteClient{secretVersion: secret.ResourceVersion, client: remote, restConfig: restConfig}
+	r.remoteClients.clients[key] = cached
+	return cached, nil
+}
+
+// validateKubeconfig rejects kubeconfigs that run commands or read files in the controller container,
+// which would expose the credentials of the controller to whoever can create the Secret
+func validateKubeconfig(kubeconfig *clientcmdapi.Config) error {
+	for name, authInfo := range kubeconfig.AuthInfos {
+		switch {
+		case authInfo.Exec != nil || authInfo.AuthProvider != nil:
+			return fmt.Errorf("user %s uses an exec or auth provider plugin, which is not supported", name)
+		case authInfo.TokenFile != "" || authInfo.ClientCertificate != "" || authInfo.ClientKey != "":
+			return fmt.Errorf("user %s references files, the credentials must be embedded", name)
+		}
+	}
+	for name, cluster := range kubeconfig.Clusters {
+		if cluster.CertificateAuthority != "" {
+			return fmt.Errorf("cluster %s references a CA file, the CA must be embedded", name)
+		}
+	}
+	return nil
+}
+
+// localReader returns the client of the cluster the controller runs in, for the objects referenced next to the CR
+func (r *GpuOperatorReconciler) localReader() client.Reader {
+	if target, ok := r.Client.(*targetClusterClient); ok {
+		return target.local
+	}
+	return r.Client
+}
+
+// setControllerReference makes the CR the controller of an object it created. Objects in a remote cluster
+// get no owner reference, the garbage collector there would delete them right away.
+func (r *GpuOperatorReconciler) setControllerReference(gpuOperator *operatorv1alpha1.GpuOperator, obj client.Object) error {
+	if r.remote {
+		return nil
+	}
+	return controllerutil.SetControllerReference(gpuOperator, obj, r.Scheme)
+}
+
+// deleteRemoteModuleObjects deletes the objects that are garbage collected with the CR in the local cluster,
+// but have no owner reference in a remote cluster
+func (r *GpuOperatorReconciler) deleteRemoteModuleObjects(ctx context.Context, namespace string) error {
+	if !r.remote {
+		return nil
+	}
+	if err := r.DeleteAllOf(ctx, &appsv1.DaemonSet{}, client.InNamespace(namespace),
+		client.MatchingLabels{"app.kubernetes.io/component": reservedGPUsComponent}); err != nil && !apierrors.IsNotFound(err) {
+		return fmt.Errorf("failed to delete reserved GPU device plugins: %w", err)
+	}
+	// The smoke test Jobs are owned by their CronJob within the remote cluster
+	if err := r.DeleteAllOf(ctx, &batchv1.CronJob{}, client.InNamespace(namespace),
+		client.MatchingLabels{"app.kubernetes.io/component": smokeTestComponent},
+		client.PropagationPolicy(metav1.DeletePropagationBackground)); err != nil && !apierrors.IsNotFound(err) {
+		return fmt.Errorf("failed to delete smoke test CronJobs: %w", err)
+	}
+	if err := r.DeleteAllOf(ctx, &corev1.Pod{}, client.InNamespace(namespace),
+		client.MatchingLabels{"app.kubernetes.io/component": cudaValidationComponent}); err != nil && !apierrors.IsNotFound(err) {
+		return fmt.Errorf("failed to delete CUDA validation pods: %w", err)
+	}
+	if err := r.DeleteAllOf(ctx, &corev1.Secret{}, client.InNamespace(namespace),
+		client.MatchingLabels{"app.kubernetes.io/component": ngcComponent}); err != nil && !apierrors.IsNotFound(err) {
+		return fmt.Errorf("failed to delete NGC secrets: %w", err)
+	}
+	if err := r.deleteVGPUObjects(ctx, namespace); err != nil {
+		return err
+	}
+	return nil
+}
+
+// targetClusterClient reads and writes the GpuOperator CRs in the local cluster and all other objects
+// in the remote cluster
+type targetClusterClient struct {
+	client.Client
+	local client.Client
+}
+
+// isLocalObject reports whether an object lives in the cluster the controller runs in
+func isLocalObject(obj runtime.Object) bool {
+	switch obj.(type) {
+	case *operatorv1alpha1.GpuOperator, *operatorv1alpha1.GpuOperatorList:
+		return true
+	}
+	return false
+}
+
+func (c *targetClusterClient) clientFor(obj runtime.Object) client.Client {
+	if isLocalObject(obj) {
+		return c.local
+	}
+	return c.Client
+}
+
+func (c *targetClusterClient) Get(ctx context.Context, key client.ObjectKey, obj client.Object, opts ...client.GetOption) error {
+	return c.clientFor(obj).Get(ctx, key, obj, opts...)
+}
+
+func (c *targetClusterClient) List(ctx context.Context, list client.ObjectList, opts ...client.ListOption) error {
+	return c.clientFor(list).List(ctx, list, opts...)
+}
+
+func (c *targetClusterClient) Create(ctx context.Context, obj client.Object, opts ...client.CreateOption) error {
+	return c.clientFor(obj).Create(ctx, obj, opts...)
+}
+
+func (c *targetClusterClient) Delete(ctx context.Context, obj client.Object, opts ...client.DeleteOption) error {
+	return c.clientFor(obj).Delete(ctx, obj, opts...)
+}
+
+func (c *targetClusterClient) Update(ctx context.Context, obj client.Object, opts ...client.UpdateOption) error {
+	return c.clientFor(obj).Update(ctx, obj, opts...)
+}
+
+func (c *targetClusterClient) Patch(ctx context.Context, obj client.Object, patch client.Patch, opts ...client.PatchOption) error {
+	return c.clientFor(obj).Patch(ctx, obj, patch, opts...)
+}
+
+func (c *targetClusterClient) DeleteAllOf(ctx context.Context, obj client.Object, opts ...client.DeleteAllOfOption) error {
+	return c.clientFor(obj).DeleteAllOf(ctx, obj, opts...)
+}
+
+// Status returns the status writer of the local cluster; only the GpuOperator CRs have their status written
+func (c *targetClusterClient) Status() client.SubResourceWriter {
+	return c.local.Status()
+}
@@ -0,0 +1,287 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	operatorv1alpha1 "github.com/kyma-project/gpu-operator/api/v1alpha1"
+)
+
+const (
+	reservedGPUsComponent      = "reserved-gpus-device-plugin"
+	reservedDevicePluginPrefix = "nvidia-device-plugin-reserved-"
+
+	// deployDevicePluginLabel controls whether the GPU operator deploys its device plugin on a node
+	deployDevicePluginLabel = "nvidia.com/gpu.deploy.device-plugin"
+	// reservedGPUsLabel marks the nodes whose device plugin is replaced by the controller
+	reservedGPUsLabel = "operator.kyma-project.io/reserved-gpus"
+	// gpuCountLabel is set by GFD to the number of GPUs of a node
+	gpuCountLabel = "nvidia.com/gpu.count"
+
+	devicePluginPath = "/var/lib/kubelet/device-plugins"
+)
+
+// +kubebuilder:rbac:groups="",resources=nodes,verbs=get;list;watch;update;patch
+
+// devicePluginImage returns the image of the device plugin serving worker pools with reserved GPUs
+func (r *GpuOperatorReconciler) devicePluginImage(gpuOperator *operatorv1alpha1.GpuOperator) string {
+	if image := r.fipsHelperImage(gpuOperator, "devicePlugin"); image != "" {
+		return image
+	}
+	return r.helperImage(gpuOperator, r.Config.Get().DevicePluginImage, func(i *operatorv1alpha1.HelperImages) string {
+		return i.DevicePlugin
+	})
+}
+
+// reconcileReservedGPUs replaces the device plugin of the GPU operator on the worker pools listed in
+// spec.devicePlugin.reservedGpus by one DaemonSet per pool that only sees the GPUs that are not reserved.
+// The nodes of other pools get the device plugin of the GPU operator back.
+func (r *GpuOperatorReconciler) reconcileReservedGPUs(ctx context.Context, gpuOperator *operatorv1alpha1.GpuOperator, namespace string) error {
+	logger := log.FromContext(ctx)
+
+	reservations := map[string]operatorv1alpha1.ReservedGPUs{}
+	if gpuOperator.Spec.DevicePlugin != nil {
+		for _, reservation := range gpuOperator.Spec.DevicePlugin.ReservedGPUs {
+			reservations[reservation.Pool] = reservation
+		}
+	}
+
+	nodes, err := r.gpuNodes(ctx)
+	if err != nil {
+		return err
+	}
+	poolNodes := map[string][]*corev1.Node{}
+	for i := range nodes {
+		if pool := nodes[i].Labels[gardenerPoolLabel]; pool != "" {
+			poolNodes[pool] = append(poolNodes[pool], &nodes[i])
+		}
+	}
+
+	active := map[string]bool{}
+	for pool, reservation := range reservations {
+		if len(poolNodes[pool]) == 0 {
+			continue
+		}
+		visible, err := visibleGPUs(reservation, poolNodes[pool])
+		if err != nil {
+			return fmt.Errorf("invalid reservedGpus of pool %s: %w", pool, err)
+		}
+		if visible == "" {
+			logger.Info("Waiting for GFD to report the GPU count before reserving GPUs", "pool", pool)
+			continue
+		}
+
+		daemonSet := &appsv1.DaemonSet{
+			ObjectMeta: metav1.ObjectMeta{Name: reservedDevicePluginPrefix + pool, Namespace: namespace},
+		}
+		result, err := controllerutil.CreateOrUpdate(ctx, r.Client, daemonSet, func() error {
+			daemonSet.Labels = reservedGPUsLabels(pool)
+			r.reservedDevicePluginSpec(gpuOperator, pool, visible, &daemonSet.Spec)
+			return r.setControllerReference(gpuOperator, daemonSet)
+		})
+		if err != nil {
+			return fmt.Errorf("failed to reconcile device plugin of pool %s: %w", pool, err)
+		}
+		if result != controllerutil.OperationResultNone {
+			logger.Info("Reconciled device plugin for reserved GPUs", "daemonSet", daemonSet.Name, "visibleGPUs", visible)
+		}
+
+		for _, node := range poolNodes[pool] {
+			if err := r.setDevicePluginReplaced(ctx, node, true); err != nil {
+				return err
+			}
+		}
+		active[pool] = true
+	}
+
+	if err := r.restoreDevicePluginNodes(ctx, active); err != nil {
+		return err
+	}
+
+	daemonSets := &appsv1.DaemonSetList{}
+	if err := r.List(ctx, daemonSets, client.InNamespace(namespace),
+		client.MatchingLabels{"app.kubernetes.io/component": reservedGPUsComponent}); err != nil {
+		return fmt.Errorf("failed to list reserved GPU device plugins: %w", err)
+	}
+	for i := range daemonSets.Items {
+		daemonSet := &daemonSets.Items[i]
+		if active[daemonSet.Labels[gpuPoolLabel]] {
+			continue
+		}
+		if err := r.Delete(ctx, daemonSet); err != nil && !apierrors.IsNotFound(err) {
+			return fmt.Errorf("failed to delete device plugin %s: %w", daemonSet.Name, err)
+		}
+		logger.Info("Deleted device plugin for reserved GPUs", "daemonSet", daemonSet.Name)
+	}
+	return nil
+}
+
+// restoreDevicePluginNodes hands the nodes whose device plugin was replaced back to the GPU operator,
+// except for the nodes of the given pools
+func (r *GpuOperatorReconciler) restoreDevicePluginNodes(ctx context.Context, keepPools map[string]bool) error {
+	nodes := &corev1.NodeList{}
+	if err := r.List(ctx, nodes, client.HasLabels{reservedGPUsLabel}); err != nil {
+		return fmt.Errorf("failed to list nodes with reserved GPUs: %w", err)
+	}
+	for i := range nodes.Items {
+		if keepPools[nodes.Items[i].Labels[gardenerPoolLabel]] {
+			continue
+		}
+		if err := r.setDevicePluginReplaced(ctx, &nodes.Items[i], false); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// setDevicePluginReplaced disables the device plugin of the GPU operator on the node, or enables it again
+func (r *GpuOperatorReconciler) setDevicePluginReplaced(ctx context.Context, node *corev1.Node, replaced bool) error {
+	_, marked := node.Labels[reservedGPUsLabel]
+	if replaced && marked && node.Labels[deployDevicePluginLabel] == "false" || !replaced && !marked {
+		return nil
+	}
+	patch := client.MergeFrom(node.DeepCopy())
+	if replaced {
+		if node.Labels == nil {
+			node.Labels = map[string]string{}
+		}
+		node.Labels[reservedGPUsLabel] = "true"
+		node.Labels[deployDevicePluginLabel] = "false"
+	} else {
+		delete(node.Labels, reservedGPUsLabel)
+		// The GPU operator labels the node again
+		delete(node.Labels, deployDevicePluginLabel)
+	}
+	if err := r.Patch(ctx, node, patch); err != nil {
+		return fmt.Errorf("failed to label node %s: %w", node.Name, err)
+	}
+	return nil
+}
+
+// visibleGPUs returns the comma-separated indices of the GPUs that are not reserved on the nodes of a pool,
+// empty if GFD has not reported the GPU count of any node yet
+func visibleGPUs(reservation operatorv1alpha1.ReservedGPUs, nodes []*corev1.Node) (string, error) {
+	count := 0
+	for _, node := range nodes {
+		if n, err := strconv.Atoi(node.Labels[gpuCountLabel]); err == nil && n > count {
+			count = n
+		}
+	}
+	if count == 0 {
+		return "", nil
+	}
+
+	reserved := map[int]bool{}
+	if reservation.Count != nil {
+		for i := 0; i < int(*reservation.Count); i++ {
+			reserved[i] = true
+		}
+	}
+	for _, index := range reservation.Indices {
+		if index < 0 || int(index) >= count {
+			return "", fmt.Errorf("GPU index %d does not exist, the nodes have %d GPUs", index, count)
+		}
+		reserved[int(index)] = true
+	}
+	if len(reserved) >= count {
+		return "", fmt.Errorf("reserving %d GPUs leaves none of the %d GPUs of the nodes for workloads", len(reserved), count)
+	}
+
+	visible := make([]string, 0, count-len(reserved))
+	for i := 0; i < count; i++ {
+		if !reserved[i] {
+			visible = append(visible, strconv.Itoa(i))
+		}
+	}
+	return strings.Join(visible, ","), nil
+}
+
+// reservedDevicePluginSpec runs the NVIDIA device plugin on the nodes of a pool, restricted to the visible GPUs
+func (r *GpuOperatorReconciler) reservedDevicePluginSpec(gpuOperator *operatorv1alpha1.GpuOperator, pool, visible string, spec *appsv1.DaemonSetSpec) {
+	selector := map[string]string{
+		"app.kubernetes.io/component": reservedGPUsComponent,
+		gpuPoolLabel:                  pool,
+	}
+	if spec.Selector == nil {
+		// The selector is immutable
+		spec.Selector = &metav1.LabelSelector{MatchLabels: selector}
+	}
+	hostPathType := corev1.HostPathDirectory
+	spec.Template = corev1.PodTemplateSpec{
+		ObjectMeta: metav1.ObjectMeta{Labels: reservedGPUsLabels(pool)},
+		Spec: corev1.PodSpec{
+			NodeSelector:      map[string]string{gardenerPoolLabel: pool, nvidiaPCILabel: "true"},
+			PriorityClassName: "system-node-critical",
+			RuntimeClassName:  ptr.To(runtimeClassName(gpuOperator)),
+			ImagePullSecrets:  registryPullSecrets(gpuOperator),
+			Tolerations: []corev1.Toleration{
+				{Key: string(gpuResourceName), Operator: corev1.TolerationOpExists, Effect: corev1.TaintEffectNoSchedule},
+			},
+			Containers: []corev1.Container{
+				{
+					Name:    "nvidia-device-plugin",
+					Image:   r.devicePluginImage(gpuOperator),
+					Command: []string{"nvidia-device-plugin"},
+					Env: []corev1.EnvVar{
+						// Only the visible GPUs are mounted into the container and advertised
+						{Name: "NVIDIA_VISIBLE_DEVICES", Value: visible},
+						{Name: "NVIDIA_DRIVER_CAPABILITIES", Value: "compute,utility"},
+						{Name: "DEVICE_LIST_STRATEGY", Value: "envvar"},
+						{Name: "DEVICE_ID_STRATEGY", Value: "uuid"},
+						{Name: "PASS_DEVICE_SPECS", Value: "true"},
+						{Name: "FAIL_ON_INIT_ERROR", Value: "true"},
+					},
+					SecurityContext: &corev1.SecurityContext{Privileged: ptr.To(true)},
+					VolumeMounts: []corev1.VolumeMount{
+						{Name: "device-plugin", MountPath: devicePluginPath},
+					},
+				},
+			},
+			Volumes: []corev1.Volume{
+				{
+					Name: "device-plugin",
+					VolumeSource: corev1.VolumeSource{
+						HostPath: &corev1.HostPathVolumeSource{Path: devicePluginPath, Type: &hostPathType},
+					},
+				},
+			},
+		},
+	}
+}
+
+// reservedGPUsLabels returns the labels of the device plugin DaemonSet and pods of a pool
+func reservedGPUsLabels(pool string) map[string]string {
+	return map[string]string{
+		"app.kubernetes.io/name":       "gpu-operator",
+		"app.kubernetes.io/managed-by": "gpu-operator-module",
+		"app.kubernetes.io/component":  reservedGPUsComponent,
+		gpuPoolLabel:                   pool,
+	}
+}
@@ -0,0 +1,41 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	operatorv1alpha1 "github.com/kyma-project/gpu-operator/api/v1alpha1"
+)
+
+// defaultRuntimeClass is the RuntimeClass the GPU operator creates for the NVIDIA runtime by default
+const defaultRuntimeClass = "nvidia"
+
+// runtimeClassName returns the RuntimeClass of the NVIDIA container runtime
+func runtimeClassName(gpuOperator *operatorv1alpha1.GpuOperator) string {
+	if runtimeClass := gpuOperator.Spec.RuntimeClass; runtimeClass != nil && runtimeClass.Name != "" {
+		return runtimeClass.Name
+	}
+	return defaultRuntimeClass
+}
+
+// runtimeClassValues returns the chart values of spec.runtimeClass. The GPU operator creates the RuntimeClass
+// and passes its name to the container toolkit as the containerd runtime handler.
+func runtimeClassValues(gpuOperator *operatorv1alpha1.GpuOperator) []chartValue {
+	if gpuOperator.Spec.RuntimeClass == nil {
+		return nil
+	}
+	return []chartValue{{path: "operator.runtimeClass", value: runtimeClassName(gpuOperator)}}
+}
@@ -0,0 +1,126 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	operatorv1alpha1 "github.com/kyma-project/gpu-operator/api/v1alpha1"
+	"github.com/kyma-project/gpu-operator/internal/instances"
+)
+
+// duplicateInstanceError reports a GpuOperator CR that targets the same cluster and vendor as an older one.
+// Only the older CR manages the installation, the duplicate would race on the same releases.
+type duplicateInstanceError struct {
+	active types.NamespacedName
+}
+
+func (e *duplicateInstanceError) Error() string {
+	return fmt.Sprintf("GpuOperator %s already manages the GPU operator of this cluster, only one GpuOperator per cluster and vendor is supported; "+
+		"delete this one, it takes over once %s is deleted", e.active, e.active)
+}
+
+// activeInstance returns the CR that manages the cluster targeted by the given CR, nil if it is the given CR
+// itself. The oldest CR per target cluster and vendor is active, including one that is still being finalized.
+//
+// The CRs are listed from the cache first, which finds an older CR of the same shard. A CR that has not
+// installed yet is then checked again through the API reader, so the shards of a sharded controller agree on
+// the active CR before it installs. Once installed, only newer CRs can appear, so the cache suffices and the
+// reconciles triggered by the operand workloads do not list the CRs from the API server.
+func (r *GpuOperatorReconciler) activeInstance(ctx context.Context, gpuOperator *operatorv1alpha1.GpuOperator) (*operatorv1alpha1.GpuOperator, error) {
+	active, err := oldestInstance(ctx, r.Client, gpuOperator)
+	if err != nil || active != nil || r.APIReader == nil || installedBefore(gpuOperator) {
+		return active, err
+	}
+	return oldestInstance(ctx, r.APIReader, gpuOperator)
+}
+
+// oldestInstance returns the oldest CR the reader lists for the cluster targeted by the given CR, nil if it is
+// the given CR itself
+func oldestInstance(ctx context.Context, reader client.Reader, gpuOperator *operatorv1alpha1.GpuOperator) (*operatorv1alpha1.GpuOperator, error) {
+	sameTarget, err := instances.SameTarget(ctx, reader, gpuOperator)
+	if err != nil {
+		return nil, err
+	}
+	if len(sameTarget) == 0 || instances.IsSame(sameTarget[0], gpuOperator) {
+		return nil, nil
+	}
+	return sameTarget[0], nil
+}
+
+// installedBefore reports whether the CR installed the GPU operator at least once, in any generation
+func installedBefore(gpuOperator *operatorv1alpha1.GpuOperator) bool {
+	return meta.IsStatusConditionTrue(gpuOperator.Status.Conditions, conditionTypeInstalled)
+}
+
+// gpuOperatorReader returns the reader of the GpuOperator CRs of all namespaces and shards
+func (r *GpuOperatorReconciler) gpuOperatorReader() client.Reader {
+	if r.APIReader != nil {
+		return r.APIReader
+	}
+	return r.Client
+}
+
+// reconcileDuplicateInstance keeps a duplicate CR out of the installation. It reports the active CR in the
+// Error state until that one is gone, and is deleted without uninstalling.
+func (r *GpuOperatorReconciler) reconcileDuplicateInstance(ctx context.Context, gpuOperator *operatorv1alpha1.GpuOperator,
+	active *operatorv1alpha1.GpuOperator) (ctrl.Result, error) {
+	logger := log.FromContext(ctx)
+	key := client.ObjectKeyFromObject(active)
+	if gpuOperator.DeletionTimestamp != nil {
+		if !controllerutil.ContainsFinalizer(gpuOperator, finalizerName) {
+			return ctrl.Result{}, nil
+		}
+		logger.Info("Removing finalizer of duplicate GpuOperator without uninstalling", "active", key)
+		controllerutil.RemoveFinalizer(gpuOperator, finalizerName)
+		return ctrl.Result{}, r.Update(ctx, gpuOperator)
+	}
+
+	logger.Info("Another GpuOperator manages this cluster, skipping installation", "active", key)
+	// The error is reported in the status; retrying it with backoff would not change anything
+	_, _ = r.updateStatusError(ctx, gpuOperator, &duplicateInstanceError{active: key})
+	return ctrl.Result{RequeueAfter: r.Config.Get().RequeueInterval.Duration}, nil
+}
+
+// instanceRemovedPredicate passes the deletion of GpuOperator CRs
+var instanceRemovedPredicate = predicate.Funcs{
+	CreateFunc:  func(event.CreateEvent) bool { return false },
+	UpdateFunc:  func(event.UpdateEvent) bool { return false },
+	DeleteFunc:  func(event.DeleteEvent) bool { return true },
+	GenericFunc: func(event.GenericEvent) bool { return false },
+}
+
+// gpuOperatorsForRemovedInstance enqueues the remaining GpuOperator CRs once one is deleted, so a duplicate
+// takes over the cluster right away
+func (r *GpuOperatorReconciler) gpuOperatorsForRemovedInstance(ctx context.Context, obj client.Object) []reconcile.Request {
+	requests := r.gpuOperatorRequests(ctx)
+	if len(requests) > 0 {
+		log.FromContext(ctx).V(1).Info("GpuOperator deleted, reconciling the remaining ones", "deleted", client.ObjectKeyFromObject(obj))
+	}
+	return requests
+}
@@ -0,0 +1,128 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	operatorv1alpha1 "github.com/kyma-project/gpu-operator/api/v1alpha1"
+)
+
+// countingReader counts the List calls of the API reader
+type countingReader struct {
+	client.Reader
+	lists int
+}
+
+func (r *countingReader) List(ctx context.Context, list client.ObjectList, opts ...client.ListOption) error {
+	r.lists++
+	return r.Reader.List(ctx, list, opts...)
+}
+
+func TestActiveInstance(t *testing.T) {
+	scheme := runtime.NewScheme()
+	if err := operatorv1alpha1.AddToScheme(scheme); err != nil {
+		t.Fatalf("AddToScheme: %v", err)
+	}
+	created := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	newGpuOperator := func(namespace string, age time.Duration, conditions ...metav1.Condition) *operatorv1alpha1.GpuOperator {
+		return &operatorv1alpha1.GpuOperator{
+			ObjectMeta: metav1.ObjectMeta{Name: "gpu-operator", Namespace: namespace, CreationTimestamp: metav1.NewTime(created.Add(-age))},
+			Status:     operatorv1alpha1.GpuOperatorStatus{Conditions: conditions},
+		}
+	}
+	installed := metav1.Condition{Type: conditionTypeInstalled, Status: metav1.ConditionTrue, Reason: "HelmInstallComplete"}
+
+	tests := []struct {
+		name       string
+		reconciled *operatorv1alpha1.GpuOperator
+		// cached are the CRs of the shard of the reconciled CR, others those of the other shards
+		cached     []*operatorv1alpha1.GpuOperator
+		others     []*operatorv1alpha1.GpuOperator
+		wantActive string
+		wantLists  int
+	}{
+		{
+			name:       "only CR before the installation",
+			reconciled: newGpuOperator("team-a", time.Hour),
+			wantLists:  1,
+		},
+		{
+			name:       "older CR in the same shard",
+			reconciled: newGpuOperator("team-a", time.Hour),
+			cached:     []*operatorv1alpha1.GpuOperator{newGpuOperator("team-b", 2*time.Hour)},
+			wantActive: "team-b",
+		},
+		{
+			name:       "older CR in another shard before the installation",
+			reconciled: newGpuOperator("team-a", time.Hour),
+			others:     []*operatorv1alpha1.GpuOperator{newGpuOperator("team-b", 2*time.Hour)},
+			wantActive: "team-b",
+			wantLists:  1,
+		},
+		{
+			name:       "newer CR in another shard before the installation",
+			reconciled: newGpuOperator("team-a", time.Hour),
+			others:     []*operatorv1alpha1.GpuOperator{newGpuOperator("team-b", 0)},
+			wantLists:  1,
+		},
+		{
+			name:       "installed CR",
+			reconciled: newGpuOperator("team-a", time.Hour, installed),
+			others:     []*operatorv1alpha1.GpuOperator{newGpuOperator("team-b", 0)},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cached := []client.Object{tt.reconciled}
+			for _, obj := range tt.cached {
+				cached = append(cached, obj)
+			}
+			all := append([]client.Object{}, cached...)
+			for _, obj := range tt.others {
+				all = append(all, obj)
+			}
+			apiReader := &countingReader{Reader: fake.NewClientBuilder().WithScheme(scheme).WithObjects(all...).Build()}
+			r := &GpuOperatorReconciler{
+				Client:    fake.NewClientBuilder().WithScheme(scheme).WithObjects(cached...).Build(),
+				APIReader: apiReader,
+			}
+
+			active, err := r.activeInstance(context.Background(), tt.reconciled)
+			if err != nil {
+				t.Fatalf("activeInstance: %v", err)
+			}
+			gotActive := ""
+			if active != nil {
+				gotActive = active.Namespace
+			}
+			if gotActive != tt.wantActive {
+				t.Errorf("activeInstance() = %q, want %q", gotActive, tt.wantActive)
+			}
+			if apiReader.lists != tt.wantLists {
+				t.Errorf("API reader listed %d times, want %d", apiReader.lists, tt.wantLists)
+			}
+		})
+	}
+}
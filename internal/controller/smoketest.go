@@ -0,0 +1,298 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	operatorv1alpha1 "github.com/kyma-project/gpu-operator/api/v1alpha1"
+	"github.com/kyma-project/gpu-operator/internal/logging"
+)
+
+const (
+	conditionTypeSmokeTest = "SmokeTest"
+	smokeTestComponent     = "smoke-test"
+	smokeTestPrefix        = "gpu-smoke-test-"
+
+	// gardenerPoolLabel is set by Gardener on every node with the name of its worker pool
+	gardenerPoolLabel = "worker.gardener.cloud/pool"
+	// gpuPoolLabel records the worker pool targeted by a smoke test or device plugin managed by the controller
+	gpuPoolLabel = "operator.kyma-project.io/gpu-pool"
+
+	gpuResourceName corev1.ResourceName = "nvidia.com/gpu"
+)
+
+// +kubebuilder:rbac:groups=batch,resources=cronjobs,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups="",resources=nodes,verbs=get;list;watch
+// +kubebuilder:rbac:groups="",resources=pods,verbs=get;list;watch
+
+// smokeTestFailure is a worker pool whose latest scheduled smoke test failed
+type smokeTestFailure struct {
+	pool  string
+	nodes []string
+}
+
+// validationImage returns the image of the validator and test workload pods
+func (r *GpuOperatorReconciler) validationImage(gpuOperator *operatorv1alpha1.GpuOperator) string {
+	return r.helperImage(gpuOperator, r.Config.Get().ValidationImage, func(i *operatorv1alpha1.HelperImages) string {
+		return i.Validation
+	})
+}
+
+// reconcileSmokeTests keeps one smoke test CronJob per GPU worker pool in line with spec.validation.schedule
+// and returns the pools whose latest run failed. The CronJobs are removed when no schedule is set.
+// With runNow, a smoke test Job is started for every pool immediately instead of waiting for the schedule.
+func (r *GpuOperatorReconciler) reconcileSmokeTests(ctx context.Context, gpuOperator *operatorv1alpha1.GpuOperator, namespace string, runNow bool) ([]smokeTestFailure, error) {
+	logger := log.FromContext(ctx).WithName(logging.SubsystemHealth)
+
+	schedule := ""
+	if gpuOperator.Spec.Validation != nil {
+		schedule = gpuOperator.Spec.Validation.Schedule
+	}
+
+	pools := map[string]bool{}
+	if schedule != "" {
+		nodes, err := r.gpuNodes(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for i := range nodes {
+			// Nodes passing their GPUs through to VMs cannot run the CUDA container
+			if !servesContainers(gpuOperator, &nodes[i]) {
+				continue
+			}
+			if pool := nodes[i].Labels[gardenerPoolLabel]; pool != "" {
+				pools[pool] = true
+			}
+		}
+	}
+
+	for pool := range pools {
+		cronJob := &batchv1.CronJob{
+			ObjectMeta: metav1.ObjectMeta{Name: smokeTestPrefix + pool, Namespace: namespace},
+		}
+		result, err := controllerutil.CreateOrUpdate(ctx, r.Client, cronJob, func() error {
+			cronJob.Labels = smokeTestLabels(pool)
+			cronJob.Spec = r.smokeTestCronJobSpec(gpuOperator, pool, schedule)
+			return r.setControllerReference(gpuOperator, cronJob)
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to reconcile smoke test CronJob for pool %s: %w", pool, err)
+		}
+		if result != controllerutil.OperationResultNone {
+			logger.Info("Reconciled smoke test CronJob", "cronJob", cronJob.Name, "schedule", schedule, "operation", result)
+		}
+		if runNow {
+			if err := r.runSmokeTest(ctx, cronJob); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	// Remove the CronJobs of pools that are gone or of a schedule that was cleared
+	cronJobs := &batchv1.CronJobList{}
+	if err := r.List(ctx, cronJobs, client.InNamespace(namespace),
+		client.MatchingLabels{"app.kubernetes.io/component": smokeTestComponent}); err != nil {
+		return nil, fmt.Errorf("failed to list smoke test CronJobs: %w", err)
+	}
+	for i := range cronJobs.Items {
+		cronJob := &cronJobs.Items[i]
+		if pools[cronJob.Labels[gpuPoolLabel]] {
+			continue
+		}
+		if err := r.Delete(ctx, cronJob, client.PropagationPolicy(metav1.DeletePropagationBackground)); err != nil && !apierrors.IsNotFound(err) {
+			return nil, fmt.Errorf("failed to delete smoke test CronJob %s: %w", cronJob.Name, err)
+		}
+		logger.Info("Deleted smoke test CronJob", "cronJob", cronJob.Name)
+	}
+
+	if len(pools) == 0 {
+		return nil, nil
+	}
+	return r.smokeTestFailures(ctx, namespace, pools)
+}
+
+// smokeTestFailures returns the pools whose most recent finished smoke test Job failed,
+// together with the nodes the failing pods ran on
+func (r *GpuOperatorReconciler) smokeTestFailures(ctx context.Context, namespace string, pools map[string]bool) ([]smokeTestFailure, error) {
+	jobs := &batchv1.JobList{}
+	if err := r.List(ctx, jobs, client.InNamespace(namespace),
+		client.MatchingLabels{"app.kubernetes.io/component": smokeTestComponent}); err != nil {
+		return nil, fmt.Errorf("failed to list smoke test Jobs: %w", err)
+	}
+
+	latest := map[string]*batchv1.Job{}
+	for i := range jobs.Items {
+		job := &jobs.Items[i]
+		pool := job.Labels[gpuPoolLabel]
+		if !pools[pool] || jobFinishedCondition(job) == "" {
+			continue
+		}
+		if current, ok := latest[pool]; !ok || current.CreationTimestamp.Before(&job.CreationTimestamp) {
+			latest[pool] = job
+		}
+	}
+
+	var failures []smokeTestFailure
+	for pool, job := range latest {
+		if jobFinishedCondition(job) != batchv1.JobFailed {
+			continue
+		}
+		pods := &corev1.PodList{}
+		if err := r.List(ctx, pods, client.InNamespace(namespace),
+			client.MatchingLabels{batchv1.JobNameLabel: job.Name}); err != nil {
+			return nil, fmt.Errorf("failed to list pods of smoke test Job %s: %w", job.Name, err)
+		}
+		failure := smokeTestFailure{pool: pool}
+		for _, pod := range pods.Items {
+			if pod.Spec.NodeName != "" && pod.Status.Phase == corev1.PodFailed {
+				failure.nodes = append(failure.nodes, pod.Spec.NodeName)
+			}
+		}
+		sort.Strings(failure.nodes)
+		failures = append(failures, failure)
+	}
+	sort.Slice(failures, func(i, j int) bool { return failures[i].pool < failures[j].pool })
+	return failures, nil
+}
+
+// runSmokeTest starts a Job from the CronJob's template, like kubectl create job --from=cronjob.
+// The Job gets a longer deadline, as fresh nodes first need the driver stack rolled out.
+func (r *GpuOperatorReconciler) runSmokeTest(ctx context.Context, cronJob *batchv1.CronJob) error {
+	job := &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        fmt.Sprintf("%s-%d", cronJob.Name, time.Now().Unix()),
+			Namespace:   cronJob.Namespace,
+			Labels:      cronJob.Spec.JobTemplate.Labels,
+			Annotations: map[string]string{"cronjob.kubernetes.io/instantiate": "manual"},
+		},
+		Spec: *cronJob.Spec.JobTemplate.Spec.DeepCopy(),
+	}
+	job.Spec.ActiveDeadlineSeconds = ptr.To[int64](1800)
+	if err := controllerutil.SetControllerReference(cronJob, job, r.Scheme); err != nil {
+		return fmt.Errorf("failed to set owner reference: %w", err)
+	}
+	if err := r.Create(ctx, job); err != nil && !apierrors.IsAlreadyExists(err) {
+		return fmt.Errorf("failed to start smoke test Job from CronJob %s: %w", cronJob.Name, err)
+	}
+	log.FromContext(ctx).WithName(logging.SubsystemHealth).Info("Started smoke test", "job", job.Name)
+	return nil
+}
+
+// smokeTestCronJobSpec runs nvidia-smi in a CUDA container on a single GPU of the given pool
+func (r *GpuOperatorReconciler) smokeTestCronJobSpec(gpuOperator *operatorv1alpha1.GpuOperator, pool, schedule string) batchv1.CronJobSpec {
+	return batchv1.CronJobSpec{
+		Schedule:                   schedule,
+		ConcurrencyPolicy:          batchv1.ForbidConcurrent,
+		SuccessfulJobsHistoryLimit: ptr.To[int32](1),
+		FailedJobsHistoryLimit:     ptr.To[int32](1),
+		JobTemplate: batchv1.JobTemplateSpec{
+			ObjectMeta: metav1.ObjectMeta{Labels: smokeTestLabels(pool)},
+			Spec: batchv1.JobSpec{
+				BackoffLimit:          ptr.To[int32](0),
+				ActiveDeadlineSeconds: ptr.To[int64](600),
+				Template: corev1.PodTemplateSpec{
+					ObjectMeta: metav1.ObjectMeta{Labels: smokeTestLabels(pool)},
+					Spec: corev1.PodSpec{
+						RestartPolicy: corev1.RestartPolicyNever,
+						// The pod deadline puts the smoke tests into the quota of the namespace defaults
+						ActiveDeadlineSeconds: ptr.To[int64](1800),
+						RuntimeClassName:      ptr.To(runtimeClassName(gpuOperator)),
+						NodeSelector:          map[string]string{gardenerPoolLabel: pool},
+						ImagePullSecrets:      registryPullSecrets(gpuOperator),
+						Tolerations: []corev1.Toleration{
+							{Key: string(gpuResourceName), Operator: corev1.TolerationOpExists, Effect: corev1.TaintEffectNoSchedule},
+						},
+						Containers: []corev1.Container{
+							{
+								Name:    "cuda-smoke-test",
+								Image:   r.validationImage(gpuOperator),
+								Command: []string{"nvidia-smi"},
+								Resources: corev1.ResourceRequirements{
+									Limits: corev1.ResourceList{gpuResourceName: resource.MustParse("1")},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// smokeTestLabels returns the labels of the smoke test CronJob, Jobs and pods of a pool
+func smokeTestLabels(pool string) map[string]string {
+	return map[string]string{
+		"app.kubernetes.io/name":       "gpu-operator",
+		"app.kubernetes.io/managed-by": "gpu-operator-module",
+		"app.kubernetes.io/component":  smokeTestComponent,
+		gpuPoolLabel:                   pool,
+	}
+}
+
+// jobFinishedCondition returns JobComplete or JobFailed once the Job has finished, empty otherwise
+func jobFinishedCondition(job *batchv1.Job) batchv1.JobConditionType {
+	for _, condition := range job.Status.Conditions {
+		if (condition.Type == batchv1.JobComplete || condition.Type == batchv1.JobFailed) &&
+			condition.Status == corev1.ConditionTrue {
+			return condition.Type
+		}
+	}
+	return ""
+}
+
+// smokeTestCondition summarizes the scheduled smoke test results, nil if no smoke tests are scheduled
+func smokeTestCondition(gpuOperator *operatorv1alpha1.GpuOperator, failures []smokeTestFailure) *metav1.Condition {
+	if gpuOperator.Spec.Validation == nil || gpuOperator.Spec.Validation.Schedule == "" {
+		return nil
+	}
+	condition := &metav1.Condition{
+		Type:               conditionTypeSmokeTest,
+		Status:             metav1.ConditionTrue,
+		Reason:             "SmokeTestsPassing",
+		Message:            "Latest scheduled CUDA smoke tests passed on all GPU worker pools",
+		ObservedGeneration: gpuOperator.Generation,
+	}
+	if len(failures) > 0 {
+		details := make([]string, 0, len(failures))
+		for _, failure := range failures {
+			if len(failure.nodes) == 0 {
+				details = append(details, failure.pool)
+				continue
+			}
+			details = append(details, fmt.Sprintf("%s (nodes: %s)", failure.pool, strings.Join(failure.nodes, ", ")))
+		}
+		condition.Status = metav1.ConditionFalse
+		condition.Reason = "SmokeTestFailed"
+		condition.Message = "Latest scheduled CUDA smoke test failed in GPU worker pools: " + strings.Join(details, "; ")
+	}
+	return condition
+}
@@ -0,0 +1,62 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	corev1 "k8s.io/api/core/v1"
+
+	operatorv1alpha1 "github.com/kyma-project/gpu-operator/api/v1alpha1"
+)
+
+// spotPriorityClassName lets the operands preempt regular workloads on a fresh node
+const spotPriorityClassName = "system-node-critical"
+
+// spotInterruptionTaints are put on spot/preemptible nodes by cloud providers and node termination
+// handlers. The operands keep running on a node until it is actually gone.
+var spotInterruptionTaints = []string{
+	"kubernetes.azure.com/scalesetpriority",
+	"cloud.google.com/gke-spot",
+	"cloud.google.com/gke-preemptible",
+	"aws-node-termination-handler/spot-itn",
+	"aws-node-termination-handler/rebalance-recommendation",
+	"node.cloudprovider.kubernetes.io/shutdown",
+}
+
+// spotTolerations returns the tolerations the operands need on spot nodes, nil if spot handling is disabled.
+// The GPU taint tolerated by the chart defaults is kept, as the list replaces the defaults.
+func spotTolerations(gpuOperator *operatorv1alpha1.GpuOperator) []corev1.Toleration {
+	spot := gpuOperator.Spec.Spot
+	if spot == nil || !spot.Enabled {
+		return nil
+	}
+	tolerations := []corev1.Toleration{
+		{Key: string(gpuResourceName), Operator: corev1.TolerationOpExists, Effect: corev1.TaintEffectNoSchedule},
+	}
+	for _, key := range spotInterruptionTaints {
+		tolerations = append(tolerations, corev1.Toleration{Key: key, Operator: corev1.TolerationOpExists})
+	}
+	return append(tolerations, spot.Tolerations...)
+}
+
+// spotValues returns the chart values applying the spot priority to all operand DaemonSets. The spot
+// tolerations are part of the operand tolerations.
+func spotValues(gpuOperator *operatorv1alpha1.GpuOperator) []chartValue {
+	if spotTolerations(gpuOperator) == nil {
+		return nil
+	}
+	return []chartValue{{path: "daemonsets.priorityClassName", value: spotPriorityClassName}}
+}
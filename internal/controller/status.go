@@ -0,0 +1,66 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/util/retry"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	operatorv1alpha1 "github.com/kyma-project/gpu-operator/api/v1alpha1"
+)
+
+// updateStatus writes the status of the CR as a patch against the latest version of the CR, retrying on conflicts
+// with concurrent writers such as finalizer or annotation updates. The CR is refreshed from the written object.
+func (r *GpuOperatorReconciler) updateStatus(ctx context.Context, gpuOperator *operatorv1alpha1.GpuOperator) error {
+	status := gpuOperator.Status.DeepCopy()
+	latest := &operatorv1alpha1.GpuOperator{}
+	err := retry.RetryOnConflict(retry.DefaultBackoff, func() error {
+		if err := r.Get(ctx, client.ObjectKeyFromObject(gpuOperator), latest); err != nil {
+			return err
+		}
+		patch := client.MergeFromWithOptions(latest.DeepCopy(), client.MergeFromWithOptimisticLock{})
+		status.DeepCopyInto(&latest.Status)
+		return r.Status().Patch(ctx, latest, patch)
+	})
+	if err != nil {
+		return err
+	}
+	latest.DeepCopyInto(gpuOperator)
+	recordState(gpuOperator)
+	return nil
+}
+
+// setConditions replaces the conditions of the status by the given ones. Conditions of other types are removed,
+// the transition time of a condition is kept while its status does not change.
+func setConditions(gpuOperator *operatorv1alpha1.GpuOperator, conditions ...metav1.Condition) {
+	types := make(map[string]bool, len(conditions))
+	for _, condition := range conditions {
+		types[condition.Type] = true
+	}
+	for _, condition := range append([]metav1.Condition(nil), gpuOperator.Status.Conditions...) {
+		if !types[condition.Type] {
+			meta.RemoveStatusCondition(&gpuOperator.Status.Conditions, condition.Type)
+		}
+	}
+	for _, condition := range conditions {
+		meta.SetStatusCondition(&gpuOperator.Status.Conditions, condition)
+	}
+}
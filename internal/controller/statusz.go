@@ -0,0 +1,93 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"time"
+
+	operatorv1alpha1 "github.com/kyma-project/gpu-operator/api/v1alpha1"
+	"github.com/kyma-project/gpu-operator/internal/logging"
+	"github.com/kyma-project/gpu-operator/internal/statusz"
+)
+
+// recordDesiredState records the installation computed from the spec and the controller configuration
+func (r *GpuOperatorReconciler) recordDesiredState(cr string, gpuOperator *operatorv1alpha1.GpuOperator, namespace string) {
+	if r.Statusz == nil {
+		return
+	}
+	desired := statusz.DesiredState{
+		Namespace:       namespace,
+		InstallEngine:   string(gpuOperator.Spec.InstallEngine),
+		DriverVersion:   gpuOperator.Spec.DriverVersion,
+		ChartVersion:    chartVersion(gpuOperator),
+		ValidationImage: r.validationImage(gpuOperator),
+		Spot:            gpuOperator.Spec.Spot != nil && gpuOperator.Spec.Spot.Enabled,
+	}
+	if desired.InstallEngine == "" {
+		desired.InstallEngine = string(operatorv1alpha1.InstallEngineHelm)
+	}
+	if gpuOperator.Spec.Validation != nil {
+		desired.SmokeTestSchedule = gpuOperator.Spec.Validation.Schedule
+	}
+	r.Statusz.Update(cr, func(s *statusz.Snapshot) {
+		s.Generation = gpuOperator.Generation
+		s.LastReconcile = time.Now()
+		s.Phase = logging.PhasePrepare
+		s.Desired = desired
+	})
+}
+
+// recordPhase records the reconcile phase reached
+func (r *GpuOperatorReconciler) recordPhase(cr, phase string) {
+	r.Statusz.Update(cr, func(s *statusz.Snapshot) {
+		s.Phase = phase
+	})
+}
+
+// recordHelmAction records the outcome of a Helm action
+func (r *GpuOperatorReconciler) recordHelmAction(cr, action string, revision int, err error) {
+	r.Statusz.Update(cr, func(s *statusz.Snapshot) {
+		helmAction := &statusz.HelmAction{Action: action, Status: "Complete", Revision: revision, ObservedAt: time.Now()}
+		if err != nil {
+			helmAction.Status = "Failed"
+			helmAction.Error = err.Error()
+		}
+		s.LastHelmAction = helmAction
+	})
+}
+
+// recordHealth records the result of the health evaluation and the conditions written to the status
+func (r *GpuOperatorReconciler) recordHealth(cr string, gpuOperator *operatorv1alpha1.GpuOperator, driverVersion string,
+	hibernated bool, failures []smokeTestFailure) {
+	r.Statusz.Update(cr, func(s *statusz.Snapshot) {
+		s.Phase = logging.PhaseReady
+		s.Desired.DriverVersion = driverVersion
+		health := statusz.Health{State: string(gpuOperator.Status.State), Hibernated: hibernated}
+		for _, failure := range failures {
+			health.SmokeTestFailures = append(health.SmokeTestFailures, failure.pool)
+		}
+		for _, condition := range gpuOperator.Status.Conditions {
+			health.Conditions = append(health.Conditions, statusz.Condition{
+				Type:    condition.Type,
+				Status:  string(condition.Status),
+				Reason:  condition.Reason,
+				Message: condition.Message,
+			})
+		}
+		s.Health = health
+	})
+}
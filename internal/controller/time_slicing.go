@@ -0,0 +1,170 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/yaml"
+
+	operatorv1alpha1 "github.com/kyma-project/gpu-operator/api/v1alpha1"
+)
+
+const (
+	// timeSlicingConfigMapName is the device plugin configuration generated from spec.timeSlicing
+	timeSlicingConfigMapName = "time-slicing-config"
+	// timeSlicingDefaultConfig is the configuration of the GPU nodes of pools without an override
+	timeSlicingDefaultConfig = "default"
+	// timeSlicingPoolConfigPrefix starts the names of the configurations of the pool overrides
+	timeSlicingPoolConfigPrefix = "pool-"
+
+	// devicePluginConfigLabel selects the device plugin configuration applied to a node
+	devicePluginConfigLabel = "nvidia.com/device-plugin.config"
+)
+
+// devicePluginConfig is the configuration file format of the NVIDIA device plugin
+type devicePluginConfig struct {
+	Version string                     `json:"version"`
+	Sharing *devicePluginConfigSharing `json:"sharing,omitempty"`
+}
+
+type devicePluginConfigSharing struct {
+	TimeSlicing devicePluginTimeSlicing `json:"timeSlicing"`
+}
+
+type devicePluginTimeSlicing struct {
+	Resources []devicePluginSharedResource `json:"resources"`
+}
+
+type devicePluginSharedResource struct {
+	Name     string `json:"name"`
+	Replicas int32  `json:"replicas"`
+}
+
+// timeSlicingValues returns the chart values pointing the device plugin to the time-slicing configuration
+func timeSlicingValues(gpuOperator *operatorv1alpha1.GpuOperator) []chartValue {
+	if gpuOperator.Spec.TimeSlicing == nil {
+		return nil
+	}
+	return []chartValue{
+		{path: "devicePlugin.config.name", value: timeSlicingConfigMapName},
+		{path: "devicePlugin.config.default", value: timeSlicingDefaultConfig},
+	}
+}
+
+// timeSlicingPoolConfig returns the name of the device plugin configuration of a worker pool override
+func timeSlicingPoolConfig(pool string) string {
+	return timeSlicingPoolConfigPrefix + pool
+}
+
+// reconcileTimeSlicing generates the device plugin configuration of spec.timeSlicing in the installation
+// namespace, with one configuration per worker pool override, and selects the override on the GPU nodes of
+// the pool with the nvidia.com/device-plugin.config label. Both are removed when time-slicing is disabled.
+func (r *GpuOperatorReconciler) reconcileTimeSlicing(ctx context.Context, gpuOperator *operatorv1alpha1.GpuOperator, namespace string) error {
+	timeSlicing := gpuOperator.Spec.TimeSlicing
+	configMap := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: timeSlicingConfigMapName, Namespace: namespace}}
+	pools := map[string]bool{}
+	if timeSlicing == nil {
+		if err := r.Delete(ctx, configMap); err != nil && !apierrors.IsNotFound(err) {
+			return fmt.Errorf("failed to delete time-slicing config %s: %w", timeSlicingConfigMapName, err)
+		}
+	} else {
+		data := map[string]string{}
+		config, err := timeSlicingConfig(timeSlicing.Replicas)
+		if err != nil {
+			return err
+		}
+		data[timeSlicingDefaultConfig] = config
+		for _, pool := range timeSlicing.Pools {
+			if config, err = timeSlicingConfig(pool.Replicas); err != nil {
+				return err
+			}
+			data[timeSlicingPoolConfig(pool.Pool)] = config
+			pools[pool.Pool] = true
+		}
+		if _, err := controllerutil.CreateOrUpdate(ctx, r.Client, configMap, func() error {
+			configMap.Labels = map[string]string{
+				"app.kubernetes.io/name":       "gpu-operator",
+				"app.kubernetes.io/managed-by": "gpu-operator-module",
+				"app.kubernetes.io/component":  "time-slicing-config",
+			}
+			configMap.Data = data
+			return r.setControllerReference(gpuOperator, configMap)
+		}); err != nil {
+			return fmt.Errorf("failed to reconcile time-slicing config %s: %w", timeSlicingConfigMapName, err)
+		}
+	}
+	return r.selectTimeSlicingConfigs(ctx, pools)
+}
+
+// timeSlicingConfig renders the device plugin configuration advertising every GPU the given number of times
+func timeSlicingConfig(replicas int32) (string, error) {
+	config := devicePluginConfig{Version: "v1"}
+	if replicas > 1 {
+		config.Sharing = &devicePluginConfigSharing{TimeSlicing: devicePluginTimeSlicing{
+			Resources: []devicePluginSharedResource{{Name: string(gpuResourceName), Replicas: replicas}},
+		}}
+	}
+	data, err := yaml.Marshal(config)
+	if err != nil {
+		return "", fmt.Errorf("failed to render time-slicing config: %w", err)
+	}
+	return string(data), nil
+}
+
+// selectTimeSlicingConfigs labels the GPU nodes of the given pools with the configuration of their pool override.
+// The label is removed from nodes of other pools that carry the configuration of their own pool, so labels set by
+// hand for other configurations are kept.
+func (r *GpuOperatorReconciler) selectTimeSlicingConfigs(ctx context.Context, pools map[string]bool) error {
+	nodes, err := r.gpuNodes(ctx)
+	if err != nil {
+		return err
+	}
+	for i := range nodes {
+		node := &nodes[i]
+		pool := node.Labels[gardenerPoolLabel]
+		if pool == "" {
+			continue
+		}
+		current, labeled := node.Labels[devicePluginConfigLabel]
+		desired := timeSlicingPoolConfig(pool)
+		switch {
+		case pools[pool] && current != desired:
+			patch := client.MergeFrom(node.DeepCopy())
+			if node.Labels == nil {
+				node.Labels = map[string]string{}
+			}
+			node.Labels[devicePluginConfigLabel] = desired
+			if err := r.Patch(ctx, node, patch); err != nil {
+				return fmt.Errorf("failed to label node %s: %w", node.Name, err)
+			}
+		case !pools[pool] && labeled && current == desired:
+			patch := client.MergeFrom(node.DeepCopy())
+			delete(node.Labels, devicePluginConfigLabel)
+			if err := r.Patch(ctx, node, patch); err != nil {
+				return fmt.Errorf("failed to label node %s: %w", node.Name, err)
+			}
+		}
+	}
+	return nil
+}
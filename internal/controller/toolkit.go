@@ -0,0 +1,60 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"strconv"
+
+	corev1 "k8s.io/api/core/v1"
+
+	operatorv1alpha1 "github.com/kyma-project/gpu-operator/api/v1alpha1"
+)
+
+const (
+	// containerdConfigEnv and containerdSocketEnv point the container toolkit to containerd. The GPU operator
+	// mounts the host directories of both paths into the toolkit container.
+	containerdConfigEnv = "CONTAINERD_CONFIG"
+	containerdSocketEnv = "CONTAINERD_SOCKET"
+	// containerdSetAsDefaultEnv makes the NVIDIA runtime the default runtime of containerd
+	containerdSetAsDefaultEnv = "CONTAINERD_SET_AS_DEFAULT"
+)
+
+// toolkitValues returns the chart values of spec.toolkit and spec.runtimeClass.setAsDefault. The toolkit
+// environment replaces the chart default, so these settings must be made here rather than in toolkit.env
+// of custom Helm values.
+func toolkitValues(gpuOperator *operatorv1alpha1.GpuOperator) []chartValue {
+	var values []chartValue
+	var env []corev1.EnvVar
+	if toolkit := gpuOperator.Spec.Toolkit; toolkit != nil {
+		if toolkit.InstallDir != "" {
+			values = append(values, chartValue{path: "toolkit.installDir", value: toolkit.InstallDir})
+		}
+		if toolkit.ContainerdConfig != "" {
+			env = append(env, corev1.EnvVar{Name: containerdConfigEnv, Value: toolkit.ContainerdConfig})
+		}
+		if toolkit.ContainerdSocket != "" {
+			env = append(env, corev1.EnvVar{Name: containerdSocketEnv, Value: toolkit.ContainerdSocket})
+		}
+	}
+	if runtimeClass := gpuOperator.Spec.RuntimeClass; runtimeClass != nil && runtimeClass.SetAsDefault != nil {
+		env = append(env, corev1.EnvVar{Name: containerdSetAsDefaultEnv, Value: strconv.FormatBool(*runtimeClass.SetAsDefault)})
+	}
+	if len(env) > 0 {
+		values = append(values, chartValue{path: "toolkit.env", value: env})
+	}
+	return values
+}
@@ -0,0 +1,281 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	operatorv1alpha1 "github.com/kyma-project/gpu-operator/api/v1alpha1"
+)
+
+const (
+	defaultUninstallTimeout = 30 * time.Minute
+
+	// moduleNamespaceLabel marks the installation namespaces created by the module, the only ones
+	// spec.uninstall.deleteNamespace deletes
+	moduleNamespaceLabel = "operator.kyma-project.io/created-by"
+	moduleNamespaceValue = "gpu-operator-module"
+)
+
+// nvidiaCRDs are the CRDs installed by the GPU operator chart that Helm leaves behind on uninstall
+var nvidiaCRDs = []string{"clusterpolicies.nvidia.com", "nvidiadrivers.nvidia.com"}
+
+var crdGVK = schema.GroupVersionKind{
+	Group:   "apiextensions.k8s.io",
+	Version: "v1",
+	Kind:    "CustomResourceDefinition",
+}
+
+var clusterPolicyGVK = schema.GroupVersionKind{
+	Group:   "nvidia.com",
+	Version: "v1",
+	Kind:    "ClusterPolicy",
+}
+
+// uninstallTimeout returns how long finalization retries the Helm uninstall
+func uninstallTimeout(gpuOperator *operatorv1alpha1.GpuOperator) time.Duration {
+	if gpuOperator.Spec.Uninstall != nil && gpuOperator.Spec.Uninstall.Timeout != nil {
+		return gpuOperator.Spec.Uninstall.Timeout.Duration
+	}
+	return defaultUninstallTimeout
+}
+
+// forceCleanup deletes the resources left by the GPU operator release on a best-effort basis
+// and returns the ones that still exist afterwards
+func (r *GpuOperatorReconciler) forceCleanup(ctx context.Context, namespace, release string) []string {
+	logger := log.FromContext(ctx)
+
+	candidates, err := r.listReleaseResources(ctx, namespace, release)
+	if err != nil {
+		logger.Error(err, "Failed to list GPU operator resources for forced cleanup")
+	}
+	for _, obj := range candidates {
+		if err := r.Delete(ctx, obj, client.PropagationPolicy(metav1.DeletePropagationBackground)); err != nil &&
+			!apierrors.IsNotFound(err) {
+			logger.Error(err, "Failed to delete resource during forced cleanup", "resource", describeObject(obj))
+		}
+	}
+
+	remaining, err := r.listReleaseResources(ctx, namespace, release)
+	if err != nil {
+		logger.Error(err, "Failed to list GPU operator resources after forced cleanup")
+	}
+	names := make([]string, 0, len(remaining))
+	for _, obj := range remaining {
+		names = append(names, describeObject(obj))
+	}
+	return names
+}
+
+// deleteCRDs reports whether the NVIDIA CRDs are deleted after the uninstall
+func deleteCRDs(gpuOperator *operatorv1alpha1.GpuOperator) bool {
+	return gpuOperator.Spec.Uninstall != nil && gpuOperator.Spec.Uninstall.DeleteCRDs
+}
+
+// deleteNVIDIACRDs deletes the NVIDIA CRDs if spec.uninstall.deleteCRDs is set. Deleting a CRD deletes the
+// custom resources of its kind that are left, including the ones the module did not create.
+func (r *GpuOperatorReconciler) deleteNVIDIACRDs(ctx context.Context, gpuOperator *operatorv1alpha1.GpuOperator) error {
+	if !deleteCRDs(gpuOperator) {
+		return nil
+	}
+	for _, name := range nvidiaCRDs {
+		crd := &unstructured.Unstructured{}
+		crd.SetGroupVersionKind(crdGVK)
+		crd.SetName(name)
+		if err := r.Delete(ctx, crd); err != nil {
+			if apierrors.IsNotFound(err) {
+				continue
+			}
+			return fmt.Errorf("failed to delete CRD %s: %w", name, err)
+		}
+		log.FromContext(ctx).Info("Deleted NVIDIA CRD", "crd", name)
+	}
+	return nil
+}
+
+// cleanupInstallationNamespace deletes the installation namespace if spec.uninstall.deleteNamespace is set
+// and the namespace was created by the module for the CR, and releases a namespace that is kept otherwise
+func (r *GpuOperatorReconciler) cleanupInstallationNamespace(ctx context.Context, gpuOperator *operatorv1alpha1.GpuOperator,
+	namespace string) error {
+	logger := log.FromContext(ctx)
+	ns := &corev1.Namespace{}
+	if err := r.Get(ctx, client.ObjectKey{Name: namespace}, ns); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil
+		}
+		return fmt.Errorf("failed to get namespace %s: %w", namespace, err)
+	}
+	if gpuOperator.Spec.Uninstall == nil || !gpuOperator.Spec.Uninstall.DeleteNamespace {
+		return r.releaseNamespace(ctx, gpuOperator, ns)
+	}
+	if !ownsNamespace(gpuOperator, ns) {
+		logger.Info("Keeping installation namespace that was not created by the module for this GpuOperator", "namespace", namespace)
+		return r.releaseNamespace(ctx, gpuOperator, ns)
+	}
+	if err := r.Delete(ctx, ns); err != nil && !apierrors.IsNotFound(err) {
+		return fmt.Errorf("failed to delete namespace %s: %w", namespace, err)
+	}
+	logger.Info("Deleted installation namespace", "namespace", namespace)
+	return nil
+}
+
+// listReleaseResources lists the Helm release storage, ClusterPolicies, operand workloads and
+// module Jobs that belong to the GPU operator installation. The AMD GPU operator release has no ClusterPolicies.
+func (r *GpuOperatorReconciler) listReleaseResources(ctx context.Context, namespace, release string) ([]client.Object, error) {
+	var objects []client.Object
+	var errs []string
+
+	secrets := &corev1.SecretList{}
+	if err := r.List(ctx, secrets, client.InNamespace(namespace),
+		client.MatchingLabels{"owner": "helm", "name": release}); err != nil {
+		errs = append(errs, err.Error())
+	}
+	for i := range secrets.Items {
+		objects = append(objects, &secrets.Items[i])
+	}
+
+	if release == helmReleaseName {
+		clusterPolicies := &unstructured.UnstructuredList{}
+		clusterPolicies.SetGroupVersionKind(clusterPolicyGVK.GroupVersion().WithKind(clusterPolicyGVK.Kind + "List"))
+		if err := r.List(ctx, clusterPolicies); err != nil && !meta.IsNoMatchError(err) {
+			errs = append(errs, err.Error())
+		}
+		for i := range clusterPolicies.Items {
+			objects = append(objects, &clusterPolicies.Items[i])
+		}
+	}
+
+	daemonSets := &appsv1.DaemonSetList{}
+	if err := r.List(ctx, daemonSets, client.InNamespace(namespace)); err != nil {
+		errs = append(errs, err.Error())
+	}
+	for i := range daemonSets.Items {
+		if belongsToRelease(&daemonSets.Items[i], release) {
+			objects = append(objects, &daemonSets.Items[i])
+		}
+	}
+
+	deployments := &appsv1.DeploymentList{}
+	if err := r.List(ctx, deployments, client.InNamespace(namespace)); err != nil {
+		errs = append(errs, err.Error())
+	}
+	for i := range deployments.Items {
+		if belongsToRelease(&deployments.Items[i], release) {
+			objects = append(objects, &deployments.Items[i])
+		}
+	}
+
+	jobs := &batchv1.JobList{}
+	if err := r.List(ctx, jobs, client.InNamespace(namespace),
+		client.MatchingLabels{"app.kubernetes.io/managed-by": "gpu-operator-module"}); err != nil {
+		errs = append(errs, err.Error())
+	}
+	for i := range jobs.Items {
+		objects = append(objects, &jobs.Items[i])
+	}
+
+	if len(errs) > 0 {
+		return objects, fmt.Errorf("failed to list resources: %s", strings.Join(errs, "; "))
+	}
+	return objects, nil
+}
+
+// belongsToRelease reports whether a workload was created by the Helm release or by its ClusterPolicy, or
+// by the DeviceConfig of the AMD GPU operator release
+func belongsToRelease(obj client.Object, release string) bool {
+	if obj.GetLabels()["app.kubernetes.io/instance"] == release {
+		return true
+	}
+	ownerKind := clusterPolicyGVK.Kind
+	if release == amdReleaseName {
+		ownerKind = amdDeviceConfigKind
+	}
+	for _, owner := range obj.GetOwnerReferences() {
+		if owner.Kind == ownerKind {
+			return true
+		}
+	}
+	return false
+}
+
+// describeObject formats an object as Kind/namespace/name for status messages
+func describeObject(obj client.Object) string {
+	kind := obj.GetObjectKind().GroupVersionKind().Kind
+	if kind == "" {
+		kind = fmt.Sprintf("%T", obj)
+		kind = kind[strings.LastIndex(kind, ".")+1:]
+	}
+	if obj.GetNamespace() == "" {
+		return kind + "/" + obj.GetName()
+	}
+	return kind + "/" + obj.GetNamespace() + "/" + obj.GetName()
+}
+
+// recordForcedUninstall records the outcome of a forced cleanup in the Uninstalled condition
+func (r *GpuOperatorReconciler) recordForcedUninstall(ctx context.Context, gpuOperator *operatorv1alpha1.GpuOperator,
+	reason string, remaining []string) {
+	message := "Uninstall did not complete, forced cleanup removed all remaining resources"
+	if len(remaining) > 0 {
+		message = fmt.Sprintf("Uninstall did not complete, resources left behind after forced cleanup: %s",
+			strings.Join(remaining, ", "))
+	}
+
+	meta.SetStatusCondition(&gpuOperator.Status.Conditions, metav1.Condition{
+		Type:               conditionTypeUninstalled,
+		Status:             metav1.ConditionFalse,
+		Reason:             reason,
+		Message:            message,
+		ObservedGeneration: gpuOperator.Generation,
+	})
+	setLastOperation(gpuOperator, operatorv1alpha1.OperationFailed, message)
+	if err := r.updateStatus(ctx, gpuOperator); err != nil {
+		log.FromContext(ctx).Error(err, "Failed to record forced uninstall in status")
+	}
+	r.event(gpuOperator, corev1.EventTypeWarning, reason, "%s", message)
+}
+
+// reportDeletionProgress records in the Deleting condition what finalization is waiting for
+func (r *GpuOperatorReconciler) reportDeletionProgress(ctx context.Context, gpuOperator *operatorv1alpha1.GpuOperator,
+	reason, message string) {
+	changed := meta.SetStatusCondition(&gpuOperator.Status.Conditions, metav1.Condition{
+		Type:               conditionTypeDeleting,
+		Status:             metav1.ConditionTrue,
+		Reason:             reason,
+		Message:            message,
+		ObservedGeneration: gpuOperator.Generation,
+	})
+	if !setLastOperation(gpuOperator, operatorv1alpha1.OperationProcessing, message) && !changed {
+		return
+	}
+	if err := r.updateStatus(ctx, gpuOperator); err != nil {
+		log.FromContext(ctx).Error(err, "Failed to record deletion progress in status")
+	}
+}
@@ -0,0 +1,160 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	operatorv1alpha1 "github.com/kyma-project/gpu-operator/api/v1alpha1"
+)
+
+// approveUpgradeAnnotation approves the pending upgrade whose ID it is set to
+const approveUpgradeAnnotation = "operator.kyma-project.io/approve-upgrade"
+
+const eventUpgradePendingApproval = "UpgradePendingApproval"
+
+// upgradeApprovalRequired reports whether spec.upgradePolicy.requireApproval is set
+func upgradeApprovalRequired(gpuOperator *operatorv1alpha1.GpuOperator) bool {
+	return gpuOperator.Spec.UpgradePolicy != nil && gpuOperator.Spec.UpgradePolicy.RequireApproval
+}
+
+// upgradeApproved reports whether the approve-upgrade annotation approves the pending upgrade
+func upgradeApproved(gpuOperator *operatorv1alpha1.GpuOperator) bool {
+	plan := gpuOperator.Status.PendingUpgrade
+	return plan != nil && gpuOperator.GetAnnotations()[approveUpgradeAnnotation] == plan.ID
+}
+
+// chartUpgradeAllowed reports whether the upgrade channel may select a chart version: if no approval is
+// required, or the approved upgrade includes the chart upgrade to the version
+func chartUpgradeAllowed(gpuOperator *operatorv1alpha1.GpuOperator, version string) bool {
+	if !upgradeApprovalRequired(gpuOperator) {
+		return true
+	}
+	return upgradeApproved(gpuOperator) && gpuOperator.Status.PendingUpgrade.Chart != nil &&
+		gpuOperator.Status.PendingUpgrade.Chart.To == version
+}
+
+// specDriverVersion returns the driver version of the spec, spec.componentVersions.driver or spec.driverVersion,
+// or spec.amd.driverVersion for the vendor AMD
+func specDriverVersion(gpuOperator *operatorv1alpha1.GpuOperator) string {
+	if isAMD(gpuOperator) {
+		return amdDriverVersion(gpuOperator)
+	}
+	if versions := gpuOperator.Spec.ComponentVersions; versions != nil && versions.Driver != "" {
+		return versions.Driver
+	}
+	return gpuOperator.Spec.DriverVersion
+}
+
+// reconcileUpgradeApproval holds back the upgrades that require approval if spec.upgradePolicy.requireApproval
+// is set, and reports whether the installation waits for an approval. A pending chart upgrade only holds back
+// the selection of the new chart version, a pending driver change holds back the installation of the spec.
+func (r *GpuOperatorReconciler) reconcileUpgradeApproval(ctx context.Context, gpuOperator *operatorv1alpha1.GpuOperator) (bool, error) {
+	if !upgradeApprovalRequired(gpuOperator) {
+		gpuOperator.Status.PendingUpgrade = nil
+		gpuOperator.Status.AppliedDriverVersion = specDriverVersion(gpuOperator)
+		return false, nil
+	}
+
+	plan := upgradePlan(gpuOperator)
+	pending := gpuOperator.Status.PendingUpgrade
+	switch {
+	case plan == nil:
+		gpuOperator.Status.PendingUpgrade = nil
+	case upgradeApproved(gpuOperator) && planCovers(pending, plan):
+		// The approved plan stays pending until all of it is installed
+	default:
+		if pending == nil || pending.ID != plan.ID {
+			plan.Since = metav1.Now()
+			gpuOperator.Status.PendingUpgrade = plan
+			log.FromContext(ctx).Info("Upgrade waits for approval", "upgrade", plan.ID, "changes", describeUpgradePlan(plan))
+			r.event(gpuOperator, corev1.EventTypeNormal, eventUpgradePendingApproval, "%s waits for approval, annotate with %s=%s",
+				describeUpgradePlan(plan), approveUpgradeAnnotation, plan.ID)
+			if err := r.updateStatus(ctx, gpuOperator); err != nil {
+				return false, fmt.Errorf("failed to record pending upgrade: %w", err)
+			}
+		}
+		if plan.Driver != nil {
+			return true, nil
+		}
+	}
+	if version := specDriverVersion(gpuOperator); version != "" {
+		gpuOperator.Status.AppliedDriverVersion = version
+	}
+	return false, nil
+}
+
+// upgradePlan returns the chart upgrade of the upgrade channel and the change of the driver version in the
+// spec, nil if there are none
+func upgradePlan(gpuOperator *operatorv1alpha1.GpuOperator) *operatorv1alpha1.UpgradePlan {
+	plan := &operatorv1alpha1.UpgradePlan{}
+	current := currentChartVersion(gpuOperator)
+	if candidate := chartUpgradeCandidate(gpuOperator, current); candidate != "" {
+		plan.Chart = &operatorv1alpha1.VersionChange{From: current, To: candidate}
+	}
+	// Without an applied version there is no driver to roll, e.g. on the first installation
+	if applied, version := gpuOperator.Status.AppliedDriverVersion, specDriverVersion(gpuOperator); applied != "" &&
+		version != "" && version != applied {
+		plan.Driver = &operatorv1alpha1.VersionChange{From: applied, To: version}
+	}
+	if plan.Chart == nil && plan.Driver == nil {
+		return nil
+	}
+	plan.ID = upgradePlanID(plan)
+	return plan
+}
+
+// upgradePlanID derives the ID of a plan from its changes, so the same changes keep their ID across reconciles
+func upgradePlanID(plan *operatorv1alpha1.UpgradePlan) string {
+	sum := sha256.New()
+	for _, change := range []*operatorv1alpha1.VersionChange{plan.Chart, plan.Driver} {
+		if change != nil {
+			fmt.Fprintf(sum, "%s>%s", change.From, change.To)
+		}
+		sum.Write([]byte{0})
+	}
+	return hex.EncodeToString(sum.Sum(nil))[:10]
+}
+
+// planCovers reports whether every change of a plan is part of the approved plan, which may have more
+// changes that are installed already
+func planCovers(approved, plan *operatorv1alpha1.UpgradePlan) bool {
+	covers := func(approved, change *operatorv1alpha1.VersionChange) bool {
+		return change == nil || approved != nil && *approved == *change
+	}
+	return covers(approved.Chart, plan.Chart) && covers(approved.Driver, plan.Driver)
+}
+
+// describeUpgradePlan summarizes the changes of a plan for logs and Events
+func describeUpgradePlan(plan *operatorv1alpha1.UpgradePlan) string {
+	var changes []string
+	if plan.Chart != nil {
+		changes = append(changes, fmt.Sprintf("chart upgrade from %s to %s", plan.Chart.From, plan.Chart.To))
+	}
+	if plan.Driver != nil {
+		changes = append(changes, fmt.Sprintf("driver change from %s to %s", plan.Driver.From, plan.Driver.To))
+	}
+	return strings.Join(changes, " and ")
+}
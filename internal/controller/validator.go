@@ -0,0 +1,58 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"strconv"
+
+	corev1 "k8s.io/api/core/v1"
+
+	operatorv1alpha1 "github.com/kyma-project/gpu-operator/api/v1alpha1"
+)
+
+// withWorkloadEnv switches the test pod of the cuda and plugin validations on or off
+const withWorkloadEnv = "WITH_WORKLOAD"
+
+// validatorValues returns the chart values of spec.validator. The environment of a configured validation
+// replaces the chart default, which for the plugin validation disables its workload.
+func validatorValues(gpuOperator *operatorv1alpha1.GpuOperator) []chartValue {
+	validator := gpuOperator.Spec.Validator
+	if validator == nil {
+		return nil
+	}
+	values := operandImageValues("validator", validator.Repository, validator.Image)
+	components := []struct {
+		name      string
+		component *operatorv1alpha1.ValidatorComponent
+	}{
+		{"driver", validator.Driver},
+		{"toolkit", validator.Toolkit},
+		{"cuda", validator.CUDA},
+		{"plugin", validator.Plugin},
+	}
+	for _, c := range components {
+		if c.component == nil {
+			continue
+		}
+		env := append([]corev1.EnvVar{}, c.component.Env...)
+		if c.component.Workload != nil {
+			env = append(env, corev1.EnvVar{Name: withWorkloadEnv, Value: strconv.FormatBool(*c.component.Workload)})
+		}
+		values = append(values, chartValue{path: "validator." + c.name + ".env", value: env})
+	}
+	return values
+}
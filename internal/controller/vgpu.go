@@ -0,0 +1,150 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	operatorv1alpha1 "github.com/kyma-project/gpu-operator/api/v1alpha1"
+)
+
+const (
+	vgpuComponent = "vgpu"
+
+	// vgpuPullSecretName is the copy of the credentials of the vGPU driver registry in the installation namespace
+	vgpuPullSecretName = "vgpu-image-pull"
+	// vgpuLicensingConfigMapName holds gridd.conf and the client configuration token read by the vGPU guest driver
+	vgpuLicensingConfigMapName = "licensing-config"
+
+	// vgpuFeatureType licenses the guest driver for NVIDIA vGPU
+	vgpuFeatureType = 1
+)
+
+// vgpuValues returns the chart values pointing the driver to the vGPU guest driver image and its licensing configuration
+func vgpuValues(gpuOperator *operatorv1alpha1.GpuOperator) []chartValue {
+	vgpu := gpuOperator.Spec.VGPU
+	if vgpu == nil {
+		return nil
+	}
+	values := operandImageValues("driver", vgpu.DriverRepository, vgpu.DriverImage)
+	if vgpu.ImagePullSecretRef != nil {
+		values = append(values, chartValue{path: "driver.imagePullSecrets", value: []string{vgpuPullSecretName}})
+	}
+	return append(values,
+		chartValue{path: "driver.licensingConfig.configMapName", value: vgpuLicensingConfigMapName},
+		chartValue{path: "driver.licensingConfig.nlsEnabled", value: vgpu.ClientTokenSecretRef != nil},
+	)
+}
+
+// reconcileVGPU provides the licensing ConfigMap of the vGPU guest driver and the copy of the image pull secret of
+// its registry in the installation namespace. Both are removed when spec.vgpu is not set.
+func (r *GpuOperatorReconciler) reconcileVGPU(ctx context.Context, gpuOperator *operatorv1alpha1.GpuOperator, namespace string) error {
+	pullSecret := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: vgpuPullSecretName, Namespace: namespace}}
+	licensing := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: vgpuLicensingConfigMapName, Namespace: namespace}}
+	vgpu := gpuOperator.Spec.VGPU
+	if vgpu == nil || vgpu.ImagePullSecretRef == nil {
+		if err := r.Delete(ctx, pullSecret); err != nil && !apierrors.IsNotFound(err) {
+			return fmt.Errorf("failed to delete vGPU image pull secret: %w", err)
+		}
+	}
+	if vgpu == nil {
+		if err := r.Delete(ctx, licensing); err != nil && !apierrors.IsNotFound(err) {
+			return fmt.Errorf("failed to delete vGPU licensing config: %w", err)
+		}
+		return nil
+	}
+
+	data := map[string]string{griddConfKey: vgpuGriddConf(vgpu.LicenseServer)}
+	if ref := vgpu.ClientTokenSecretRef; ref != nil {
+		source := &corev1.Secret{}
+		if err := r.localReader().Get(ctx, types.NamespacedName{Name: ref.Name, Namespace: gpuOperator.Namespace}, source); err != nil {
+			return fmt.Errorf("failed to get vGPU client token Secret %s: %w", ref.Name, err)
+		}
+		if len(source.Data[licensingTokenKey]) == 0 {
+			return fmt.Errorf("vGPU client token Secret %s has no key %s", ref.Name, licensingTokenKey)
+		}
+		data[licensingTokenKey] = string(source.Data[licensingTokenKey])
+	}
+	if _, err := controllerutil.CreateOrUpdate(ctx, r.Client, licensing, func() error {
+		licensing.Labels = vgpuLabels()
+		licensing.Data = data
+		return r.setControllerReference(gpuOperator, licensing)
+	}); err != nil {
+		return fmt.Errorf("failed to reconcile vGPU licensing config: %w", err)
+	}
+
+	if ref := vgpu.ImagePullSecretRef; ref != nil {
+		source := &corev1.Secret{}
+		if err := r.localReader().Get(ctx, types.NamespacedName{Name: ref.Name, Namespace: gpuOperator.Namespace}, source); err != nil {
+			return fmt.Errorf("failed to get vGPU image pull Secret %s: %w", ref.Name, err)
+		}
+		if source.Type != corev1.SecretTypeDockerConfigJson {
+			return fmt.Errorf("vGPU image pull Secret %s is of type %s, expected %s", ref.Name, source.Type, corev1.SecretTypeDockerConfigJson)
+		}
+		if _, err := controllerutil.CreateOrUpdate(ctx, r.Client, pullSecret, func() error {
+			pullSecret.Labels = vgpuLabels()
+			pullSecret.Type = corev1.SecretTypeDockerConfigJson
+			pullSecret.Data = map[string][]byte{corev1.DockerConfigJsonKey: source.Data[corev1.DockerConfigJsonKey]}
+			return r.setControllerReference(gpuOperator, pullSecret)
+		}); err != nil {
+			return fmt.Errorf("failed to reconcile vGPU image pull secret: %w", err)
+		}
+	}
+	return nil
+}
+
+// vgpuGriddConf renders the gridd.conf of the guest driver. With the NVIDIA License System the driver finds the
+// license server in the client configuration token.
+func vgpuGriddConf(server *operatorv1alpha1.VGPULicenseServer) string {
+	conf := fmt.Sprintf("FeatureType=%d\n", vgpuFeatureType)
+	if server == nil {
+		return conf
+	}
+	port := server.Port
+	if port == 0 {
+		port = 7070
+	}
+	return fmt.Sprintf("ServerAddress=%s\nServerPort=%d\n", server.Address, port) + conf
+}
+
+// deleteVGPUObjects deletes the licensing ConfigMap and image pull secret of the vGPU guest driver
+func (r *GpuOperatorReconciler) deleteVGPUObjects(ctx context.Context, namespace string) error {
+	for _, obj := range []client.Object{&corev1.ConfigMap{}, &corev1.Secret{}} {
+		if err := r.DeleteAllOf(ctx, obj, client.InNamespace(namespace),
+			client.MatchingLabels{"app.kubernetes.io/component": vgpuComponent}); err != nil && !apierrors.IsNotFound(err) {
+			return fmt.Errorf("failed to delete vGPU objects: %w", err)
+		}
+	}
+	return nil
+}
+
+// vgpuLabels returns the labels of the objects derived from spec.vgpu
+func vgpuLabels() map[string]string {
+	return map[string]string{
+		"app.kubernetes.io/name":       "gpu-operator",
+		"app.kubernetes.io/managed-by": "gpu-operator-module",
+		"app.kubernetes.io/component":  vgpuComponent,
+	}
+}
@@ -0,0 +1,78 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	corev1 "k8s.io/api/core/v1"
+
+	operatorv1alpha1 "github.com/kyma-project/gpu-operator/api/v1alpha1"
+)
+
+const (
+	// workloadConfigLabel selects the workload a GPU node serves when sandbox workloads are enabled
+	workloadConfigLabel   = "nvidia.com/gpu.workload.config"
+	workloadContainer     = "container"
+	workloadVMPassthrough = "vm-passthrough"
+)
+
+// vmPassthroughValues returns the chart values enabling the sandbox workload operands for VM passthrough
+func vmPassthroughValues(gpuOperator *operatorv1alpha1.GpuOperator) []chartValue {
+	passthrough := vmPassthrough(gpuOperator)
+	if passthrough == nil {
+		return nil
+	}
+	return []chartValue{
+		{path: "sandboxWorkloads.enabled", value: true},
+		{path: "sandboxWorkloads.defaultWorkload", value: defaultWorkload(passthrough)},
+		{path: "vfioManager.enabled", value: true},
+		{path: "sandboxDevicePlugin.enabled", value: true},
+	}
+}
+
+// vmPassthrough returns spec.workloads.vmPassthrough if it is enabled, nil otherwise
+func vmPassthrough(gpuOperator *operatorv1alpha1.GpuOperator) *operatorv1alpha1.VMPassthroughSpec {
+	workloads := gpuOperator.Spec.Workloads
+	if workloads == nil || workloads.VMPassthrough == nil || !workloads.VMPassthrough.Enabled {
+		return nil
+	}
+	return workloads.VMPassthrough
+}
+
+// defaultWorkload returns the workload of GPU nodes without the workload config label
+func defaultWorkload(passthrough *operatorv1alpha1.VMPassthroughSpec) string {
+	if passthrough.DefaultWorkload != "" {
+		return passthrough.DefaultWorkload
+	}
+	return workloadContainer
+}
+
+// servesContainers reports whether a GPU node advertises nvidia.com/gpu to containers, as opposed
+// to passing its GPUs through to virtual machines or being excluded from the operands
+func servesContainers(gpuOperator *operatorv1alpha1.GpuOperator, node *corev1.Node) bool {
+	if _, excluded := node.Labels[operandsExcludedLabel]; excluded {
+		return false
+	}
+	passthrough := vmPassthrough(gpuOperator)
+	if passthrough == nil {
+		return true
+	}
+	workload := node.Labels[workloadConfigLabel]
+	if workload == "" {
+		workload = defaultWorkload(passthrough)
+	}
+	return workload == workloadContainer
+}
@@ -0,0 +1,232 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	operatorv1alpha1 "github.com/kyma-project/gpu-operator/api/v1alpha1"
+)
+
+const (
+	conditionTypeUninstallBlocked = "UninstallBlocked"
+	conditionTypeWorkloadsDrained = "GPUWorkloadsDrained"
+
+	defaultDrainTimeout = 10 * time.Minute
+
+	// maxListedWorkloads limits the pods named in the UninstallBlocked condition
+	maxListedWorkloads = 10
+)
+
+// +kubebuilder:rbac:groups="",resources=pods/eviction,verbs=create
+
+// gpuWorkloads returns the pods outside the installation namespace that request nvidia.com/gpu and have not finished
+func (r *GpuOperatorReconciler) gpuWorkloads(ctx context.Context, namespace string) ([]corev1.Pod, error) {
+	pods := &corev1.PodList{}
+	if err := r.List(ctx, pods); err != nil {
+		return nil, fmt.Errorf("failed to list pods: %w", err)
+	}
+	var workloads []corev1.Pod
+	for _, pod := range pods.Items {
+		if pod.Namespace == namespace || pod.Status.Phase == corev1.PodSucceeded || pod.Status.Phase == corev1.PodFailed {
+			continue
+		}
+		if podRequestsGPU(&pod) {
+			workloads = append(workloads, pod)
+		}
+	}
+	return workloads, nil
+}
+
+// podRequestsGPU reports whether any container of the pod requests or is limited to nvidia.com/gpu
+func podRequestsGPU(pod *corev1.Pod) bool {
+	containers := append(append([]corev1.Container{}, pod.Spec.InitContainers...), pod.Spec.Containers...)
+	for _, container := range containers {
+		if _, ok := container.Resources.Limits[gpuResourceName]; ok {
+			return true
+		}
+		if _, ok := container.Resources.Requests[gpuResourceName]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+// blockUninstallForWorkloads reports whether finalization has to wait because spec.uninstall.blockIfWorkloadsPresent
+// is set and GPU workloads are still running. The offending pods are listed in the UninstallBlocked condition.
+func (r *GpuOperatorReconciler) blockUninstallForWorkloads(ctx context.Context, gpuOperator *operatorv1alpha1.GpuOperator, namespace string) (bool, error) {
+	previous := meta.FindStatusCondition(gpuOperator.Status.Conditions, conditionTypeUninstallBlocked)
+	condition := metav1.Condition{
+		Type:               conditionTypeUninstallBlocked,
+		Status:             metav1.ConditionFalse,
+		Reason:             "NoGPUWorkloads",
+		Message:            "No pods requesting " + string(gpuResourceName) + " are running, uninstalling",
+		ObservedGeneration: gpuOperator.Generation,
+	}
+
+	var workloads []corev1.Pod
+	if gpuOperator.Spec.Uninstall == nil || !gpuOperator.Spec.Uninstall.BlockIfWorkloadsPresent {
+		if previous == nil || previous.Status != metav1.ConditionTrue {
+			return false, nil
+		}
+		condition.Reason = "BlockingDisabled"
+		condition.Message = "spec.uninstall.blockIfWorkloadsPresent was unset, uninstalling"
+	} else {
+		var err error
+		if workloads, err = r.gpuWorkloads(ctx, namespace); err != nil {
+			return false, err
+		}
+	}
+
+	if len(workloads) > 0 {
+		condition.Status = metav1.ConditionTrue
+		condition.Reason = "GPUWorkloadsPresent"
+		condition.Message = describeWorkloads(workloads) + ". Stop them or unset spec.uninstall.blockIfWorkloadsPresent to continue"
+		log.FromContext(ctx).Info("Uninstall blocked by running GPU workloads", "pods", len(workloads))
+	} else if previous == nil {
+		// Never blocked, nothing to record
+		return false, nil
+	}
+
+	if meta.SetStatusCondition(&gpuOperator.Status.Conditions, condition) {
+		if err := r.updateStatus(ctx, gpuOperator); err != nil {
+			return false, fmt.Errorf("failed to update UninstallBlocked condition: %w", err)
+		}
+	}
+	return len(workloads) > 0, nil
+}
+
+// describeWorkloads lists the namespaces and the first pods of the given GPU workloads
+func describeWorkloads(workloads []corev1.Pod) string {
+	var namespaces, pods []string
+	for _, pod := range workloads {
+		namespaces = append(namespaces, pod.Namespace)
+		if len(pods) < maxListedWorkloads {
+			pods = append(pods, pod.Namespace+"/"+pod.Name)
+		}
+	}
+	message := fmt.Sprintf("%d pods requesting %s are running in namespaces %s: %s",
+		len(workloads), gpuResourceName, strings.Join(uniqueSorted(namespaces), ", "), strings.Join(pods, ", "))
+	if len(workloads) > maxListedWorkloads {
+		message += fmt.Sprintf(" and %d more", len(workloads)-maxListedWorkloads)
+	}
+	return message
+}
+
+// uninstallDeadline returns when finalization stops waiting for the uninstall. The timeout starts when
+// the CR was deleted, after the drain timeout, or when running GPU workloads stopped blocking the uninstall.
+func uninstallDeadline(gpuOperator *operatorv1alpha1.GpuOperator) time.Time {
+	start := gpuOperator.GetDeletionTimestamp().Time
+	if uninstall := gpuOperator.Spec.Uninstall; uninstall != nil && uninstall.DrainGPUWorkloads {
+		start = start.Add(drainTimeout(gpuOperator))
+	}
+	if blocked := meta.FindStatusCondition(gpuOperator.Status.Conditions, conditionTypeUninstallBlocked); blocked != nil &&
+		blocked.Status == metav1.ConditionFalse && blocked.LastTransitionTime.After(start) {
+		start = blocked.LastTransitionTime.Time
+	}
+	return start.Add(uninstallTimeout(gpuOperator))
+}
+
+// drainTimeout returns how long finalization keeps evicting GPU workloads
+func drainTimeout(gpuOperator *operatorv1alpha1.GpuOperator) time.Duration {
+	if gpuOperator.Spec.Uninstall != nil && gpuOperator.Spec.Uninstall.DrainTimeout != nil {
+		return gpuOperator.Spec.Uninstall.DrainTimeout.Duration
+	}
+	return defaultDrainTimeout
+}
+
+// drainGPUWorkloads evicts the GPU workloads that existed when the CR was deleted if spec.uninstall.drainGpuWorkloads
+// is set, and reports whether the drain is done. Evictions refused by a PodDisruptionBudget are retried until the
+// drain timeout, after which the uninstall continues and the pods left are listed in the GPUWorkloadsDrained condition.
+func (r *GpuOperatorReconciler) drainGPUWorkloads(ctx context.Context, gpuOperator *operatorv1alpha1.GpuOperator, namespace string) (bool, error) {
+	if gpuOperator.Spec.Uninstall == nil || !gpuOperator.Spec.Uninstall.DrainGPUWorkloads {
+		return true, nil
+	}
+	logger := log.FromContext(ctx)
+
+	workloads, err := r.gpuWorkloads(ctx, namespace)
+	if err != nil {
+		return false, err
+	}
+	deletion := gpuOperator.GetDeletionTimestamp()
+	var remaining []corev1.Pod
+	for i := range workloads {
+		pod := &workloads[i]
+		// Replacements created by the workload controllers cannot run once the device plugin is gone
+		if isDaemonSetPod(pod) || !pod.CreationTimestamp.Before(deletion) {
+			continue
+		}
+		remaining = append(remaining, *pod)
+		if pod.DeletionTimestamp != nil {
+			continue
+		}
+		eviction := &policyv1.Eviction{ObjectMeta: metav1.ObjectMeta{Name: pod.Name, Namespace: pod.Namespace}}
+		err := r.SubResource("eviction").Create(ctx, pod, eviction)
+		switch {
+		case err == nil:
+			logger.Info("Evicted GPU workload", "pod", pod.Namespace+"/"+pod.Name)
+		case apierrors.IsTooManyRequests(err):
+			logger.Info("Eviction of GPU workload refused by PodDisruptionBudget, will retry", "pod", pod.Namespace+"/"+pod.Name)
+		case !apierrors.IsNotFound(err):
+			return false, fmt.Errorf("failed to evict pod %s/%s: %w", pod.Namespace, pod.Name, err)
+		}
+	}
+
+	condition := metav1.Condition{
+		Type:               conditionTypeWorkloadsDrained,
+		Status:             metav1.ConditionTrue,
+		Reason:             "Drained",
+		Message:            "All pods requesting " + string(gpuResourceName) + " were evicted",
+		ObservedGeneration: gpuOperator.Generation,
+	}
+	done := len(remaining) == 0
+	if !done {
+		deadline := deletion.Add(drainTimeout(gpuOperator))
+		condition.Status = metav1.ConditionFalse
+		condition.Reason = "Draining"
+		condition.Message = "Evicting GPU workloads until " + deadline.UTC().Format(time.RFC3339) + ": " + describeWorkloads(remaining)
+		if !time.Now().Before(deadline) {
+			done = true
+			condition.Reason = "DrainTimeout"
+			condition.Message = "GPU workloads were not evicted before the drain timeout, uninstalling anyway: " + describeWorkloads(remaining)
+			logger.Info("GPU workloads not evicted before drain timeout, continuing with uninstall", "pods", len(remaining))
+		}
+	}
+
+	if meta.SetStatusCondition(&gpuOperator.Status.Conditions, condition) {
+		if err := r.updateStatus(ctx, gpuOperator); err != nil {
+			return false, fmt.Errorf("failed to update GPUWorkloadsDrained condition: %w", err)
+		}
+	}
+	return done, nil
+}
+
+// isDaemonSetPod reports whether the pod is managed by a DaemonSet, which would recreate it right after eviction
+func isDaemonSetPod(pod *corev1.Pod) bool {
+	owner := metav1.GetControllerOf(pod)
+	return owner != nil && owner.Kind == "DaemonSet"
+}
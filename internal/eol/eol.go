@@ -0,0 +1,148 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package eol holds the end-of-life matrix of driver branches and chart versions. A matrix is shipped
+// with the controller and can be replaced at runtime through the controller configuration.
+package eol
+
+import (
+	_ "embed"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"sigs.k8s.io/yaml"
+)
+
+//go:embed matrix.yaml
+var defaultMatrix []byte
+
+// Lifecycle is the support status of a version at a point in time
+type Lifecycle string
+
+const (
+	// Supported versions are neither deprecated nor end-of-life
+	Supported Lifecycle = "Supported"
+	// Deprecated versions are still supported but approach their end-of-life
+	Deprecated Lifecycle = "Deprecated"
+	// EndOfLife versions no longer receive fixes
+	EndOfLife Lifecycle = "EndOfLife"
+)
+
+// Matrix lists the lifecycle dates of driver branches and GPU operator chart versions
+type Matrix struct {
+	// DriverBranches are matched against the driver version
+	DriverBranches []Entry `json:"driverBranches,omitempty"`
+
+	// ChartVersions are matched against the GPU operator chart version
+	ChartVersions []Entry `json:"chartVersions,omitempty"`
+}
+
+// Entry defines the lifecycle dates of a version prefix; dates use the YYYY-MM-DD format
+type Entry struct {
+	// Version is matched as a prefix on dot boundaries, e.g. "535" matches 535.247.01
+	Version string `json:"version"`
+
+	// Deprecated is the date from which the version is deprecated
+	Deprecated string `json:"deprecated,omitempty"`
+
+	// EndOfLife is the date from which the version is end-of-life
+	EndOfLife string `json:"endOfLife,omitempty"`
+}
+
+// Default returns the matrix shipped with the controller
+var Default = sync.OnceValue(func() *Matrix {
+	m, err := Parse(defaultMatrix)
+	if err != nil {
+		panic(fmt.Sprintf("invalid embedded EOL matrix: %v", err))
+	}
+	return m
+})
+
+// Parse decodes and validates a matrix
+func Parse(data []byte) (*Matrix, error) {
+	m := &Matrix{}
+	if err := yaml.UnmarshalStrict(data, m); err != nil {
+		return nil, fmt.Errorf("failed to parse EOL matrix: %w", err)
+	}
+	return m, m.Validate()
+}
+
+// Validate checks that every entry has a version and well-formed dates
+func (m *Matrix) Validate() error {
+	for _, entries := range [][]Entry{m.DriverBranches, m.ChartVersions} {
+		for _, entry := range entries {
+			if entry.Version == "" {
+				return fmt.Errorf("EOL matrix entry without version")
+			}
+			for _, date := range []string{entry.Deprecated, entry.EndOfLife} {
+				if date == "" {
+					continue
+				}
+				if _, err := time.Parse(time.DateOnly, date); err != nil {
+					return fmt.Errorf("invalid date %q for version %s: %w", date, entry.Version, err)
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// Driver returns the entry matching the driver version, nil if the matrix does not list it
+func (m *Matrix) Driver(version string) *Entry {
+	return lookup(m.DriverBranches, version)
+}
+
+// Chart returns the entry matching the chart version, nil if the matrix does not list it
+func (m *Matrix) Chart(version string) *Entry {
+	return lookup(m.ChartVersions, version)
+}
+
+// lookup returns the most specific entry whose version is a prefix of the given version
+func lookup(entries []Entry, version string) *Entry {
+	var match *Entry
+	for i := range entries {
+		prefix := entries[i].Version
+		if version != prefix && !strings.HasPrefix(version, prefix+".") {
+			continue
+		}
+		if match == nil || len(prefix) > len(match.Version) {
+			match = &entries[i]
+		}
+	}
+	return match
+}
+
+// Lifecycle returns the support status of the entry at the given time
+func (e *Entry) Lifecycle(now time.Time) Lifecycle {
+	if reached(e.EndOfLife, now) {
+		return EndOfLife
+	}
+	if reached(e.Deprecated, now) {
+		return Deprecated
+	}
+	return Supported
+}
+
+// reached reports whether the date is set and not after now
+func reached(date string, now time.Time) bool {
+	if date == "" {
+		return false
+	}
+	t, err := time.Parse(time.DateOnly, date)
+	return err == nil && !now.Before(t)
+}
@@ -0,0 +1,42 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package gardener holds the Garden Linux values of the GPU operator chart published by the Gardener project.
+// A vetted copy is shipped with the controller, so air-gapped clusters and clusters with a flaky network
+// still install.
+package gardener
+
+import (
+	_ "embed"
+	"fmt"
+
+	"sigs.k8s.io/yaml"
+)
+
+// ValuesURL is where the Gardener project publishes the Garden Linux values
+const ValuesURL = "https://raw.githubusercontent.com/gardenlinux/gardenlinux-nvidia-installer/refs/heads/main/helm/gpu-operator-values.yaml"
+
+//go:embed values.yaml
+var embeddedValues []byte
+
+// Values returns a copy of the Garden Linux values embedded in the controller, which callers may modify
+func Values() (map[string]interface{}, error) {
+	values := map[string]interface{}{}
+	if err := yaml.Unmarshal(embeddedValues, &values); err != nil {
+		return nil, fmt.Errorf("invalid embedded Garden Linux values: %w", err)
+	}
+	return values, nil
+}
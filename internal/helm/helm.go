@@ -0,0 +1,713 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package helm runs Helm actions in-process with the Helm SDK, for the Helm install engine.
+package helm
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-logr/logr"
+	"golang.org/x/net/http/httpproxy"
+	"golang.org/x/sync/singleflight"
+	"helm.sh/helm/v3/pkg/action"
+	"helm.sh/helm/v3/pkg/chart"
+	"helm.sh/helm/v3/pkg/chart/loader"
+	"helm.sh/helm/v3/pkg/chartutil"
+	"helm.sh/helm/v3/pkg/cli"
+	"helm.sh/helm/v3/pkg/downloader"
+	"helm.sh/helm/v3/pkg/getter"
+	"helm.sh/helm/v3/pkg/release"
+	"helm.sh/helm/v3/pkg/repo"
+	"helm.sh/helm/v3/pkg/storage/driver"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/client-go/discovery"
+	"k8s.io/client-go/discovery/cached/memory"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/restmapper"
+	"k8s.io/client-go/tools/clientcmd"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	// cacheTTL is how long a loaded chart or values file is reused before it is fetched again, so that
+	// an unpinned chart version picks up new releases
+	cacheTTL = time.Hour
+	// downloadTimeout bounds a download from a chart repository or values URL, so an unresponsive server does
+	// not hold up the reconciles waiting for it
+	downloadTimeout = 5 * time.Minute
+	// discoveryTTL is how long the API discovery of a cluster is reused, so resource types added by others are found
+	discoveryTTL = 10 * time.Minute
+	// hookTimeout bounds the chart hooks; the operands are not waited for, readiness is checked by the controller
+	hookTimeout = 10 * time.Minute
+	// logTailLines is how many of the last lines Helm logged are added to the error of a failed operation
+	logTailLines = 10
+)
+
+// Chart identifies a chart in a chart repository
+type Chart struct {
+	RepoURL string
+	Name    string
+	// Version is the chart version, the latest version if empty
+	Version string
+	// CABundle holds the PEM encoded CA certificates of the repository, the system CAs are used if empty
+	CABundle string
+	// Proxy is the proxy the repository is reached through
+	Proxy Proxy
+	// Credentials authenticate to the repository, it is accessed anonymously if empty
+	Credentials Credentials
+}
+
+// Credentials authenticate to a chart repository with basic auth, or with a bearer token if Token is set.
+// They are only sent to the host of the repository.
+type Credentials struct {
+	Username string
+	Password string
+	Token    string
+}
+
+// Proxy configures the HTTP proxy of downloads, the proxy environment of the controller is used if empty
+type Proxy struct {
+	HTTPProxy  string
+	HTTPSProxy string
+	NoProxy    string
+}
+
+// Release is a revision of a release as stored by Helm
+type Release struct {
+	Revision     int
+	Status       release.Status
+	ChartVersion string
+	AppVersion   string
+	// Values are the values of the revision merged over the defaults of its chart
+	Values map[string]interface{}
+}
+
+// UpgradeError is returned when an upgrade failed. If the release was rolled back, RolledBackTo is the
+// revision that is deployed again.
+type UpgradeError struct {
+	Release      string
+	RolledBackTo int
+	Err          error
+}
+
+func (e *UpgradeError) Error() string {
+	if e.RolledBackTo > 0 {
+		return fmt.Sprintf("helm upgrade of %s failed, rolled back to revision %d: %v", e.Release, e.RolledBackTo, e.Err)
+	}
+	return fmt.Sprintf("helm upgrade of %s failed: %v", e.Release, e.Err)
+}
+
+func (e *UpgradeError) Unwrap() error {
+	return e.Err
+}
+
+// Client installs, upgrades and uninstalls releases. Charts and values files are cached across calls,
+// the cluster is passed per call so a single client serves local and remote clusters.
+type Client struct {
+	settings *cli.EnvSettings
+	log      logr.Logger
+
+	// downloads runs concurrent downloads of the same chart or values file once; mu only guards the caches
+	// and is never held during network I/O
+	downloads singleflight.Group
+	mu        sync.Mutex
+	charts    map[Chart]cachedChart
+	values    map[string]cachedValues
+	discovery map[*rest.Config]cachedDiscovery
+}
+
+type cachedChart struct {
+	chart    *chart.Chart
+	loadedAt time.Time
+}
+
+type cachedValues struct {
+	values    map[string]interface{}
+	fetchedAt time.Time
+}
+
+type cachedDiscovery struct {
+	client    discovery.CachedDiscoveryInterface
+	createdAt time.Time
+}
+
+// New returns a client that keeps the Helm repository cache below cacheDir
+func New(cacheDir string, log logr.Logger) *Client {
+	settings := cli.New()
+	settings.RepositoryCache = filepath.Join(cacheDir, "repository")
+	settings.RepositoryConfig = filepath.Join(cacheDir, "repositories.yaml")
+	settings.RegistryConfig = filepath.Join(cacheDir, "registry", "config.json")
+	return &Client{
+		settings:  settings,
+		log:       log,
+		charts:    map[Chart]cachedChart{},
+		values:    map[string]cachedValues{},
+		discovery: map[*rest.Config]cachedDiscovery{},
+	}
+}
+
+// Values fetches a values file from a URL
+func (c *Client) Values(ctx context.Context, url string, proxy Proxy) (map[string]interface{}, error) {
+	c.mu.Lock()
+	cached, ok := c.values[url]
+	c.mu.Unlock()
+	if ok && time.Since(cached.fetchedAt) < cacheTTL {
+		return copyValues(cached.values)
+	}
+
+	fetched, err := c.download(ctx, fmt.Sprintf("values %s %v", url, proxy), func(ctx context.Context) (interface{}, error) {
+		values, err := c.fetchValues(ctx, url, proxy)
+		if err == nil {
+			c.mu.Lock()
+			c.values[url] = cachedValues{values: values, fetchedAt: time.Now()}
+			c.mu.Unlock()
+		}
+		return values, err
+	})
+	if err != nil {
+		if ok {
+			c.log.Error(err, "Failed to refresh values file, using cached copy", "url", url)
+			return copyValues(cached.values)
+		}
+		return nil, err
+	}
+	return copyValues(fetched.(map[string]interface{}))
+}
+
+// download runs fetch once for all concurrent callers with the same key. The download is bounded by
+// downloadTimeout rather than canceled with the context of the caller that started it, as other callers may
+// still wait for it; each caller stops waiting when its own context is done.
+func (c *Client) download(ctx context.Context, key string, fetch func(ctx context.Context) (interface{}, error)) (interface{}, error) {
+	result := c.downloads.DoChan(key, func() (interface{}, error) {
+		ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), downloadTimeout)
+		defer cancel()
+		return fetch(ctx)
+	})
+	select {
+	case res := <-result:
+		return res.Val, res.Err
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+func (c *Client) fetchValues(ctx context.Context, url string, proxy Proxy) (map[string]interface{}, error) {
+	providers, err := c.getters(ctx, "", proxy, "", "")
+	if err != nil {
+		return nil, err
+	}
+	g, err := providers.ByScheme("https")
+	if err != nil {
+		return nil, err
+	}
+	data, err := g.Get(url)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch values from %s: %w", url, err)
+	}
+	values := map[string]interface{}{}
+	if err := yaml.Unmarshal(data.Bytes(), &values); err != nil {
+		return nil, fmt.Errorf("failed to parse values from %s: %w", url, err)
+	}
+	return values, nil
+}
+
+// loadChart returns the cached chart, or downloads it from the repository
+func (c *Client) loadChart(ctx context.Context, ref Chart) (*chart.Chart, error) {
+	c.mu.Lock()
+	cached, ok := c.charts[ref]
+	c.mu.Unlock()
+	if ok && time.Since(cached.loadedAt) < cacheTTL {
+		return cached.chart, nil
+	}
+
+	loaded, err := c.download(ctx, fmt.Sprintf("chart %v", ref), func(ctx context.Context) (interface{}, error) {
+		path, err := c.downloadChart(ctx, ref)
+		if err != nil {
+			return nil, err
+		}
+		loaded, err := loader.Load(path)
+		if err == nil {
+			c.mu.Lock()
+			c.charts[ref] = cachedChart{chart: loaded, loadedAt: time.Now()}
+			c.mu.Unlock()
+		}
+		return loaded, err
+	})
+	if err == nil {
+		return loaded.(*chart.Chart), nil
+	}
+	if ok {
+		c.log.Error(err, "Failed to refresh chart, using cached copy", "chart", ref.Name)
+		return cached.chart, nil
+	}
+	return nil, fmt.Errorf("failed to load chart %s from %s: %w", ref.Name, ref.RepoURL, err)
+}
+
+// FindChart checks that the repository can be reached and serves the chart in the requested version,
+// without downloading the chart
+func (c *Client) FindChart(ctx context.Context, ref Chart) error {
+	_, err := c.download(ctx, fmt.Sprintf("find %v", ref), func(ctx context.Context) (interface{}, error) {
+		_, _, err := c.findChart(ctx, ref)
+		return nil, err
+	})
+	return err
+}
+
+// ChartVersions returns the versions of the chart listed in the index of the repository, newest first
+func (c *Client) ChartVersions(ctx context.Context, ref Chart) ([]string, error) {
+	versions, err := c.download(ctx, fmt.Sprintf("versions %v", ref), func(ctx context.Context) (interface{}, error) {
+		return c.chartVersions(ctx, ref)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return versions.([]string), nil
+}
+
+func (c *Client) chartVersions(ctx context.Context, ref Chart) ([]string, error) {
+	repoURL, err := url.Parse(ref.RepoURL)
+	if err != nil {
+		return nil, fmt.Errorf("invalid repository URL %s: %w", ref.RepoURL, err)
+	}
+	getters, err := c.getters(ctx, ref.CABundle, ref.Proxy, ref.Credentials.Token, repoURL.Host)
+	if err != nil {
+		return nil, err
+	}
+	chartRepo, err := repo.NewChartRepository(&repo.Entry{
+		URL:      ref.RepoURL,
+		Username: ref.Credentials.Username,
+		Password: ref.Credentials.Password,
+	}, getters)
+	if err != nil {
+		return nil, fmt.Errorf("invalid repository %s: %w", ref.RepoURL, err)
+	}
+	// Like helm, download the index into a directory of its own rather than the shared repository cache
+	dir, err := os.MkdirTemp("", "helm-index-")
+	if err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(dir)
+	chartRepo.CachePath = dir
+	path, err := chartRepo.DownloadIndexFile()
+	if err != nil {
+		return nil, fmt.Errorf("failed to download the index of %s: %w", ref.RepoURL, err)
+	}
+	// Loading sorts the versions of every chart, newest first
+	index, err := repo.LoadIndexFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load the index of %s: %w", ref.RepoURL, err)
+	}
+	entries, ok := index.Entries[ref.Name]
+	if !ok {
+		return nil, fmt.Errorf("chart %s not found in %s", ref.Name, ref.RepoURL)
+	}
+	versions := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		versions = append(versions, entry.Version)
+	}
+	return versions, nil
+}
+
+// findChart looks the chart up in the index of the repository and returns its URL and the getters to download it
+func (c *Client) findChart(ctx context.Context, ref Chart) (string, getter.Providers, error) {
+	repoURL, err := url.Parse(ref.RepoURL)
+	if err != nil {
+		return "", nil, fmt.Errorf("invalid repository URL %s: %w", ref.RepoURL, err)
+	}
+	getters, err := c.getters(ctx, ref.CABundle, ref.Proxy, ref.Credentials.Token, repoURL.Host)
+	if err != nil {
+		return "", nil, err
+	}
+	chartURL, err := repo.FindChartInAuthAndTLSAndPassRepoURL(ref.RepoURL, ref.Credentials.Username, ref.Credentials.Password,
+		ref.Name, ref.Version, "", "", "", false, false, getters)
+	if err != nil {
+		return "", nil, err
+	}
+	return chartURL, getters, nil
+}
+
+// downloadChart downloads the chart from the repository into the repository cache and returns its path
+func (c *Client) downloadChart(ctx context.Context, ref Chart) (string, error) {
+	chartURL, getters, err := c.findChart(ctx, ref)
+	if err != nil {
+		return "", err
+	}
+	repoURL, err := url.Parse(ref.RepoURL)
+	if err != nil {
+		return "", fmt.Errorf("invalid repository URL %s: %w", ref.RepoURL, err)
+	}
+	username, password := ref.Credentials.Username, ref.Credentials.Password
+	if err := os.MkdirAll(c.settings.RepositoryCache, 0o755); err != nil {
+		return "", err
+	}
+	dl := downloader.ChartDownloader{
+		Out:              io.Discard,
+		Getters:          getters,
+		RepositoryConfig: c.settings.RepositoryConfig,
+		RepositoryCache:  c.settings.RepositoryCache,
+	}
+	// Like helm, pass the basic auth credentials on only if the chart is served by the repository host
+	if u, err := url.Parse(chartURL); err == nil && u.Scheme == repoURL.Scheme && u.Host == repoURL.Host {
+		dl.Options = append(dl.Options, getter.WithBasicAuth(username, password))
+	}
+	path, _, err := dl.DownloadTo(chartURL, ref.Version, c.settings.RepositoryCache)
+	return path, err
+}
+
+// getters returns the HTTP getters of the repository and values downloads. A CA bundle is trusted in addition
+// to the system CAs, a proxy replaces the proxy settings of the controller environment, and a bearer token is
+// sent to the given host. Every request times out after downloadTimeout; the requests with a bearer token are
+// also canceled with ctx, the Helm HTTP getter takes no context.
+func (c *Client) getters(ctx context.Context, caBundle string, proxy Proxy, token, tokenHost string) (getter.Providers, error) {
+	transport := &http.Transport{DisableCompression: true, Proxy: http.ProxyFromEnvironment}
+	if proxy != (Proxy{}) {
+		proxyFunc := (&httpproxy.Config{HTTPProxy: proxy.HTTPProxy, HTTPSProxy: proxy.HTTPSProxy, NoProxy: proxy.NoProxy}).ProxyFunc()
+		transport.Proxy = func(req *http.Request) (*url.URL, error) { return proxyFunc(req.URL) }
+	}
+	if caBundle != "" {
+		pool, err := x509.SystemCertPool()
+		if err != nil {
+			pool = x509.NewCertPool()
+		}
+		if !pool.AppendCertsFromPEM([]byte(caBundle)) {
+			return nil, errors.New("the CA bundle contains no PEM encoded certificate")
+		}
+		transport.TLSClientConfig = &tls.Config{RootCAs: pool, MinVersion: tls.VersionTLS12}
+	}
+	return getter.Providers{{
+		Schemes: []string{"http", "https"},
+		New: func(options ...getter.Option) (getter.Getter, error) {
+			if token != "" {
+				return &tokenGetter{
+					ctx:    ctx,
+					client: &http.Client{Transport: transport, Timeout: downloadTimeout},
+					token:  token,
+					host:   tokenHost,
+				}, nil
+			}
+			return getter.NewHTTPGetter(append(options, getter.WithTransport(transport), getter.WithTimeout(downloadTimeout))...)
+		},
+	}}, nil
+}
+
+// tokenGetter downloads with a bearer token, which the Helm HTTP getter does not support
+type tokenGetter struct {
+	ctx    context.Context
+	client *http.Client
+	token  string
+	host   string
+}
+
+func (g *tokenGetter) Get(href string, _ ...getter.Option) (*bytes.Buffer, error) {
+	req, err := http.NewRequestWithContext(g.ctx, http.MethodGet, href, nil)
+	if err != nil {
+		return nil, err
+	}
+	if req.URL.Host == g.host {
+		req.Header.Set("Authorization", "Bearer "+g.token)
+	}
+	resp, err := g.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to fetch %s: %s", href, resp.Status)
+	}
+	buf := &bytes.Buffer{}
+	if _, err := io.Copy(buf, resp.Body); err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", href, err)
+	}
+	return buf, nil
+}
+
+// Upgrade installs the release, or upgrades it if the chart version or the values changed or force is set.
+// It does not wait for the resources to become ready. A failed upgrade is rolled back to the last deployed
+// revision.
+func (c *Client) Upgrade(ctx context.Context, restConfig *rest.Config, namespace, name string, ref Chart,
+	values map[string]interface{}, force bool) (*Release, error) {
+	loaded, err := c.loadChart(ctx, ref)
+	if err != nil {
+		return nil, err
+	}
+	tail := &logTail{}
+	cfg, err := c.configuration(restConfig, namespace, tail)
+	if err != nil {
+		return nil, err
+	}
+
+	history, err := action.NewHistory(cfg).Run(name)
+	if errors.Is(err, driver.ErrReleaseNotFound) {
+		install := action.NewInstall(cfg)
+		install.ReleaseName = name
+		install.Namespace = namespace
+		install.CreateNamespace = true
+		install.Timeout = hookTimeout
+		rel, err := install.RunWithContext(ctx, loaded, values)
+		if err != nil {
+			return nil, fmt.Errorf("helm install of %s failed: %w", name, tail.wrap(err))
+		}
+		c.log.Info("Installed Helm release", "release", name, "revision", rel.Version, "chartVersion", loaded.Metadata.Version)
+		return releaseOf(rel), nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read history of release %s: %w", name, err)
+	}
+
+	latest, lastDeployed := history[0], (*release.Release)(nil)
+	for _, rel := range history {
+		if rel.Version > latest.Version {
+			latest = rel
+		}
+		if rel.Info.Status == release.StatusDeployed && (lastDeployed == nil || rel.Version > lastDeployed.Version) {
+			lastDeployed = rel
+		}
+	}
+	if !force && latest.Info.Status == release.StatusDeployed && latest.Chart.Metadata.Version == loaded.Metadata.Version &&
+		valuesEqual(latest.Config, values) {
+		return releaseOf(latest), nil
+	}
+
+	upgrade := action.NewUpgrade(cfg)
+	upgrade.Namespace = namespace
+	upgrade.Timeout = hookTimeout
+	upgrade.MaxHistory = 10
+	rel, err := upgrade.RunWithContext(ctx, name, loaded, values)
+	if err == nil {
+		c.log.Info("Upgraded Helm release", "release", name, "revision", rel.Version, "chartVersion", loaded.Metadata.Version)
+		return releaseOf(rel), nil
+	}
+
+	upgradeErr := &UpgradeError{Release: name, Err: tail.wrap(err)}
+	if lastDeployed != nil {
+		rollback := action.NewRollback(cfg)
+		rollback.Version = lastDeployed.Version
+		rollback.Timeout = hookTimeout
+		rollback.CleanupOnFail = true
+		rollback.MaxHistory = 10
+		if rollbackErr := rollback.Run(name); rollbackErr != nil {
+			c.log.Error(rollbackErr, "Failed to roll back Helm release", "release", name, "revision", lastDeployed.Version)
+		} else {
+			upgradeErr.RolledBackTo = lastDeployed.Version
+		}
+	}
+	return nil, upgradeErr
+}
+
+// Latest returns the latest revision of a release and the values it was installed with, nil if the release
+// is not installed
+func (c *Client) Latest(restConfig *rest.Config, namespace, name string) (*Release, map[string]interface{}, error) {
+	cfg, err := c.configuration(restConfig, namespace, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	history, err := action.NewHistory(cfg).Run(name)
+	if errors.Is(err, driver.ErrReleaseNotFound) {
+		return nil, nil, nil
+	}
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to read history of release %s: %w", name, err)
+	}
+	latest := history[0]
+	for _, rel := range history {
+		if rel.Version > latest.Version {
+			latest = rel
+		}
+	}
+	return releaseOf(latest), latest.Config, nil
+}
+
+// Uninstall removes a release, if it is installed
+func (c *Client) Uninstall(restConfig *rest.Config, namespace, name string) error {
+	tail := &logTail{}
+	cfg, err := c.configuration(restConfig, namespace, tail)
+	if err != nil {
+		return err
+	}
+	uninstall := action.NewUninstall(cfg)
+	uninstall.IgnoreNotFound = true
+	uninstall.Timeout = hookTimeout
+	if _, err := uninstall.Run(name); err != nil {
+		return fmt.Errorf("helm uninstall of %s failed: %w", name, tail.wrap(err))
+	}
+	return nil
+}
+
+// configuration returns the action configuration for the releases of a namespace, stored in Secrets like the CLI
+// does. What Helm logs is also kept in tail, if set.
+func (c *Client) configuration(restConfig *rest.Config, namespace string, tail *logTail) (*action.Configuration, error) {
+	cfg := &action.Configuration{}
+	debug := func(format string, v ...interface{}) {
+		line := fmt.Sprintf(format, v...)
+		c.log.V(1).Info(line)
+		if tail != nil {
+			tail.add(line)
+		}
+	}
+	discoveryClient, err := c.discoveryClient(restConfig)
+	if err != nil {
+		return nil, err
+	}
+	clientGetter := &restClientGetter{config: restConfig, namespace: namespace, discovery: discoveryClient}
+	if err := cfg.Init(clientGetter, namespace, "secret", debug); err != nil {
+		return nil, fmt.Errorf("failed to initialize Helm: %w", err)
+	}
+	return cfg, nil
+}
+
+// logTail keeps the last lines Helm logged during an operation
+type logTail struct {
+	mu    sync.Mutex
+	lines []string
+}
+
+func (t *logTail) add(line string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.lines = append(t.lines, strings.TrimSpace(line))
+	if len(t.lines) > logTailLines {
+		t.lines = t.lines[len(t.lines)-logTailLines:]
+	}
+}
+
+// wrap adds the kept lines to the error of the failed operation
+func (t *logTail) wrap(err error) error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if len(t.lines) == 0 {
+		return err
+	}
+	return fmt.Errorf("%w\nhelm log:\n%s", err, strings.Join(t.lines, "\n"))
+}
+
+// discoveryClient returns the cached API discovery of a cluster, which is read again after discoveryTTL. Helm
+// invalidates it itself after installing the CRDs of a chart.
+func (c *Client) discoveryClient(restConfig *rest.Config) (discovery.CachedDiscoveryInterface, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for config, cached := range c.discovery {
+		if time.Since(cached.createdAt) >= discoveryTTL {
+			delete(c.discovery, config)
+		}
+	}
+	if cached, ok := c.discovery[restConfig]; ok {
+		return cached.client, nil
+	}
+	discoveryClient, err := discovery.NewDiscoveryClientForConfig(restConfig)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create discovery client: %w", err)
+	}
+	cached := memory.NewMemCacheClient(discoveryClient)
+	c.discovery[restConfig] = cachedDiscovery{client: cached, createdAt: time.Now()}
+	return cached, nil
+}
+
+func releaseOf(rel *release.Release) *Release {
+	r := &Release{Revision: rel.Version, Status: rel.Info.Status}
+	if rel.Chart != nil && rel.Chart.Metadata != nil {
+		r.ChartVersion = rel.Chart.Metadata.Version
+		r.AppVersion = rel.Chart.Metadata.AppVersion
+	}
+	if rel.Chart != nil {
+		if values, err := chartutil.CoalesceValues(rel.Chart, rel.Config); err == nil {
+			r.Values = values
+		}
+	}
+	return r
+}
+
+// valuesEqual compares values by their JSON encoding, which sorts the keys and unifies the number types
+func valuesEqual(a, b map[string]interface{}) bool {
+	if len(a) == 0 && len(b) == 0 {
+		return true
+	}
+	encodedA, errA := json.Marshal(a)
+	encodedB, errB := json.Marshal(b)
+	return errA == nil && errB == nil && bytes.Equal(encodedA, encodedB)
+}
+
+// ValuesHash hashes values by their JSON encoding, so values that are equal for valuesEqual hash equally
+func ValuesHash(values map[string]interface{}) (string, error) {
+	if len(values) == 0 {
+		values = nil
+	}
+	data, err := json.Marshal(values)
+	if err != nil {
+		return "", fmt.Errorf("failed to encode values: %w", err)
+	}
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])[:16], nil
+}
+
+// copyValues returns a deep copy, so callers can set values without changing the cache
+func copyValues(values map[string]interface{}) (map[string]interface{}, error) {
+	data, err := json.Marshal(values)
+	if err != nil {
+		return nil, err
+	}
+	copied := map[string]interface{}{}
+	if err := json.Unmarshal(data, &copied); err != nil {
+		return nil, err
+	}
+	return copied, nil
+}
+
+// restClientGetter hands the REST config of the controller to Helm instead of a kubeconfig file
+type restClientGetter struct {
+	config    *rest.Config
+	namespace string
+	discovery discovery.CachedDiscoveryInterface
+}
+
+func (g *restClientGetter) ToRESTConfig() (*rest.Config, error) {
+	return rest.CopyConfig(g.config), nil
+}
+
+func (g *restClientGetter) ToDiscoveryClient() (discovery.CachedDiscoveryInterface, error) {
+	return g.discovery, nil
+}
+
+func (g *restClientGetter) ToRESTMapper() (meta.RESTMapper, error) {
+	discoveryClient, err := g.ToDiscoveryClient()
+	if err != nil {
+		return nil, err
+	}
+	mapper := restmapper.NewDeferredDiscoveryRESTMapper(discoveryClient)
+	return restmapper.NewShortcutExpander(mapper, discoveryClient, nil), nil
+}
+
+func (g *restClientGetter) ToRawKubeConfigLoader() clientcmd.ClientConfig {
+	overrides := &clientcmd.ConfigOverrides{}
+	overrides.Context.Namespace = g.namespace
+	return clientcmd.NewNonInteractiveDeferredLoadingClientConfig(&clientcmd.ClientConfigLoadingRules{}, overrides)
+}
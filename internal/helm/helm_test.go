@@ -0,0 +1,111 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package helm
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+)
+
+func TestValuesDownloadsOnce(t *testing.T) {
+	var requests atomic.Int32
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		requests.Add(1)
+		<-release
+		fmt.Fprintln(w, "driver:\n  version: \"570\"")
+	}))
+	defer server.Close()
+	c := New(t.TempDir(), logr.Discard())
+
+	var wg sync.WaitGroup
+	errs := make(chan error, 5)
+	for range 5 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			values, err := c.Values(context.Background(), server.URL, Proxy{})
+			if err == nil && values["driver"] == nil {
+				err = fmt.Errorf("values without driver: %v", values)
+			}
+			errs <- err
+		}()
+	}
+	// Let the callers queue up behind the first download before it completes
+	time.Sleep(100 * time.Millisecond)
+	close(release)
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		if err != nil {
+			t.Errorf("Values: %v", err)
+		}
+	}
+	if got := requests.Load(); got != 1 {
+		t.Errorf("values file downloaded %d times, want 1", got)
+	}
+
+	if _, err := c.Values(context.Background(), server.URL, Proxy{}); err != nil {
+		t.Errorf("Values from the cache: %v", err)
+	}
+	if got := requests.Load(); got != 1 {
+		t.Errorf("values file downloaded %d times after a cached call, want 1", got)
+	}
+}
+
+func TestValuesUnresponsiveServer(t *testing.T) {
+	hung := make(chan struct{})
+	unresponsive := httptest.NewServer(http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+		select {
+		case <-hung:
+		case <-r.Context().Done():
+		}
+	}))
+	defer unresponsive.Close()
+	defer close(hung)
+	responsive := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		fmt.Fprintln(w, "driver: {}")
+	}))
+	defer responsive.Close()
+	c := New(t.TempDir(), logr.Discard())
+
+	ctx, cancel := context.WithTimeout(context.Background(), 200*time.Millisecond)
+	defer cancel()
+	started := time.Now()
+	if _, err := c.Values(ctx, unresponsive.URL, Proxy{}); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Values from an unresponsive server = %v, want %v", err, context.DeadlineExceeded)
+	}
+	if waited := time.Since(started); waited > 5*time.Second {
+		t.Errorf("Values returned after %s, want shortly after the deadline", waited)
+	}
+
+	// The hung download must not block downloads from other servers
+	ctx, cancel = context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if _, err := c.Values(ctx, responsive.URL, Proxy{}); err != nil {
+		t.Errorf("Values while another download hangs: %v", err)
+	}
+}
@@ -0,0 +1,42 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package images resolves the references of the helper images launched by the controller.
+package images
+
+import "strings"
+
+// Mirror rewrites an image reference to pull from the given mirror prefix, e.g.
+// "nvcr.io/nvidia/cuda:12" with mirror "registry.local/nvidia-mirror" becomes
+// "registry.local/nvidia-mirror/nvidia/cuda:12". The source registry is dropped, Docker Hub
+// references without a registry are mirrored as-is, and references already below the mirror
+// are returned unchanged.
+func Mirror(mirror, ref string) string {
+	mirror = strings.TrimSuffix(strings.TrimSpace(mirror), "/")
+	if mirror == "" || ref == "" || strings.HasPrefix(ref, mirror+"/") {
+		return ref
+	}
+	return mirror + "/" + Repository(ref)
+}
+
+// Repository strips the registry host from an image reference
+func Repository(ref string) string {
+	first, rest, found := strings.Cut(ref, "/")
+	if found && (strings.ContainsAny(first, ".:") || first == "localhost") {
+		return rest
+	}
+	return ref
+}
@@ -0,0 +1,88 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package instances decides which GpuOperator CR manages a cluster. The controller and the validating
+// webhook share it, so both agree on what makes two CRs duplicates and which of them is active.
+package instances
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	operatorv1alpha1 "github.com/kyma-project/gpu-operator/api/v1alpha1"
+)
+
+// Vendor returns the GPU vendor of a GpuOperator CR, NVIDIA for CRs admitted before the vendor was defaulted
+func Vendor(gpuOperator *operatorv1alpha1.GpuOperator) operatorv1alpha1.GPUVendor {
+	if gpuOperator.Spec.Vendor == "" {
+		return operatorv1alpha1.GPUVendorNVIDIA
+	}
+	return gpuOperator.Spec.Vendor
+}
+
+// TargetCluster identifies the cluster a GpuOperator CR installs into, empty for the local cluster
+func TargetCluster(gpuOperator *operatorv1alpha1.GpuOperator) string {
+	ref := gpuOperator.Spec.TargetClusterKubeconfigSecretRef
+	if ref == nil {
+		return ""
+	}
+	return gpuOperator.Namespace + "/" + ref.Name + "/" + ref.Key
+}
+
+// TargetKey identifies the cluster and vendor a GpuOperator CR installs into. An NVIDIA and an AMD
+// GpuOperator manage a mixed-vendor cluster side by side.
+func TargetKey(gpuOperator *operatorv1alpha1.GpuOperator) string {
+	return string(Vendor(gpuOperator)) + "/" + TargetCluster(gpuOperator)
+}
+
+// SameTarget lists the GpuOperator CRs with the same target key as the given one, including the given one
+// if it is stored already, oldest first. The first one is the CR that manages the cluster.
+//
+// To rule out an older CR, the reader must see the CRs of all namespaces and shards. A cache restricted by
+// --watch-namespaces or --shard-selector only sees the CRs of its own namespaces or shard, so it can find an
+// older CR but not rule one out.
+func SameTarget(ctx context.Context, reader client.Reader, gpuOperator *operatorv1alpha1.GpuOperator) ([]*operatorv1alpha1.GpuOperator, error) {
+	gpuOperators := &operatorv1alpha1.GpuOperatorList{}
+	if err := reader.List(ctx, gpuOperators); err != nil {
+		return nil, fmt.Errorf("failed to list GpuOperators: %w", err)
+	}
+	target := TargetKey(gpuOperator)
+	var sameTarget []*operatorv1alpha1.GpuOperator
+	for i := range gpuOperators.Items {
+		if TargetKey(&gpuOperators.Items[i]) == target {
+			sameTarget = append(sameTarget, &gpuOperators.Items[i])
+		}
+	}
+	sort.Slice(sameTarget, func(i, j int) bool {
+		a, b := sameTarget[i], sameTarget[j]
+		if !a.CreationTimestamp.Equal(&b.CreationTimestamp) {
+			return a.CreationTimestamp.Before(&b.CreationTimestamp)
+		}
+		if a.Namespace != b.Namespace {
+			return a.Namespace < b.Namespace
+		}
+		return a.Name < b.Name
+	})
+	return sameTarget, nil
+}
+
+// IsSame reports whether two GpuOperator objects are the same CR
+func IsSame(a, b *operatorv1alpha1.GpuOperator) bool {
+	return a.Namespace == b.Namespace && a.Name == b.Name
+}
@@ -0,0 +1,125 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package migration
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-logr/logr"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/kyma-project/gpu-operator/internal/logging"
+)
+
+// +kubebuilder:rbac:groups=apiextensions.k8s.io,resources=customresourcedefinitions/status,verbs=get;update;patch
+
+var crdGVK = schema.GroupVersionKind{Group: "apiextensions.k8s.io", Version: "v1", Kind: "CustomResourceDefinition"}
+
+// StorageVersionMigrator rewrites the stored objects of a CRD in its storage version once at startup and then
+// drops the other versions from status.storedVersions, so a later release can stop serving them. It does
+// nothing while the objects are stored in a single version.
+type StorageVersionMigrator struct {
+	Client client.Client
+	// CRDName is the name of the CustomResourceDefinition whose objects are migrated
+	CRDName string
+}
+
+// Start implements manager.Runnable
+func (m *StorageVersionMigrator) Start(ctx context.Context) error {
+	logger := ctrl.Log.WithName(logging.SubsystemMigration).WithValues("crd", m.CRDName)
+	if err := m.migrate(ctx, logger); err != nil {
+		// The objects stay readable in every served version, the migration is retried on the next start
+		logger.Error(err, "Failed to migrate stored objects to the storage version")
+	}
+	return nil
+}
+
+// NeedLeaderElection implements manager.LeaderElectionRunnable; only the leader rewrites the objects
+func (m *StorageVersionMigrator) NeedLeaderElection() bool {
+	return true
+}
+
+func (m *StorageVersionMigrator) migrate(ctx context.Context, logger logr.Logger) error {
+	crd := &unstructured.Unstructured{}
+	crd.SetGroupVersionKind(crdGVK)
+	if err := m.Client.Get(ctx, types.NamespacedName{Name: m.CRDName}, crd); err != nil {
+		return fmt.Errorf("failed to get CRD: %w", err)
+	}
+	storage, err := storageVersion(crd)
+	if err != nil {
+		return err
+	}
+	stored, _, err := unstructured.NestedStringSlice(crd.Object, "status", "storedVersions")
+	if err != nil {
+		return fmt.Errorf("failed to read stored versions: %w", err)
+	}
+	if len(stored) == 1 && stored[0] == storage {
+		return nil
+	}
+
+	group, _, _ := unstructured.NestedString(crd.Object, "spec", "group")
+	kind, _, _ := unstructured.NestedString(crd.Object, "spec", "names", "kind")
+	list := &unstructured.UnstructuredList{}
+	list.SetGroupVersionKind(schema.GroupVersionKind{Group: group, Version: storage, Kind: kind + "List"})
+	if err := m.Client.List(ctx, list); err != nil {
+		return fmt.Errorf("failed to list %s objects: %w", kind, err)
+	}
+	logger.Info("Migrating stored objects to the storage version", "storedVersions", stored,
+		"storageVersion", storage, "objects", len(list.Items))
+	for i := range list.Items {
+		// An unchanged update makes the API server write the object in the storage version
+		obj := &list.Items[i]
+		if err := m.Client.Update(ctx, obj); err != nil && !apierrors.IsNotFound(err) && !apierrors.IsConflict(err) {
+			return fmt.Errorf("failed to migrate %s %s/%s: %w", kind, obj.GetNamespace(), obj.GetName(), err)
+		}
+	}
+
+	patch := client.MergeFrom(crd.DeepCopy())
+	if err := unstructured.SetNestedStringSlice(crd.Object, []string{storage}, "status", "storedVersions"); err != nil {
+		return fmt.Errorf("failed to set stored versions: %w", err)
+	}
+	if err := m.Client.Status().Patch(ctx, crd, patch); err != nil {
+		return fmt.Errorf("failed to update stored versions: %w", err)
+	}
+	logger.Info("Migrated stored objects to the storage version", "storageVersion", storage)
+	return nil
+}
+
+// storageVersion returns the version of the CRD its objects are stored in
+func storageVersion(crd *unstructured.Unstructured) (string, error) {
+	versions, _, err := unstructured.NestedSlice(crd.Object, "spec", "versions")
+	if err != nil {
+		return "", fmt.Errorf("failed to read CRD versions: %w", err)
+	}
+	for _, v := range versions {
+		version, ok := v.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if storage, _, _ := unstructured.NestedBool(version, "storage"); storage {
+			name, _, _ := unstructured.NestedString(version, "name")
+			return name, nil
+		}
+	}
+	return "", fmt.Errorf("CRD has no storage version")
+}
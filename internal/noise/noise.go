@@ -0,0 +1,91 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package noise deduplicates repeated identical failures, so a prolonged outage is published as one
+// counted entry that is refreshed at a bounded rate instead of one status update per retry.
+package noise
+
+import (
+	"fmt"
+	"sync"
+	"time"
+)
+
+// Occurrence describes a failure that was observed one or more times in a row
+type Occurrence struct {
+	// Count is how many times in a row the failure was observed
+	Count int
+	// First is when the failure was first observed
+	First time.Time
+	// Publish is set if the occurrence should be published: the first occurrence of a failure always is,
+	// repeats at most once per interval
+	Publish bool
+}
+
+// Summary appends the repeat count to a failure message
+func (o Occurrence) Summary(message string) string {
+	if o.Count <= 1 {
+		return message
+	}
+	return fmt.Sprintf("%s (occurred %d times since %s)", message, o.Count, o.First.UTC().Format(time.RFC3339))
+}
+
+// Filter tracks the last failure per key, e.g. per CR. It is safe for concurrent use.
+type Filter struct {
+	interval time.Duration
+
+	mu      sync.Mutex
+	entries map[string]*entry
+}
+
+type entry struct {
+	fingerprint string
+	count       int
+	first       time.Time
+	published   time.Time
+}
+
+// NewFilter returns a filter that publishes a repeated failure at most once per interval
+func NewFilter(interval time.Duration) *Filter {
+	return &Filter{interval: interval, entries: map[string]*entry{}}
+}
+
+// Observe records a failure of key. Failures with the same fingerprint in a row are counted as repeats,
+// a different fingerprint starts over.
+func (f *Filter) Observe(key, fingerprint string) Occurrence {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	now := time.Now()
+	e, ok := f.entries[key]
+	if !ok || e.fingerprint != fingerprint {
+		f.entries[key] = &entry{fingerprint: fingerprint, count: 1, first: now, published: now}
+		return Occurrence{Count: 1, First: now, Publish: true}
+	}
+	e.count++
+	occurrence := Occurrence{Count: e.count, First: e.first}
+	if now.Sub(e.published) >= f.interval {
+		e.published = now
+		occurrence.Publish = true
+	}
+	return occurrence
+}
+
+// Reset forgets the failure of key, e.g. after it recovered or was deleted
+func (f *Filter) Reset(key string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	delete(f.entries, key)
+}
@@ -0,0 +1,199 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package statusz records what the controller computed for each GpuOperator CR and serves it as JSON
+// on a debug endpoint. It explains what the controller is waiting for when the CR status does not.
+package statusz
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"sort"
+	"sync"
+	"time"
+
+	ctrl "sigs.k8s.io/controller-runtime"
+)
+
+// Snapshot is the controller's view of a GpuOperator CR after its last reconcile
+type Snapshot struct {
+	// CR is the namespace/name of the GpuOperator CR
+	CR string `json:"cr"`
+	// Generation is the CR generation that was reconciled
+	Generation int64 `json:"generation"`
+	// LastReconcile is when the CR was last reconciled
+	LastReconcile time.Time `json:"lastReconcile"`
+	// Phase is the reconcile phase the last reconcile reached
+	Phase string `json:"phase"`
+
+	Desired        DesiredState `json:"desired"`
+	LastHelmAction *HelmAction  `json:"lastHelmAction,omitempty"`
+	Retry          Retry        `json:"retry"`
+	Health         Health       `json:"health"`
+}
+
+// DesiredState is the installation the controller computed from the spec and its configuration
+type DesiredState struct {
+	Namespace         string `json:"namespace"`
+	InstallEngine     string `json:"installEngine"`
+	DriverVersion     string `json:"driverVersion,omitempty"`
+	ChartVersion      string `json:"chartVersion,omitempty"`
+	ValidationImage   string `json:"validationImage"`
+	SmokeTestSchedule string `json:"smokeTestSchedule,omitempty"`
+	Spot              bool   `json:"spot,omitempty"`
+}
+
+// HelmAction is the last Helm action the controller ran
+type HelmAction struct {
+	// Action is install or uninstall
+	Action string `json:"action"`
+	// Status is Complete or Failed
+	Status     string    `json:"status"`
+	Error      string    `json:"error,omitempty"`
+	Revision   int       `json:"revision,omitempty"`
+	ObservedAt time.Time `json:"observedAt"`
+}
+
+// Retry describes when the CR is reconciled next and why
+type Retry struct {
+	RequeueAfter        string `json:"requeueAfter,omitempty"`
+	LastError           string `json:"lastError,omitempty"`
+	ConsecutiveFailures int    `json:"consecutiveFailures,omitempty"`
+}
+
+// Health is the result of the last health evaluation
+type Health struct {
+	State             string      `json:"state,omitempty"`
+	Hibernated        bool        `json:"hibernated,omitempty"`
+	SmokeTestFailures []string    `json:"smokeTestFailures,omitempty"`
+	Conditions        []Condition `json:"conditions,omitempty"`
+}
+
+// Condition is a condition reported in the CR status
+type Condition struct {
+	Type    string `json:"type"`
+	Status  string `json:"status"`
+	Reason  string `json:"reason,omitempty"`
+	Message string `json:"message,omitempty"`
+}
+
+// Recorder holds the latest Snapshot of every CR. A nil Recorder records nothing.
+type Recorder struct {
+	mu        sync.RWMutex
+	snapshots map[string]*Snapshot
+}
+
+// NewRecorder returns an empty Recorder
+func NewRecorder() *Recorder {
+	return &Recorder{snapshots: map[string]*Snapshot{}}
+}
+
+// Update applies the change to the Snapshot of the CR, creating it if needed
+func (r *Recorder) Update(cr string, update func(*Snapshot)) {
+	if r == nil {
+		return
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	snapshot, ok := r.snapshots[cr]
+	if !ok {
+		snapshot = &Snapshot{CR: cr}
+		r.snapshots[cr] = snapshot
+	}
+	update(snapshot)
+}
+
+// RecordResult records the outcome of a reconcile
+func (r *Recorder) RecordResult(cr string, requeueAfter time.Duration, err error) {
+	r.Update(cr, func(s *Snapshot) {
+		s.Retry.RequeueAfter = ""
+		if requeueAfter > 0 {
+			s.Retry.RequeueAfter = requeueAfter.String()
+		}
+		if err != nil {
+			s.Retry.LastError = err.Error()
+			s.Retry.ConsecutiveFailures++
+			return
+		}
+		s.Retry.LastError = ""
+		s.Retry.ConsecutiveFailures = 0
+	})
+}
+
+// Delete forgets the CR
+func (r *Recorder) Delete(cr string) {
+	if r == nil {
+		return
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	delete(r.snapshots, cr)
+}
+
+// ServeHTTP writes all snapshots as JSON, sorted by CR
+func (r *Recorder) ServeHTTP(w http.ResponseWriter, _ *http.Request) {
+	r.mu.RLock()
+	snapshots := make([]Snapshot, 0, len(r.snapshots))
+	for _, snapshot := range r.snapshots {
+		snapshots = append(snapshots, *snapshot)
+	}
+	r.mu.RUnlock()
+	sort.Slice(snapshots, func(i, j int) bool { return snapshots[i].CR < snapshots[j].CR })
+
+	w.Header().Set("Content-Type", "application/json")
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(snapshots); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+	}
+}
+
+// Server serves the Recorder on /statusz as a manager.Runnable
+type Server struct {
+	BindAddress string
+	Recorder    *Recorder
+}
+
+// Start implements manager.Runnable
+func (s *Server) Start(ctx context.Context) error {
+	mux := http.NewServeMux()
+	mux.Handle("/statusz", s.Recorder)
+	server := &http.Server{
+		Addr:              s.BindAddress,
+		Handler:           mux,
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+	go func() {
+		<-ctx.Done()
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		_ = server.Shutdown(shutdownCtx)
+	}()
+
+	ctrl.Log.WithName("statusz").Info("Serving debug status", "address", s.BindAddress, "path", "/statusz")
+	if err := server.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		return err
+	}
+	return nil
+}
+
+// NeedLeaderElection implements manager.LeaderElectionRunnable; only the leader has snapshots,
+// but serving on every replica makes it obvious which one is reconciling
+func (s *Server) NeedLeaderElection() bool {
+	return false
+}
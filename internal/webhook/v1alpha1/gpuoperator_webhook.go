@@ -0,0 +1,238 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+	"sigs.k8s.io/yaml"
+
+	operatorv1alpha1 "github.com/kyma-project/gpu-operator/api/v1alpha1"
+	"github.com/kyma-project/gpu-operator/internal/config"
+	"github.com/kyma-project/gpu-operator/internal/instances"
+	"github.com/kyma-project/gpu-operator/internal/logging"
+)
+
+// valuesConfigMapKey is the key of the custom values in the ConfigMap referenced by spec.valuesConfigMapName
+const valuesConfigMapKey = "values.yaml"
+
+// driverVersionPattern matches a driver branch such as 570 or a full driver version such as 570.124.06
+var driverVersionPattern = regexp.MustCompile(`^[0-9]{3}(\.[0-9]+){0,2}$`)
+
+var gpuOperatorLog = logf.Log.WithName(logging.SubsystemWebhook)
+
+// SetupGpuOperatorWebhookWithManager registers the webhook for GpuOperator in the manager.
+// Other GpuOperator CRs are read through the API reader, the cache may be restricted to a shard.
+// The builder also serves the conversion webhook at /convert, v1alpha1 being the hub of the GpuOperator versions.
+func SetupGpuOperatorWebhookWithManager(mgr ctrl.Manager, configStore *config.Store) error {
+	return ctrl.NewWebhookManagedBy(mgr).For(&operatorv1alpha1.GpuOperator{}).
+		WithDefaulter(&GpuOperatorCustomDefaulter{Config: configStore}).
+		WithValidator(&GpuOperatorCustomValidator{Reader: mgr.GetAPIReader(), Config: configStore}).
+		Complete()
+}
+
+// +kubebuilder:webhook:path=/mutate-operator-kyma-project-io-v1alpha1-gpuoperator,mutating=true,failurePolicy=fail,sideEffects=None,groups=operator.kyma-project.io,resources=gpuoperators,verbs=create;update,versions=v1alpha1,name=mgpuoperator-v1alpha1.kb.io,admissionReviewVersions=v1
+
+// GpuOperatorCustomDefaulter writes the effective defaults into the spec, so the stored object shows the
+// configuration the controller acts on. The controller keeps the same fallbacks for CRs admitted without it.
+type GpuOperatorCustomDefaulter struct {
+	Config *config.Store
+}
+
+var _ webhook.CustomDefaulter = &GpuOperatorCustomDefaulter{}
+
+// Default implements webhook.CustomDefaulter
+func (d *GpuOperatorCustomDefaulter) Default(_ context.Context, obj runtime.Object) error {
+	gpuOperator, ok := obj.(*operatorv1alpha1.GpuOperator)
+	if !ok {
+		return fmt.Errorf("expected a GpuOperator object but got %T", obj)
+	}
+	// Leave a CR that is being deleted as it is
+	if gpuOperator.DeletionTimestamp != nil {
+		return nil
+	}
+	gpuOperatorLog.V(1).Info("Defaulting GpuOperator", "cr", client.ObjectKeyFromObject(gpuOperator))
+
+	if gpuOperator.Spec.Namespace == "" {
+		gpuOperator.Spec.Namespace = defaultNamespace(d.Config, gpuOperator)
+	}
+	// A pinned driver version takes precedence over spec.driverVersion, show it there. Without one, the
+	// driver branch stays empty: it is selected for the GPU nodes in the cluster, which change over time.
+	if versions := gpuOperator.Spec.ComponentVersions; gpuOperator.Spec.DriverVersion == "" &&
+		versions != nil && driverVersionPattern.MatchString(versions.Driver) {
+		gpuOperator.Spec.DriverVersion = versions.Driver
+	}
+	return nil
+}
+
+// +kubebuilder:webhook:path=/validate-operator-kyma-project-io-v1alpha1-gpuoperator,mutating=false,failurePolicy=fail,sideEffects=None,groups=operator.kyma-project.io,resources=gpuoperators,verbs=create;update,versions=v1alpha1,name=vgpuoperator-v1alpha1.kb.io,admissionReviewVersions=v1
+
+// GpuOperatorCustomValidator rejects GpuOperator CRs that would only fail during reconciliation: malformed
+// driver versions and values ConfigMaps, a changed installation namespace, and a second CR installing into
+// the same cluster
+type GpuOperatorCustomValidator struct {
+	Reader client.Reader
+	Config *config.Store
+}
+
+var _ webhook.CustomValidator = &GpuOperatorCustomValidator{}
+
+// ValidateCreate implements webhook.CustomValidator
+func (v *GpuOperatorCustomValidator) ValidateCreate(ctx context.Context, obj runtime.Object) (admission.Warnings, error) {
+	gpuOperator, ok := obj.(*operatorv1alpha1.GpuOperator)
+	if !ok {
+		return nil, fmt.Errorf("expected a GpuOperator object but got %T", obj)
+	}
+	gpuOperatorLog.V(1).Info("Validating GpuOperator creation", "cr", client.ObjectKeyFromObject(gpuOperator))
+
+	warnings, errs := v.validateSpec(ctx, gpuOperator)
+	conflict, err := v.validateSingleInstance(ctx, gpuOperator)
+	if err != nil {
+		return warnings, err
+	}
+	if conflict != nil {
+		errs = append(errs, conflict)
+	}
+	return warnings, invalid(gpuOperator, errs)
+}
+
+// ValidateUpdate implements webhook.CustomValidator
+func (v *GpuOperatorCustomValidator) ValidateUpdate(ctx context.Context, oldObj, newObj runtime.Object) (admission.Warnings, error) {
+	oldGpuOperator, ok := oldObj.(*operatorv1alpha1.GpuOperator)
+	if !ok {
+		return nil, fmt.Errorf("expected a GpuOperator object for the old object but got %T", oldObj)
+	}
+	gpuOperator, ok := newObj.(*operatorv1alpha1.GpuOperator)
+	if !ok {
+		return nil, fmt.Errorf("expected a GpuOperator object for the new object but got %T", newObj)
+	}
+	gpuOperatorLog.V(1).Info("Validating GpuOperator update", "cr", client.ObjectKeyFromObject(gpuOperator))
+
+	// Let a CR that is being deleted finish its finalization
+	if gpuOperator.DeletionTimestamp != nil {
+		return nil, nil
+	}
+	warnings, errs := v.validateSpec(ctx, gpuOperator)
+	if oldNamespace, newNamespace := v.namespace(oldGpuOperator), v.namespace(gpuOperator); oldNamespace != newNamespace {
+		errs = append(errs, field.Forbidden(field.NewPath("spec", "namespace"),
+			fmt.Sprintf("the installation namespace cannot be changed from %s to %s, delete and recreate the GpuOperator instead",
+				oldNamespace, newNamespace)))
+	}
+	return warnings, invalid(gpuOperator, errs)
+}
+
+// ValidateDelete implements webhook.CustomValidator
+func (v *GpuOperatorCustomValidator) ValidateDelete(context.Context, runtime.Object) (admission.Warnings, error) {
+	return nil, nil
+}
+
+// validateSpec checks the fields whose format the CRD schema cannot express
+func (v *GpuOperatorCustomValidator) validateSpec(ctx context.Context, gpuOperator *operatorv1alpha1.GpuOperator) (admission.Warnings, field.ErrorList) {
+	var warnings admission.Warnings
+	var errs field.ErrorList
+	spec := field.NewPath("spec")
+
+	if version := gpuOperator.Spec.DriverVersion; version != "" && !driverVersionPattern.MatchString(version) {
+		errs = append(errs, field.Invalid(spec.Child("driverVersion"), version,
+			"must be a driver branch such as 570 or a driver version such as 570.124.06"))
+	}
+
+	if name := gpuOperator.Spec.ValuesConfigMapName; name != "" {
+		path := spec.Child("valuesConfigMapName")
+		if msgs := validation.IsDNS1123Subdomain(name); len(msgs) > 0 {
+			for _, msg := range msgs {
+				errs = append(errs, field.Invalid(path, name, msg))
+			}
+			return warnings, errs
+		}
+		configMap := &corev1.ConfigMap{}
+		err := v.Reader.Get(ctx, types.NamespacedName{Name: name, Namespace: gpuOperator.Namespace}, configMap)
+		switch {
+		case apierrors.IsNotFound(err):
+			// The ConfigMap may be applied right after the CR, e.g. by a GitOps tool
+			warnings = append(warnings, fmt.Sprintf("values ConfigMap %s does not exist yet in namespace %s", name, gpuOperator.Namespace))
+		case err != nil:
+			warnings = append(warnings, fmt.Sprintf("values ConfigMap %s could not be checked: %v", name, err))
+		default:
+			data, found := configMap.Data[valuesConfigMapKey]
+			if !found {
+				errs = append(errs, field.Invalid(path, name, fmt.Sprintf("the ConfigMap has no %s key", valuesConfigMapKey)))
+				break
+			}
+			values := map[string]interface{}{}
+			if err := yaml.Unmarshal([]byte(data), &values); err != nil {
+				errs = append(errs, field.Invalid(path, name, fmt.Sprintf("the %s key holds no valid Helm values: %v", valuesConfigMapKey, err)))
+			}
+		}
+	}
+	return warnings, errs
+}
+
+// validateSingleInstance rejects a GpuOperator CR that installs the GPU operator of the same vendor into the same
+// cluster as another one: two local CRs, or two CRs referencing the same kubeconfig Secret
+func (v *GpuOperatorCustomValidator) validateSingleInstance(ctx context.Context, gpuOperator *operatorv1alpha1.GpuOperator) (*field.Error, error) {
+	sameTarget, err := instances.SameTarget(ctx, v.Reader, gpuOperator)
+	if err != nil {
+		return nil, err
+	}
+	for _, other := range sameTarget {
+		if other.UID == gpuOperator.UID || instances.IsSame(other, gpuOperator) {
+			continue
+		}
+		return field.Forbidden(field.NewPath("metadata", "name"), fmt.Sprintf(
+			"GpuOperator %s/%s already installs the %s GPU operator into this cluster, only one GpuOperator per cluster and vendor is supported",
+			other.Namespace, other.Name, instances.Vendor(other))), nil
+	}
+	return nil, nil
+}
+
+// namespace returns the installation namespace of a GpuOperator CR
+func (v *GpuOperatorCustomValidator) namespace(gpuOperator *operatorv1alpha1.GpuOperator) string {
+	if gpuOperator.Spec.Namespace != "" {
+		return gpuOperator.Spec.Namespace
+	}
+	return defaultNamespace(v.Config, gpuOperator)
+}
+
+// defaultNamespace returns the installation namespace of a GpuOperator CR without spec.namespace
+func defaultNamespace(cfg *config.Store, gpuOperator *operatorv1alpha1.GpuOperator) string {
+	if instances.Vendor(gpuOperator) == operatorv1alpha1.GPUVendorAMD {
+		return cfg.Get().DefaultAMDNamespace
+	}
+	return cfg.Get().DefaultNamespace
+}
+
+// invalid returns the validation errors as an Invalid API error, nil if there are none
+func invalid(gpuOperator *operatorv1alpha1.GpuOperator, errs field.ErrorList) error {
+	if len(errs) == 0 {
+		return nil
+	}
+	return apierrors.NewInvalid(operatorv1alpha1.GroupVersion.WithKind("GpuOperator").GroupKind(), gpuOperator.Name, errs)
+}